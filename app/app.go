@@ -76,8 +76,8 @@ func NewPocketCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clie
 	// The governance keeper
 	app.govKeeper = govKeeper.NewKeeper(
 		app.cdc,
-		app.Keys[pocketTypes.StoreKey],
-		app.Tkeys[pocketTypes.StoreKey],
+		app.Keys[govTypes.StoreKey],
+		app.Tkeys[govTypes.TStoreKey],
 		govTypes.DefaultCodespace,
 		app.accountKeeper,
 		authSubspace, nodesSubspace, appsSubspace, pocketSubspace,
@@ -89,6 +89,8 @@ func NewPocketCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clie
 	app.appsKeeper.PocketKeeper = app.pocketKeeper
 	app.accountKeeper.POSKeeper = app.nodesKeeper
 	app.accountKeeper.AppKeeper = app.appsKeeper
+	app.govKeeper.PosKeeper = app.nodesKeeper
+	app.govKeeper.HaltHandler = app.RequestHalt
 	// setup module manager
 	app.mm = module.NewManager(
 		auth.NewAppModule(app.accountKeeper),
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bufio"
+	"encoding/csv"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -15,13 +16,17 @@ import (
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/crypto"
 	"github.com/pokt-network/pocket-core/crypto/keys"
+	"github.com/pokt-network/pocket-core/crypto/keys/hd"
 	"github.com/pokt-network/pocket-core/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/spf13/cobra"
 )
 
 func init() {
 	rootCmd.AddCommand(accountsCmd)
 	accountsCmd.AddCommand(createCmd)
+	accountsCmd.AddCommand(recoverCmd)
 	accountsCmd.AddCommand(getValidator)
 	accountsCmd.AddCommand(setValidator)
 	accountsCmd.AddCommand(deleteCmd)
@@ -34,11 +39,21 @@ func init() {
 	accountsCmd.AddCommand(exportCmd)
 	accountsCmd.AddCommand(exportRawCmd)
 	accountsCmd.AddCommand(sendTxCmd)
+	accountsCmd.AddCommand(sendBatchCmd)
+	accountsCmd.AddCommand(createVestingAccountCmd)
 	accountsCmd.AddCommand(sendRawTxCmd)
+	accountsCmd.AddCommand(simulateRawTxCmd)
+	accountsCmd.AddCommand(signTxCmd)
+	accountsCmd.AddCommand(broadcastTxCmd)
 	accountsCmd.AddCommand(newMultiPublicKey)
 	accountsCmd.AddCommand(signMS)
 	accountsCmd.AddCommand(signNexMS)
 	accountsCmd.AddCommand(buildMultisig)
+	accountsCmd.AddCommand(createPartialTx)
+	accountsCmd.AddCommand(inspectPartialTx)
+	accountsCmd.AddCommand(signPartialTx)
+	accountsCmd.AddCommand(combinePartialTx)
+	accountsCmd.AddCommand(broadcastPartialTx)
 	accountsCmd.AddCommand(unsafeDeleteCmd)
 	accountsCmd.AddCommand(getNodesLean)
 	accountsCmd.AddCommand(setValidatorsLean)
@@ -53,16 +68,40 @@ from creating and deleting accounts; to importing and exporting accounts.`,
 }
 
 var pwd, oldPwd, decryptPwd, encryptPwd string
+var msThreshold uint64
+var memo string
+var vestingStart, vestingEnd int64
+var vestingDelayed bool
+var asFeePayer bool
+var withMnemonic bool
+var bip39Pwd string
+var recoverIndex, recoverCount uint32
 
 func init() {
 	buildMultisig.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	createCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	createCmd.Flags().BoolVar(&withMnemonic, "mnemonic", false, "derive the account from a new mnemonic that can recover it")
+	recoverCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	recoverCmd.Flags().StringVar(&bip39Pwd, "bip39-pwd", "", "the optional bip39 passphrase of the mnemonic")
+	recoverCmd.Flags().Uint32Var(&recoverIndex, "index", 0, "the index of the first account derived from the mnemonic")
+	recoverCmd.Flags().Uint32Var(&recoverCount, "count", 1, "the number of accounts derived from the mnemonic")
 	deleteCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	sendTxCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	createVestingAccountCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	createVestingAccountCmd.Flags().Int64Var(&vestingStart, "start", 0, "the time (unix seconds) the amount starts to vest linearly, not used with --delayed")
+	createVestingAccountCmd.Flags().Int64Var(&vestingEnd, "end", 0, "the time (unix seconds) the amount is fully vested")
+	createVestingAccountCmd.Flags().BoolVar(&vestingDelayed, "delayed", false, "vest all of the amount at once at the end time, instead of linearly from the start time")
 	setValidator.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	signTxCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	signTxCmd.Flags().BoolVar(&asFeePayer, "as-fee-payer", false, "sign as the fee payer of the transaction instead of its signer")
 	signMS.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signNexMS.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	newMultiPublicKey.Flags().Uint64Var(&msThreshold, "threshold", 0, "the number of signatures (k of n) the multisig needs, 0 for all of them")
+	buildMultisig.Flags().Uint64Var(&msThreshold, "threshold", 0, "the number of signatures (k of n) the multisig needs, 0 for all of them")
+	createPartialTx.Flags().Uint64Var(&msThreshold, "threshold", 0, "the number of signatures (k of n) the multisig needs, 0 for all of them")
+	createPartialTx.Flags().StringVar(&memo, "memo", "", "the memo of the transaction")
+	signPartialTx.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 
 	exportCmd.Flags().StringVar(&decryptPwd, "pwd-decrypt", "", "decrypt passphrase used by the cmd, non empty usage bypass interactive prompt")
 	exportCmd.Flags().StringVar(&encryptPwd, "pwd-encrypt", "", "encrypt passphrase used by the cmd, non empty usage bypass interactive prompt")
@@ -83,7 +122,8 @@ var createCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new account",
 	Long: `Creates and persists a new account in the Keybase.
-Will prompt the user for a passphrase to encrypt the generated keypair.`,
+Will prompt the user for a passphrase to encrypt the generated keypair.
+With --mnemonic the account is derived from a new 24 word mnemonic (BIP-39, SLIP-10 path m/44'/635'/0'/0'/0'), which is printed once.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
@@ -92,6 +132,16 @@ Will prompt the user for a passphrase to encrypt the generated keypair.`,
 		fmt.Print("Enter passphrase again: \n")
 		confirmedpass := app.Credentials(pwd)
 		if pass == confirmedpass {
+			if withMnemonic {
+				kp, mnemonic, err := kb.CreateMnemonic(confirmedpass)
+				if err != nil {
+					fmt.Printf("Account generation Failed, %s", err)
+					return
+				}
+				fmt.Printf("Account generated successfully:\nAddress: %s\n", kp.GetAddress())
+				fmt.Printf("\nMnemonic: %s\n\nWrite the mnemonic down and keep it safe, it is the only way to recover the account with `pocket accounts recover`\n", mnemonic)
+				return
+			}
 			kp, err := kb.Create(confirmedpass)
 			if err != nil {
 				fmt.Printf("Account generation Failed, %s", err)
@@ -106,6 +156,43 @@ Will prompt the user for a passphrase to encrypt the generated keypair.`,
 	},
 }
 
+var recoverCmd = &cobra.Command{
+	Use:   "recover [--index <i>] [--count <n>] [--bip39-pwd <passphrase>]",
+	Short: "Recover accounts from a mnemonic",
+	Long: `Derives the accounts of a BIP-39 mnemonic with the SLIP-10 path m/44'/635'/0'/0'/<index>', from --index to --index + --count - 1,
+and persists them in the Keybase.
+Will prompt the user for the mnemonic and for a passphrase to encrypt the derived keypairs.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		// the derived indexes are hardened, so the last one must stay below the hardened offset
+		if recoverIndex >= hd.HardenedOffset || recoverCount > hd.HardenedOffset-recoverIndex {
+			fmt.Printf("Account recovery Failed, the last index exceeds %d\n", hd.HardenedOffset-1)
+			return
+		}
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+		fmt.Print("Enter Mnemonic: \n")
+		mnemonic := app.Credentials("")
+		fmt.Print("Enter Passphrase: \n")
+		pass := app.Credentials(pwd)
+		fmt.Print("Enter passphrase again: \n")
+		confirmedpass := app.Credentials(pwd)
+		if pass != confirmedpass {
+			fmt.Println("Account recovery Failed, Passphrases do not match")
+			return
+		}
+		for i := uint32(0); i < recoverCount; i++ {
+			index := recoverIndex + i
+			kp, err := kb.Recover(mnemonic, bip39Pwd, confirmedpass, index)
+			if err != nil {
+				fmt.Printf("Account %d recovery Failed, %s\n", index, err)
+				continue
+			}
+			fmt.Printf("Account %d recovered successfully:\nAddress: %s\n", index, kp.GetAddress())
+		}
+	},
+}
+
 var getNodesLean = &cobra.Command{
 	Use:   "get-validators",
 	Short: "Retrieves all nodes set by set-validators",
@@ -526,26 +613,104 @@ Prompts the user for <fromAddr> account passphrase.`,
 		}
 		memo := args[5]
 		fmt.Printf("Adding Memo: %v\n", memo)
-		fmt.Println("Enter passphrase: ")
-		res, err := SendTransaction(args[0], args[1], app.Credentials(pwd), args[3], types.NewInt(int64(amount)), int64(fees), memo, false)
+		res, err := SendTransaction(args[0], args[1], txPassphrase(), args[3], types.NewInt(int64(amount)), int64(fees), memo, false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		sendRawTx(res)
+	},
+}
+
+var createVestingAccountCmd = &cobra.Command{
+	Use:   "create-vesting-account <fromAddr> <toAddr> <amount> <networkID> <fee> --end <unix> [--start <unix> | --delayed]",
+	Short: "Fund a new vesting account",
+	Long: `Sends <amount> uPOKT from <fromAddr> to the new vesting account <toAddr>, which must not exist yet.
+The amount vests linearly from --start until --end, or all at once at --end with --delayed. The vesting uPOKT can't
+be sent until vested, but it can be staked.
+Prompts the user for <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, err := strconv.Atoi(args[2])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		fees, err := strconv.Atoi(args[4])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		res, err := CreateVestingAccount(args[0], args[1], txPassphrase(), args[3], types.NewInt(int64(amount)), vestingStart, vestingEnd, vestingDelayed, int64(fees))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(res)
+	},
+}
+
+var sendBatchCmd = &cobra.Command{
+	Use:   "send-batch <fromAddr> <csv-file> <networkID> <fee> <memo>",
+	Short: "Send uPOKT to many accounts in a single transaction",
+	Long: `Sends uPOKT from <fromAddr> to every account of the <csv-file>, one <toAddr>,<amount> row per recipient, in a single
+multi message transaction executed atomically: either all of the sends succeed or none of them does.
+The <fee> must cover the fee of every send, and the number of sends is limited by the auth/MaxMsgsPerTx param.
+Prompts the user for <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		sends, err := readBatchSends(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		memo := args[4]
+		fmt.Printf("Sending to %d accounts\n", len(sends))
+		fmt.Println("Enter passphrase: ")
+		res, err := SendBatchTransaction(args[0], sends, app.Credentials(pwd), args[2], int64(fees), memo)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(res)
 	},
 }
 
+// readBatchSends reads the sends of a batch from its csv file, one <toAddr>,<amount> row per send
+func readBatchSends(path string) (sends []nodeTypes.MsgSend, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	for i, record := range records {
+		toAddr, err := types.AddressFromHex(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid address on row %d: %s", i+1, err.Error())
+		}
+		amount, ok := types.NewIntFromString(record[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid amount on row %d: %s", i+1, record[1])
+		}
+		sends = append(sends, nodeTypes.MsgSend{ToAddress: toAddr, Amount: amount})
+	}
+	return sends, nil
+}
+
 // sendRawTxCmd represents the sendTx command
 var sendRawTxCmd = &cobra.Command{
 	Use:   "send-raw-tx <fromAddr> <txBytes>",
@@ -577,6 +742,111 @@ var sendRawTxCmd = &cobra.Command{
 	},
 }
 
+// simulateRawTxCmd represents the simulate-raw-tx command
+var simulateRawTxCmd = &cobra.Command{
+	Use:   "simulate-raw-tx <txBytes>",
+	Short: "Simulate a raw transaction from its bytes",
+	Long: `Runs the transaction through the ante handler and the message handler against the latest state without committing it.
+Prints the result code, log, events and the fee required by the transaction.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		bz, err := hex.DecodeString(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		p := rpc.SimulateTxParams{
+			RawHexBytes: hex.EncodeToString(bz),
+		}
+		j, err := json.Marshal(p)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SimulateTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+// signTxCmd represents the sign-tx command
+var signTxCmd = &cobra.Command{
+	Use:   "sign-tx <file>",
+	Short: "Sign an unsigned transaction file offline",
+	Long: `Signs the unsigned transaction file written by a transaction command with --generate-only, using only the keybase:
+no call is made to the network, so it works on a machine that is never connected. The fee is checked against the fee
+required by the message when the file was generated. The signed transaction is written back to the file.
+A transaction generated with --fee-payer is signed by both its signer and, with --as-fee-payer, its fee payer, in any
+order; the fee is deducted from the fee payer.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		otx, err := readOfflineTx(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if asFeePayer {
+			fmt.Printf("Paying with %s the fee %s of the transaction of %s:\n%s\n", otx.FeePayer, otx.Fee, otx.Signer, string(otx.Msg))
+			fmt.Println("Enter passphrase: ")
+			otx, err = app.SignOfflineTxAsFeePayer(app.Credentials(pwd), otx)
+		} else {
+			fmt.Printf("Signing the transaction of %s:\n%s\n", otx.Signer, string(otx.Msg))
+			fmt.Println("Enter passphrase: ")
+			otx, err = app.SignOfflineTx(app.Credentials(pwd), otx)
+		}
+		if err != nil {
+			fmt.Println(fmt.Errorf("error signing the transaction: %v", err))
+			return
+		}
+		if err = writeOfflineTx(args[0], otx); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Signed transaction written to %s\n", args[0])
+	},
+}
+
+// broadcastTxCmd represents the broadcast-tx command
+var broadcastTxCmd = &cobra.Command{
+	Use:   "broadcast-tx <file>",
+	Short: "Send a signed transaction file",
+	Long:  `Sends the transaction file signed offline with sign-tx through the tendermint node.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		otx, err := readOfflineTx(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		bz, err := app.EncodeOfflineTx(otx)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		p := rpc.SendRawTxParams{
+			Addr:        otx.Signer,
+			RawHexBytes: hex.EncodeToString(bz),
+		}
+		j, err := json.Marshal(p)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
 // importCmd represents the import command
 var importCmd = &cobra.Command{
 	Use:   "import-raw <private-key-hex>",
@@ -606,11 +876,20 @@ Will prompt the user for a passphrase to encrypt the generated keypair.`,
 	},
 }
 
+// newMultiSigPublicKey returns a k-of-n multisig public key when threshold is set, otherwise one that needs every signature
+func newMultiSigPublicKey(pks []crypto.PublicKey, threshold uint64) (crypto.PublicKeyMultiSig, error) {
+	if threshold == 0 {
+		return crypto.PublicKeyMultiSignature{PublicKeys: pks}, nil
+	}
+	return crypto.NewThresholdMultiKey(threshold, pks...)
+}
+
 var newMultiPublicKey = &cobra.Command{
-	Use:   "create-multi-public <ordered-comma-separated-hex-pubkeys>",
+	Use:   "create-multi-public <ordered-comma-separated-hex-pubkeys> [--threshold <k>]",
 	Short: "create a multisig public key",
-	Long:  `create a multisig public key with a comma separated list of hex encoded public keys`,
-	Args:  cobra.ExactArgs(1),
+	Long: `create a multisig public key with a comma separated list of hex encoded public keys.
+With --threshold the multisig only needs the signatures of k of the keys, in any order.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		rawPKs := strings.Split(strings.TrimSpace(args[0]), ",")
@@ -623,16 +902,21 @@ var newMultiPublicKey = &cobra.Command{
 			}
 			pks = append(pks, p)
 		}
-		multiSigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: pks}
+		multiSigPubKey, err := newMultiSigPublicKey(pks, msThreshold)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error in multisig public key creation: %v", err))
+			return
+		}
 		fmt.Printf("Sucessfully generated Multisig Public Key:\n%s\nWith Address:\n%s\n", multiSigPubKey.String(), multiSigPubKey.Address())
 	},
 }
 
 var buildMultisig = &cobra.Command{
-	Use:   "build-MS-Tx <signer-address> <json-message> <ordered-comma-separated-hex-pubkeys> <networkID> <fees>",
+	Use:   "build-MS-Tx <signer-address> <json-message> <ordered-comma-separated-hex-pubkeys> <networkID> <fees> [--threshold <k>]",
 	Short: "Build and sign a multisig tx",
 	Args:  cobra.ExactArgs(5),
-	Long:  `Build and sign a multisignature transaction from scratch: result is hex encoded std tx object.`,
+	Long: `Build and sign a multisignature transaction from scratch: result is hex encoded std tx object.
+With --threshold the transaction is for the k-of-n multisig public key of the keys.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		msg := args[1]
@@ -647,7 +931,11 @@ var buildMultisig = &cobra.Command{
 			pks = append(pks, p)
 		}
 
-		multiSigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: pks}
+		multiSigPubKey, err := newMultiSigPublicKey(pks, msThreshold)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error creating the multisig public key: %v", err))
+			return
+		}
 		fmt.Println("Enter passphrase: ")
 		fees, err := strconv.Atoi(args[4])
 		if err != nil {
@@ -665,8 +953,9 @@ var buildMultisig = &cobra.Command{
 var signMS = &cobra.Command{
 	Use:   "sign-ms-tx <signer-address> <hex-amino-stdtx> <hex-pubkeys> <networkID> ",
 	Short: "sign a multisig tx",
-	Long:  `sign a multisignature transaction using public keys, and the transaciton object, result is hex encoded std tx object`,
-	Args:  cobra.ExactArgs(4),
+	Long: `sign a multisignature transaction using public keys, and the transaciton object, result is hex encoded std tx object.
+The public keys are not used for a threshold multisig, whose signer is found in its public key.`,
+	Args: cobra.ExactArgs(4),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		msg := args[1]
@@ -693,7 +982,8 @@ var signNexMS = &cobra.Command{
 	Use:   "sign-ms-next <signer-address> <hex-stdtx> <networkID> ",
 	Short: "Sign a multisig tx",
 	Long: `Sign a multisignature transaction using the transaciton object, result is hex encoded std tx object
-NOTE: you MUST be the next signer (in order of public keys in the ms public key object) or the signature will be invalid.`,
+NOTE: you MUST be the next signer (in order of public keys in the ms public key object) or the signature will be invalid,
+unless the ms public key is a threshold one, which can be signed in any order until it has the signatures it needs.`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
@@ -706,3 +996,180 @@ NOTE: you MUST be the next signer (in order of public keys in the ms public key
 		fmt.Println("Multisig transaction: \n" + hex.EncodeToString(bz))
 	},
 }
+
+// readPartialTx reads a partially signed transaction from its json file
+func readPartialTx(path string) (ptx authTypes.PartiallySignedTx, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ptx, err
+	}
+	err = json.Unmarshal(bz, &ptx)
+	return ptx, err
+}
+
+// writePartialTx writes a partially signed transaction to its json file
+func writePartialTx(path string, ptx authTypes.PartiallySignedTx) error {
+	bz, err := json.MarshalIndent(ptx, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+var createPartialTx = &cobra.Command{
+	Use:   "create-partial-tx <json-message> <ordered-comma-separated-hex-pubkeys> <networkID> <fees> <file> [--threshold <k>] [--memo <memo>]",
+	Short: "Create an unsigned multisig tx file",
+	Long: `Create a json file with the unsigned multisignature transaction of the message, to be passed between the signers.
+The file records the message, the networkID, the fees, the memo, the entropy, the keys that must sign and the signatures collected.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		rawPKs := strings.Split(strings.TrimSpace(args[1]), ",")
+		var pks []crypto.PublicKey
+		for _, pk := range rawPKs {
+			p, err := crypto.NewPublicKey(pk)
+			if err != nil {
+				fmt.Println(fmt.Errorf("error creating the public key: %v", err))
+				return
+			}
+			pks = append(pks, p)
+		}
+		multiSigPubKey, err := newMultiSigPublicKey(pks, msThreshold)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error creating the multisig public key: %v", err))
+			return
+		}
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		ptx, err := app.NewPartialTx(args[0], args[2], multiSigPubKey, int64(fees), memo)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error creating the partial transaction: %v", err))
+			return
+		}
+		if err = writePartialTx(args[4], ptx); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Partial transaction of %s written to %s\n", ptx.Address, args[4])
+	},
+}
+
+var inspectPartialTx = &cobra.Command{
+	Use:   "inspect-partial-tx <file>",
+	Short: "Inspect a partial multisig tx file",
+	Long:  `Prints the transaction of the partial transaction file and the keys that signed it and that didn't yet.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		ptx, err := readPartialTx(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		bz, err := json.MarshalIndent(struct {
+			Transaction authTypes.PartiallySignedTx `json:"transaction"`
+			Status      authTypes.PartialTxStatus   `json:"status"`
+		}{ptx, ptx.Status()}, "", "    ")
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(bz))
+	},
+}
+
+var signPartialTx = &cobra.Command{
+	Use:   "sign-partial-tx <signer-address> <file>",
+	Short: "Sign a partial multisig tx file",
+	Long: `Adds the signature of the signer to the partial transaction file, replacing a previous one of the same signer.
+The signers may sign in any order.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		ptx, err := readPartialTx(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		ptx, err = app.SignPartialTx(args[0], app.Credentials(pwd), ptx)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error signing the partial transaction: %v", err))
+			return
+		}
+		if err = writePartialTx(args[1], ptx); err != nil {
+			fmt.Println(err)
+			return
+		}
+		status := ptx.Status()
+		fmt.Printf("Partial transaction signed: %d of %d signatures\n", len(status.Signed), status.Threshold)
+	},
+}
+
+var combinePartialTx = &cobra.Command{
+	Use:   "combine-partial-tx <output-file> <file> <file>...",
+	Short: "Combine partial multisig tx files",
+	Long:  `Merges the signatures of copies of the same partial transaction, signed separately, into the output file.`,
+	Args:  cobra.MinimumNArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var ptxs []authTypes.PartiallySignedTx
+		for _, path := range args[1:] {
+			ptx, err := readPartialTx(path)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			ptxs = append(ptxs, ptx)
+		}
+		ptx, err := ptxs[0].Combine(app.Codec(), ptxs[1:]...)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error combining the partial transactions: %v", err))
+			return
+		}
+		if err = writePartialTx(args[0], ptx); err != nil {
+			fmt.Println(err)
+			return
+		}
+		status := ptx.Status()
+		fmt.Printf("Partial transactions combined into %s: %d of %d signatures\n", args[0], len(status.Signed), status.Threshold)
+	},
+}
+
+var broadcastPartialTx = &cobra.Command{
+	Use:   "broadcast-partial-tx <file>",
+	Short: "Finalize and send a partial multisig tx file",
+	Long:  `Builds the signed transaction of the partial transaction file, once it has the signatures it needs, and sends it through the tendermint node.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		ptx, err := readPartialTx(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		bz, err := app.FinalizePartialTx(ptx)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error finalizing the partial transaction: %v", err))
+			return
+		}
+		p := rpc.SendRawTxParams{
+			Addr:        ptx.Address,
+			RawHexBytes: hex.EncodeToString(bz),
+		}
+		j, err := json.Marshal(p)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
@@ -12,16 +12,21 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc"
 	"github.com/pokt-network/pocket-core/crypto/keys/mintkey"
 	"github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 )
 
 func init() {
 	rootCmd.AddCommand(appCmd)
 	appCmd.AddCommand(appStakeCmd)
+	appCmd.AddCommand(appEditStakeCmd)
 	appCmd.AddCommand(appUnstakeCmd)
 	appCmd.AddCommand(appTransferCmd)
 	appCmd.AddCommand(createAATCmd)
+	appCmd.AddCommand(appRevokeClientsCmd)
+	appCmd.AddCommand(appUnjailCmd)
 }
 
 var appCmd = &cobra.Command{
@@ -33,11 +38,42 @@ from staking and unstaking; to generating AATs.`,
 
 func init() {
 	appStakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appEditStakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appEditStakeCmd.Flags().Int64Var(&editStakeAmount, "amount", 0, "the new stake amount in uPOKT, defaults to the current stake")
+	appEditStakeCmd.Flags().StringVar(&editStakeAddChains, "add-chains", "", "comma separated relay chain identifiers to add")
+	appEditStakeCmd.Flags().StringVar(&editStakeRemoveChains, "remove-chains", "", "comma separated relay chain identifiers to remove")
+	appEditStakeCmd.Flags().BoolVar(&editStakeDryRun, "dry-run", false, "only show the resulting stake, without signing or broadcasting the transaction")
 	appUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	createAATCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	appTransferCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appRevokeClientsCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appUnjailCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	createAATCmd.Flags().Int64Var(&aatExpirationHeight, "expiration-height", 0, "the last session block height the AAT may be used for, creates a version 0.0.2 AAT")
+	createAATCmd.Flags().StringVar(&aatChains, "chains", "", "comma separated relay chain identifiers the AAT is limited to, creates a version 0.0.2 AAT")
 }
 
+var aatExpirationHeight int64
+
+var (
+	editStakeAmount       int64
+	editStakeAddChains    string
+	editStakeRemoveChains string
+	editStakeDryRun       bool
+)
+
+// splitChains - Split comma separated relay chain identifiers, ignoring empty ones
+func splitChains(s string) []string {
+	chains := make([]string, 0)
+	for _, chain := range strings.Split(s, ",") {
+		if chain = strings.TrimSpace(chain); chain != "" {
+			chains = append(chains, chain)
+		}
+	}
+	return chains
+}
+
+var aatChains string
+
 var appStakeCmd = &cobra.Command{
 	Use:   "stake <fromAddr> <amount> <relayChainIDs> <networkID> <fee> ",
 	Short: "Stake an app into the network",
@@ -66,23 +102,65 @@ If no changes are desired for the parameter, just enter the current param value
 		}
 		rawChains := reg.ReplaceAllString(args[2], "")
 		chains := strings.Split(rawChains, ",")
-		fmt.Println("Enter passphrase: ")
-		res, err := StakeApp(chains, fromAddr, app.Credentials(pwd), args[3], types.NewInt(int64(amount)), int64(fee), false)
+		res, err := StakeApp(chains, fromAddr, txPassphrase(), args[3], types.NewInt(int64(amount)), int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(res)
+	},
+}
+
+var appEditStakeCmd = &cobra.Command{
+	Use:   "edit-stake <fromAddr> <networkID> <fee> [--amount <amount>] [--add-chains <relayChainIDs>] [--remove-chains <relayChainIDs>] [--dry-run]",
+	Short: "Edit the stake of a staked app",
+	Long: `Edits the stake of the staked app <fromAddr>, adding and removing relay chains from its current chains and
+raising its stake to --amount. Before signing, shows the resulting chains against the maximum amount of chains, the
+max relays and the minimum fee of the transaction. With --dry-run, nothing is signed or broadcast.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fee, err := strconv.Atoi(args[2])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		params := rpc.AppStakePreviewParams{
+			Address:      args[0],
+			Amount:       types.NewInt(editStakeAmount),
+			AddChains:    splitChains(editStakeAddChains),
+			RemoveChains: splitChains(editStakeRemoveChains),
+		}
+		j, err := json.Marshal(params)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		resp, err := QueryRPC(GetAppStakePreviewPath, j)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
 		fmt.Println(resp)
+		var preview app.AppStakePreview
+		if err := json.Unmarshal([]byte(resp), &preview); err != nil {
+			fmt.Println(err)
+			return
+		}
+		if int64(fee) < preview.Fee.Int64() {
+			fmt.Printf("the fee %d is lower than the minimum fee %s\n", fee, preview.Fee)
+			return
+		}
+		if preview.Error != "" || editStakeDryRun {
+			return
+		}
+		res, err := StakeApp(preview.Chains, args[0], txPassphrase(), args[1], preview.StakedTokens, int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(res)
 	},
 }
 
@@ -99,23 +177,60 @@ Prompts the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnstakeApp(args[0], app.Credentials(pwd), args[1], int64(fee), false)
+		res, err := UnstakeApp(args[0], txPassphrase(), args[1], int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(res)
+	},
+}
+
+var appUnjailCmd = &cobra.Command{
+	Use:   "unjail <fromAddr> <networkID> <fee>",
+	Short: "Unjail an app in the network",
+	Long: `Unjails the app <fromAddr>, returning it to the staking set. Only the app itself can unjail, and its stake
+must be at least the minimum app stake. The reason the app was jailed for is shown by 'pocket query app'.
+Prompts the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fee, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := UnjailApp(args[0], txPassphrase(), args[1], int64(fee), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		sendRawTx(res)
+	},
+}
+
+var appRevokeClientsCmd = &cobra.Command{
+	Use:   "revoke-clients <fromAddr> <clientPubKeys> <networkID> <fee>",
+	Short: "Revoke client public keys of an app",
+	Long: fmt.Sprintf(`Revokes the comma separated <clientPubKeys> of the app <fromAddr>.
+Servicers reject relays of AATs issued to a revoked client public key.
+An app may revoke at most %d client public keys, revocations are permanent.
+Prompts the user for the <fromAddr> account passphrase.`, appsTypes.MaxRevokedClients),
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fee, err := strconv.Atoi(args[3])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		clientPubKeys := strings.Split(args[1], ",")
+		res, err := RevokeAppClients(args[0], clientPubKeys, txPassphrase(), args[2], int64(fee), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res)
 	},
 }
 
@@ -146,8 +261,7 @@ command, you must have the private key of the current staked app <fromAddr>
 			return
 		}
 
-		fmt.Printf("Enter passphrase to unlock %s: ", currentAppAddr)
-		passphrase := app.Credentials(pwd)
+		passphrase := txPassphrase()
 
 		rawTx, err := TransferApp(
 			currentAppAddr,
@@ -162,19 +276,7 @@ command, you must have the private key of the current staked app <fromAddr>
 			return
 		}
 
-		rawTxBytes, err := json.Marshal(rawTx)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-
-		resp, err := QueryRPC(SendRawTxPath, rawTxBytes)
-		if err != nil {
-			fmt.Println("Failed to submit a transaction:", err)
-			return
-		}
-
-		fmt.Println(resp)
+		sendRawTx(rawTx)
 	},
 }
 
@@ -182,8 +284,11 @@ var createAATCmd = &cobra.Command{
 	Use:   "create-aat <appAddr> <clientPubKey>",
 	Short: "Creates an application authentication token",
 	Long: `Creates a signed Application Authentication Token.
-Creates a signed AAT (= Application Authentication Token) where the version is
-hardcoded as "0.0.1" that is the only version supported by the protocol.
+Creates a signed AAT (= Application Authentication Token). Without flags the
+version is "0.0.1", a token that never expires and is valid for every chain.
+With --expiration-height or --chains the version is "0.0.2", a token that
+servicers reject for sessions after the expiration height or for chains not
+included in the list. --expiration-height is required for version "0.0.2".
 
 This command prompts you to input the <appAddr> account passphrase.
 When you send a relay request with AAT, <appAddr> needs to be a staked
@@ -220,7 +325,16 @@ Please read doc/specs/application-auth-token.md for additional details.`,
 			return
 		}
 
-		aat, err := app.GenerateAAT(pubKeyHexEncoded, args[1], privKey)
+		var aat []byte
+		if aatExpirationHeight != 0 || aatChains != "" {
+			var chains []string
+			if aatChains != "" {
+				chains = strings.Split(aatChains, ",")
+			}
+			aat, err = app.GenerateScopedAAT(pubKeyHexEncoded, args[1], aatExpirationHeight, chains, privKey)
+		} else {
+			aat, err = app.GenerateAAT(pubKeyHexEncoded, args[1], privKey)
+		}
 		if err != nil {
 			fmt.Println(err)
 			return
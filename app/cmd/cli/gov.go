@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc"
 	"github.com/pokt-network/pocket-core/types"
 	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
 )
@@ -19,8 +20,13 @@ func init() {
 	govCmd.AddCommand(govDAOTransfer)
 	govCmd.AddCommand(govDAOBurn)
 	govCmd.AddCommand(govChangeParam)
+	govCmd.AddCommand(govSimulateChangeParam)
 	govCmd.AddCommand(govUpgrade)
 	govCmd.AddCommand(govFeatureEnable)
+	govCmd.AddCommand(govPropose)
+	govCmd.AddCommand(govVote)
+	govCmd.AddCommand(govCancelTransfer)
+	govCmd.AddCommand(govSignalVersion)
 }
 
 var govCmd = &cobra.Command{
@@ -34,9 +40,24 @@ func init() {
 	govDAOTransfer.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	govDAOBurn.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	govChangeParam.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govChangeParam.Flags().Int64Var(&paramActivationHeight, "activation-height", 0, "schedule the change for this height instead of applying it immediately")
 	govUpgrade.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govPropose.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govPropose.Flags().Int64Var(&proposalUpgradeHeight, "upgrade-height", 0, "the height of the upgrade bundled in the proposal")
+	govPropose.Flags().StringVar(&proposalUpgradeVersion, "upgrade-version", "", "the version of the upgrade bundled in the proposal")
+	govVote.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govCancelTransfer.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govSignalVersion.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govSignalVersion.Flags().StringVar(&signalledVersion, "version", "", "the version to signal, defaults to the version of this binary")
 }
 
+var (
+	paramActivationHeight  int64
+	proposalUpgradeHeight  int64
+	proposalUpgradeVersion string
+	signalledVersion       string
+)
+
 var govDAOTransfer = &cobra.Command{
 	Use:   "transfer <amount> <fromAddr> <toAddr> <networkID> <fees>",
 	Short: "Transfer from DAO",
@@ -57,24 +78,13 @@ Actions: [burn, transfer]`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		pass := app.Credentials(pwd)
+		pass := txPassphrase()
 		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_transfer", args[3], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(res)
 	},
 }
 
@@ -101,24 +111,13 @@ Actions: [burn, transfer]`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		pass := app.Credentials(pwd)
+		pass := txPassphrase()
 		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_burn", args[3], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(res)
 	},
 }
 
@@ -126,33 +125,65 @@ var govChangeParam = &cobra.Command{
 	Use:   "change_param <fromAddr> <networkID> <paramKey module/param> <paramValue (jsonObj)> <fees>",
 	Short: "Edit a param in the network",
 	Long: `If authorized, submit a tx to change any param from any module.
+With --activation-height, the change is queued and applied at the end of that block instead of immediately.
 Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
-		fmt.Println("Enter Password: ")
 		fees, err := strconv.Atoi(args[4])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
 
-		res, err := ChangeParam(args[0], args[2], []byte(args[3]), app.Credentials(pwd), args[1], int64(fees), false)
+		res, err := ChangeParam(args[0], args[2], []byte(args[3]), paramActivationHeight, txPassphrase(), args[1], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		sendRawTx(res)
+	},
+}
+
+var govSimulateChangeParam = &cobra.Command{
+	Use:   "simulate_change_param <paramChanges (jsonObj)> [<height>]",
+	Short: "Simulate the effect of param changes",
+	Long: `Applies the param changes, a json object of 'module/param' keys to json values, to a copy of the state at <height>
+without broadcasting them. Reports the old and new value of every param, the validation errors of the resulting params,
+the reward of a single relay for sample nodes and the applications whose max relays change.
+<height> defaults to the latest height.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var changes map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(args[0]), &changes); err != nil {
+			fmt.Println(err)
+			return
+		}
+		var height int
+		if len(args) == 2 {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.SimulateParamsParams{
+			Height:  int64(height),
+			Changes: changes,
+		}
+		j, err := json.Marshal(params)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := QueryRPC(GetSimulateParamsPath, j)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		fmt.Println(res)
 	},
 }
 
@@ -178,23 +209,12 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			return
 		}
 
-		fmt.Println("Enter Password: ")
-		res, err := Upgrade(args[0], u, app.Credentials(pwd), args[3], int64(fees), false)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
+		res, err := Upgrade(args[0], u, txPassphrase(), args[3], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(res)
 	},
 }
 
@@ -233,22 +253,137 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			return
 		}
 
-		fmt.Println("Enter Password: ")
-		res, err := Upgrade(args[0], u, app.Credentials(pwd), args[3], int64(fees), false)
+		res, err := Upgrade(args[0], u, txPassphrase(), args[3], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(res)
+	},
+}
+
+var govPropose = &cobra.Command{
+	Use:   "propose <fromAddr> <networkID> <paramChanges (jsonObj)> <fees>",
+	Short: "Propose param changes and/or an upgrade",
+	Long: `Submit a proposal bundling param changes and/or a protocol upgrade, to be voted by the proposal voter set.
+The param changes are a json object of <paramKey module/param> to <paramValue>, e.g. '{"pos/MaxValidators": 5000}'; use '{}' for an upgrade only proposal.
+The upgrade is set with the --upgrade-height and --upgrade-version flags.
+If the proposal passes, it is executed at the end of the block its voting period ends on behalf of the ACL owners.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var changes map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(args[2]), &changes); err != nil {
+			fmt.Println(err)
+			return
+		}
+		var upgrade *govTypes.Upgrade
+		if proposalUpgradeHeight != 0 || proposalUpgradeVersion != "" {
+			u := govTypes.NewUpgrade(proposalUpgradeHeight, dropTag(proposalUpgradeVersion))
+			upgrade = &u
+		}
+		fees, err := strconv.Atoi(args[3])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		res, err := SubmitProposal(args[0], changes, upgrade, txPassphrase(), args[1], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(res)
+	},
+}
+
+var govVote = &cobra.Command{
+	Use:   "vote <fromAddr> <proposalID> <yes/no> <networkID> <fees>",
+	Short: "Vote on a proposal",
+	Long: `If in the proposal voter set, vote on a proposal open for votes. Voting again replaces the previous vote.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		proposalID, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var approve bool
+		switch strings.ToLower(args[2]) {
+		case "yes":
+			approve = true
+		case "no":
+			approve = false
+		default:
+			fmt.Println(fmt.Errorf("invalid vote %s, must be yes or no", args[2]))
+			return
+		}
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := Vote(args[0], proposalID, approve, txPassphrase(), args[3], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(res)
+	},
+}
+
+var govCancelTransfer = &cobra.Command{
+	Use:   "cancel_transfer <fromAddr> <transferID> <networkID> <fees>",
+	Short: "Cancel a queued DAO transfer",
+	Long: `If authorized as the DAO owner, cancel a DAO transfer or burn queued by the timelock before its execution height.
+The queued transfers are listed by 'pocket query dao-queue'. Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		transferID, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := CancelDAOTransfer(args[0], transferID, txPassphrase(), args[2], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(res)
+	},
+}
+
+var govSignalVersion = &cobra.Command{
+	Use:   "signal_version <validatorAddr> <networkID> <fees> [--version <version>]",
+	Short: "Signal the version run by a validator",
+	Long: `Signal the version of the binary run by a staked validator, so the readiness of the stake for an upgrade can be
+queried with 'pocket query upgrade-readiness'. A new signal replaces the previous one.
+Will prompt the user for the <validatorAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		version := signalledVersion
+		if version == "" {
+			version = app.AppVersion
+		}
+		fees, err := strconv.Atoi(args[2])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := SignalVersion(args[0], version, txPassphrase(), args[1], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res)
 	},
 }
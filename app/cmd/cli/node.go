@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -61,23 +60,12 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnstakeNode(args[0], args[1], app.Credentials(pwd), args[2], int64(fee), isBefore8)
+		res, err := UnstakeNode(args[0], args[1], txPassphrase(), args[2], int64(fee), isBefore8)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(res)
 	},
 }
 
@@ -99,23 +87,12 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnjailNode(args[0], args[1], app.Credentials(pwd), args[2], int64(fee), isBefore8)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := UnjailNode(args[0], args[1], txPassphrase(), args[2], int64(fee), isBefore8)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res)
 	},
 }
 
@@ -170,8 +147,7 @@ $ pocket nodes stakeNew \
 			memo = args[9]
 		}
 
-		fmt.Println("Enter Passphrase:")
-		passphrase := app.Credentials(pwd)
+		passphrase := txPassphrase()
 
 		rawStakeTx, err := BuildStakeTx(
 			operatorPubKey,
@@ -190,16 +166,6 @@ $ pocket nodes stakeNew \
 			fmt.Println(err)
 			return
 		}
-		txBytes, err := json.Marshal(rawStakeTx)
-		if err != nil {
-			fmt.Println("Fail to build a transaction:", err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, txBytes)
-		if err != nil {
-			fmt.Println("Fail to submit a transaction:", err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(rawStakeTx)
 	},
 }
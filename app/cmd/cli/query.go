@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -22,6 +23,8 @@ func init() {
 	queryCmd.AddCommand(queryTx)
 	queryCmd.AddCommand(queryAccountTxs)
 	queryCmd.AddCommand(queryBlockTxs)
+	queryCmd.AddCommand(queryMessageTypeTxs)
+	queryCmd.AddCommand(queryMemoTxs)
 	queryCmd.AddCommand(queryNodes)
 	queryCmd.AddCommand(queryBalance)
 	queryCmd.AddCommand(queryAccount)
@@ -41,8 +44,36 @@ func init() {
 	queryCmd.AddCommand(queryParam)
 	queryCmd.AddCommand(queryDAOOwner)
 	queryCmd.AddCommand(querySigningInfo)
+	queryCmd.AddCommand(querySlashHistory)
+	queryCmd.AddCommand(queryUnstaking)
+	queryCmd.AddCommand(queryChainCoverage)
+	queryCmd.AddCommand(queryRelayAllowance)
+	queryCmd.AddCommand(queryRevokedClients)
+	queryCmd.AddCommand(queryRelayUsage)
+	queryCmd.AddCommand(queryProposals)
+	queryCmd.AddCommand(queryProposal)
+	queryCmd.AddCommand(queryVotes)
+	queryCmd.AddCommand(queryPendingParams)
+	queryCmd.AddCommand(queryParamHistory)
+	queryCmd.AddCommand(queryDAOQueue)
+	queryCmd.AddCommand(queryDAOSpending)
+	queryCmd.AddCommand(queryUpgradeReadiness)
+	queryCmd.AddCommand(queryFee)
+	queryParamHistory.Flags().Int64Var(&historyFromHeight, "from-height", 0, "only changes applied at or after this height")
+	queryParamHistory.Flags().Int64Var(&historyToHeight, "to-height", 0, "only changes applied at or before this height, 0 for no limit")
+	queryParamHistory.Flags().Int64Var(&historyHeight, "height", 0, "the height of the state to query, 0 for the latest")
+	queryFee.Flags().StringVar(&feeTx, "tx", "", "the hex encoded bytes of a transaction, which does not need to be signed")
+	queryFee.Flags().Int64Var(&feeHeight, "height", 0, "the height of the state to query, 0 for the latest")
 }
 
+var (
+	historyFromHeight int64
+	historyToHeight   int64
+	historyHeight     int64
+	feeTx             string
+	feeHeight         int64
+)
+
 var queryCmd = &cobra.Command{
 	Use:   "query",
 	Short: "query the blockchain",
@@ -254,6 +285,101 @@ var queryBlockTxs = &cobra.Command{
 	},
 }
 
+// parsePaginatedTxsArgs parses the optional <page> <per_page> <prove> <order> <height> args that follow the first one
+func parsePaginatedTxsArgs(args []string) (page, perPage int, prove bool, order string, height int64) {
+	order = "desc"
+	if len(args) >= 2 {
+		parsedPage, err := strconv.Atoi(args[1])
+		if err == nil {
+			page = parsedPage
+		}
+	}
+	if len(args) >= 3 {
+		parsedPerPage, err := strconv.Atoi(args[2])
+		if err == nil {
+			perPage = parsedPerPage
+		}
+	}
+	if len(args) >= 4 {
+		parsedProve, err := strconv.ParseBool(args[3])
+		if err == nil {
+			prove = parsedProve
+		}
+	}
+	if len(args) >= 5 && args[4] == "asc" {
+		order = "asc"
+	}
+	if len(args) >= 6 {
+		parsedHeight, err := strconv.ParseInt(args[5], 10, 64)
+		if err == nil {
+			height = parsedHeight
+		}
+	}
+	return
+}
+
+var queryMessageTypeTxs = &cobra.Command{
+	Use:   "msgtype-txs <message_type> <page> <per_page> <prove (true | false)> <order (asc | desc)> <height>",
+	Short: "Get the transactions of a message type, paginated by page and per_page",
+	Long: `Retrieves the transactions of a message type (e.g. send, stake_validator, claim), from the height onwards when it is given.
+Page and per_page default to the first page of 30 transactions.`,
+	Args: cobra.RangeArgs(1, 6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		page, perPage, prove, order, height := parsePaginatedTxsArgs(args)
+		params := rpc.PaginatedMessageTypeParams{
+			MessageType: args[0],
+			Page:        page,
+			PerPage:     perPage,
+			Prove:       prove,
+			Sort:        order,
+			Height:      height,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetMessageTypeTxsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryMemoTxs = &cobra.Command{
+	Use:   "memo-txs <memo> <page> <per_page> <prove (true | false)> <order (asc | desc)> <height>",
+	Short: "Get the transactions with a memo, paginated by page and per_page",
+	Long: `Retrieves the transactions whose memo is exactly <memo>, from the height onwards when it is given.
+Only the nodes with index_tx_memos enabled in their config index the memos.`,
+	Args: cobra.RangeArgs(1, 6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		page, perPage, prove, order, height := parsePaginatedTxsArgs(args)
+		params := rpc.PaginatedMemoParams{
+			Memo:    args[0],
+			Page:    page,
+			PerPage: perPage,
+			Prove:   prove,
+			Sort:    order,
+			Height:  height,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetMemoTxsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryHeight = &cobra.Command{
 	Use:   "height",
 	Short: "Get current height",
@@ -495,18 +621,22 @@ var queryNodeParams = &cobra.Command{
 
 var appStakingStatus string
 
+var appJailedStatus string
+
 var appPage, appLimit int
 
 func init() {
 	queryApps.Flags().StringVar(&nodeStakingStatus, "staking-status", "", "the staking status of the node")
+	queryApps.Flags().StringVar(&appJailedStatus, "jailed-status", "", "the jailed status of the app")
 	queryApps.Flags().IntVar(&appPage, "appPage", 1, "mark the page you want")
 	queryApps.Flags().IntVar(&appLimit, "appLimit", 10000, "reduce the amount of results")
 }
 
 var queryApps = &cobra.Command{
-	Use:   "apps [--staking-status=<nodeStakingStatus>] [--appPage=<appPage>] [--nodeLimit=<nodeLimit>] [<height>]",
+	Use:   "apps [--staking-status=<nodeStakingStatus>] [--jailed-status (jailed | unjailed)] [--appPage=<appPage>] [--nodeLimit=<nodeLimit>] [<height>]",
 	Short: "Gets apps",
-	Long:  `Retrieves the list of all applications known at the specified <height>`,
+	Long: `Retrieves the list of all applications known at the specified <height>.
+Jailed applications include the reason and the height they were jailed at.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		var height int
@@ -535,6 +665,16 @@ var queryApps = &cobra.Command{
 				fmt.Println(fmt.Errorf("unkown staking status <staked or unstaking>"))
 			}
 		}
+		if appJailedStatus != "" {
+			switch strings.ToLower(appJailedStatus) {
+			case "jailed":
+				opts.JailedStatus = 1
+			case "unjailed":
+				opts.JailedStatus = 2
+			default:
+				fmt.Println(fmt.Errorf("unkown jailed status <jailed or unjailed>"))
+			}
+		}
 		params := rpc.HeightAndApplicaitonOptsParams{
 			Height: int64(height),
 			Opts:   opts,
@@ -1016,3 +1156,550 @@ var querySigningInfo = &cobra.Command{
 		fmt.Println(res)
 	},
 }
+
+var querySlashHistory = &cobra.Command{
+	Use:   "slash-history <address> [<height>]",
+	Short: "Gets validator slash and jail history",
+	Long:  `Retrieves the slash and jail history of the validator with <address> at <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var err error
+		var height int
+		var address string
+		switch len(args) {
+		case 1:
+			address = args[0]
+		case 2:
+			address = args[0]
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.PaginatedHeightAndAddrParams{
+			Height: int64(height),
+			Addr:   address,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetSlashHistoryPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var unstakingStartTime string
+
+var unstakingEndTime string
+
+var unstakingStartHeight int64
+
+var unstakingEndHeight int64
+
+var unstakingPage int
+
+var unstakingLimit int
+
+func init() {
+	queryUnstaking.Flags().StringVar(&unstakingStartTime, "start-time", "", "the start of the window in RFC3339 format")
+	queryUnstaking.Flags().StringVar(&unstakingEndTime, "end-time", "", "the end of the window in RFC3339 format")
+	queryUnstaking.Flags().Int64Var(&unstakingStartHeight, "start-height", 0, "the start of the window as an estimated block height")
+	queryUnstaking.Flags().Int64Var(&unstakingEndHeight, "end-height", 0, "the end of the window as an estimated block height")
+	queryUnstaking.Flags().IntVar(&unstakingPage, "page", 1, "mark the page you want")
+	queryUnstaking.Flags().IntVar(&unstakingLimit, "limit", 10000, "reduce the amount of results")
+}
+
+var queryUnstaking = &cobra.Command{
+	Use:   "unstaking [--start-time <time>] [--end-time <time>] [--start-height <height>] [--end-height <height>] [--page=<page>] [--limit=<limit>] [<height>]",
+	Short: "Gets the unstaking queue",
+	Long: `Retrieves the nodes and apps scheduled to finish unstaking within a window, with their amounts and output addresses.
+Times take precedence over heights; heights are converted to times using the expected block interval.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.UnstakingParams{
+			Height:      int64(height),
+			StartHeight: unstakingStartHeight,
+			EndHeight:   unstakingEndHeight,
+			Page:        unstakingPage,
+			PerPage:     unstakingLimit,
+		}
+		var err error
+		if unstakingStartTime != "" {
+			params.StartTime, err = time.Parse(time.RFC3339, unstakingStartTime)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		if unstakingEndTime != "" {
+			params.EndTime, err = time.Parse(time.RFC3339, unstakingEndTime)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetUnstakingPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryChainCoverage = &cobra.Command{
+	Use:   "chain-coverage [<startHeight>] [<endHeight>] [<step>]",
+	Short: "Gets the staked capacity per relay chain",
+	Long: `Retrieves the staked node count, node stake, app count and app stake of every supported relay chain,
+sampled every <step> blocks from <startHeight> to <endHeight>. Defaults to the latest height.`,
+	Args: cobra.MaximumNArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var heights [3]int64
+		for i, arg := range args {
+			h, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			heights[i] = h
+		}
+		params := rpc.ChainCoverageParams{
+			StartHeight: heights[0],
+			EndHeight:   heights[1],
+			Step:        heights[2],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetChainCoveragePath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryRelayAllowance = &cobra.Command{
+	Use:   "relay-allowance <appAddr> [<height>]",
+	Short: "Gets the per session relay allowance of an app",
+	Long:  `Retrieves the relays each session node may serve the app per chain in the session of <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 2 {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetRelayAllowancePath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryRevokedClients = &cobra.Command{
+	Use:   "revoked-clients <appAddr> [<height>]",
+	Short: "Gets the client public keys revoked by an app",
+	Long:  `Retrieves the client public keys revoked by the app at <height>. Servicers reject relays of AATs issued to these keys.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 2 {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetRevokedClientsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryRelayUsage = &cobra.Command{
+	Use:   "relay-usage [<appPubKey>]",
+	Short: "Gets the relays served by the local nodes",
+	Long:  `Retrieves the relays served by the local nodes per app and session from the evidence store, optionally filtered by <appPubKey>.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var params rpc.RelayUsageParams
+		if len(args) == 1 {
+			params.AppPubKey = args[0]
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(GetRelayUsagePath, j, app.GetAuthTokenFromFile())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryProposals = &cobra.Command{
+	Use:   "proposals [<height>]",
+	Short: "Gets the governance proposals",
+	Long:  `Retrieves every governance proposal at <height>, with its status and tally.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndProposalParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetProposalsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryProposal = &cobra.Command{
+	Use:   "proposal <proposalID> [<height>]",
+	Short: "Gets a governance proposal",
+	Long:  `Retrieves the governance proposal with <proposalID> at <height>, with its status and tally.`,
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		proposalID, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var height int
+		if len(args) == 2 {
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndProposalParams{
+			Height:     int64(height),
+			ProposalID: proposalID,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetProposalsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryVotes = &cobra.Command{
+	Use:   "votes <proposalID> [<height>]",
+	Short: "Gets the votes on a governance proposal",
+	Long:  `Retrieves the votes cast on the governance proposal with <proposalID> at <height>.`,
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		proposalID, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var height int
+		if len(args) == 2 {
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndProposalParams{
+			Height:     int64(height),
+			ProposalID: proposalID,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetVotesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryPendingParams = &cobra.Command{
+	Use:   "pending-params [<height>]",
+	Short: "Gets the scheduled param changes",
+	Long:  `Retrieves the param changes scheduled at <height> for a later activation height, ordered by activation height.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetPendingParamsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryParamHistory = &cobra.Command{
+	Use:   "param-history [<paramKey>]",
+	Short: "Gets the history of the applied param changes",
+	Long: `Retrieves the applied param changes with their old value, new value, height and signer, ordered by height.
+Only the changes of <paramKey> (module/param) are returned when it is given, and the range of heights is
+narrowed down with --from-height and --to-height.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		params := rpc.ParamHistoryParams{
+			Height:     historyHeight,
+			FromHeight: historyFromHeight,
+			ToHeight:   historyToHeight,
+		}
+		if len(args) == 1 {
+			params.Key = args[0]
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetParamHistoryPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryDAOQueue = &cobra.Command{
+	Use:   "dao-queue [<height>]",
+	Short: "Gets the queued DAO transfers",
+	Long:  `Retrieves the DAO transfers and burns waiting for their timelock at <height>, ordered by execution height.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetDAOQueuePath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryDAOSpending = &cobra.Command{
+	Use:   "dao-spending [<height>]",
+	Short: "Gets the DAO spending of the period",
+	Long:  `Retrieves the amount moved out of the DAO in the spending period of <height>, with the period bounds and the cap.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetDAOSpendingPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryUpgradeReadiness = &cobra.Command{
+	Use:   "upgrade-readiness [<version>] [<height>]",
+	Short: "Gets the share of the stake ready for an upgrade",
+	Long: `Retrieves the staked tokens of the validators that signalled a version at least equal to <version>, against all of
+the staked tokens, with the staked tokens by signalled version. <version> defaults to the version of the scheduled upgrade.`,
+	Args: cobra.MaximumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var version string
+		var height int
+		if len(args) >= 1 {
+			version = args[0]
+		}
+		if len(args) == 2 {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndVersionParams{
+			Height:  int64(height),
+			Version: version,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetUpgradeReadinessPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryFee = &cobra.Command{
+	Use:   "fee [<msgType>...] [--tx <txBytes>] [--height <height>]",
+	Short: "Gets the fees required by the message types",
+	Long: `Retrieves the fee required by each <msgType> (e.g. send, app_stake), or by every message type when none is given,
+as the base fee of the message times its fee multiplier. With --tx the fee required by the (unsigned) transaction is retrieved instead.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		params := rpc.FeeParams{
+			Height:      feeHeight,
+			MsgTypes:    args,
+			RawHexBytes: feeTx,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetFeePath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
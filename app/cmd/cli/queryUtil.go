@@ -17,6 +17,7 @@ import (
 
 var (
 	SendRawTxPath,
+	SimulateTxPath,
 	GetNodePath,
 	GetACLPath,
 	GetUpgradePath,
@@ -32,14 +33,32 @@ var (
 	GetNodeParamsPath,
 	GetNodesPath,
 	GetSigningInfoPath,
+	GetSlashHistoryPath,
+	GetUnstakingPath,
+	GetChainCoveragePath,
+	GetRelayAllowancePath,
+	GetRevokedClientsPath,
+	GetRelayUsagePath,
+	GetProposalsPath,
+	GetVotesPath,
+	GetPendingParamsPath,
+	GetParamHistoryPath,
+	GetDAOQueuePath,
+	GetDAOSpendingPath,
+	GetUpgradeReadinessPath,
+	GetSimulateParamsPath,
 	GetAppsPath,
 	GetAppParamsPath,
+	GetAppStakePreviewPath,
 	GetPocketParamsPath,
 	GetNodeClaimsPath,
 	GetNodeClaimPath,
 	GetBlockTxsPath,
+	GetMessageTypeTxsPath,
+	GetMemoTxsPath,
 	GetSupplyPath,
 	GetAllParamsPath,
+	GetFeePath,
 	GetParamPath,
 	GetStopPath,
 	GetQueryChains,
@@ -52,6 +71,8 @@ func init() {
 		switch route.Name {
 		case "SendRawTx":
 			SendRawTxPath = route.Path
+		case "SimulateTx":
+			SimulateTxPath = route.Path
 		case "QueryNode":
 			GetNodePath = route.Path
 		case "QueryACL":
@@ -84,14 +105,48 @@ func init() {
 			GetNodesPath = route.Path
 		case "QuerySigningInfo":
 			GetSigningInfoPath = route.Path
+		case "QuerySlashHistory":
+			GetSlashHistoryPath = route.Path
+		case "QueryUnstaking":
+			GetUnstakingPath = route.Path
+		case "QueryChainCoverage":
+			GetChainCoveragePath = route.Path
+		case "QueryRelayAllowance":
+			GetRelayAllowancePath = route.Path
+		case "QueryRevokedClients":
+			GetRevokedClientsPath = route.Path
+		case "QueryRelayUsage":
+			GetRelayUsagePath = route.Path
+		case "QueryProposals":
+			GetProposalsPath = route.Path
+		case "QueryVotes":
+			GetVotesPath = route.Path
+		case "QueryPendingParams":
+			GetPendingParamsPath = route.Path
+		case "QueryParamHistory":
+			GetParamHistoryPath = route.Path
+		case "QueryDAOQueue":
+			GetDAOQueuePath = route.Path
+		case "QueryDAOSpending":
+			GetDAOSpendingPath = route.Path
+		case "QueryUpgradeReadiness":
+			GetUpgradeReadinessPath = route.Path
+		case "QuerySimulateParams":
+			GetSimulateParamsPath = route.Path
 		case "QueryApps":
 			GetAppsPath = route.Path
 		case "QueryAppParams":
 			GetAppParamsPath = route.Path
+		case "QueryAppStakePreview":
+			GetAppStakePreviewPath = route.Path
 		case "QueryPocketParams":
 			GetPocketParamsPath = route.Path
 		case "QueryBlockTxs":
 			GetBlockTxsPath = route.Path
+		case "QueryMessageTypeTxs":
+			GetMessageTypeTxsPath = route.Path
+		case "QueryMemoTxs":
+			GetMemoTxsPath = route.Path
 		case "QuerySupply":
 			GetSupplyPath = route.Path
 		case "QueryNodeClaim":
@@ -100,6 +155,8 @@ func init() {
 			GetNodeClaimsPath = route.Path
 		case "QueryAllParams":
 			GetAllParamsPath = route.Path
+		case "QueryFee":
+			GetFeePath = route.Path
 		case "QueryParam":
 			GetParamPath = route.Path
 		case "Stop":
@@ -117,6 +117,9 @@ func start(cmd *cobra.Command, args []string) {
 		}
 		message := fmt.Sprintf("Exit signal %s received\n", sig)
 		fmt.Println(message)
+		if app.PCA != nil {
+			os.Exit(app.PCA.ExitCode())
+		}
 		os.Exit(0)
 	}()
 }
@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -71,23 +70,12 @@ If no changes are desired for the parameter, just enter the current param value
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Passphrase: ")
-		res, err := LegacyStakeNode(chains, serviceURI, fromAddr, app.Credentials(pwd), args[4], types.NewInt(int64(amount)), int64(fee), isBefore8)
+		res, err := LegacyStakeNode(chains, serviceURI, fromAddr, txPassphrase(), args[4], types.NewInt(int64(amount)), int64(fee), isBefore8)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(res)
 	},
 }
 
@@ -145,22 +133,11 @@ just as before.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Passphrase: ")
-		res, err := StakeNode(chains, serviceURI, operatorPubKey, output, app.Credentials(pwd), args[5], types.NewInt(int64(amount)), int64(fee), isBefore8)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := StakeNode(chains, serviceURI, operatorPubKey, output, txPassphrase(), args[5], types.NewInt(int64(amount)), int64(fee), isBefore8)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res)
 	},
 }
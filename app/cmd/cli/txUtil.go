@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -20,6 +22,7 @@ import (
 	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/rand"
 )
 
@@ -36,7 +39,7 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.Bi
 	if amount.LTE(sdk.ZeroInt()) {
 		return nil, sdk.ErrInternal("must send above 0")
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -59,17 +62,44 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.Bi
 	}, nil
 }
 
-// LegacyStakeNode - Deliver Stake message to node
-func LegacyStakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
+// SendBatchTransaction - Deliver a multi message transaction of the sends from fromAddr, executed atomically
+func SendBatchTransaction(fromAddr string, sends []nodeTypes.MsgSend, passphrase, chainID string, fees int64, memo string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
+	if len(sends) == 0 {
+		return nil, sdk.ErrInternal("no sends in the batch")
+	}
 	kb, err := app.GetKeybase()
 	if err != nil {
 		return nil, err
 	}
-	kp, err := kb.Get(fa)
+	msgs := make([]sdk.ProtoMsg, len(sends))
+	for i := range sends {
+		sends[i].FromAddress = fa
+		if err = sends[i].ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid send %d: %s", i, err.Error())
+		}
+		msgs[i] = &sends[i]
+	}
+	txBz, err := newMultiMsgTxBz(app.Codec(), msgs, fa, chainID, kb, passphrase, fees, memo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// LegacyStakeNode - Deliver Stake message to node
+func LegacyStakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
+	kb, err := txKeybase()
+	if err != nil {
+		return nil, err
+	}
+	fa, publicKey, err := txSigner(kb, fromAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -96,14 +126,14 @@ func LegacyStakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID
 	var msg sdk.ProtoMsg
 	if isBefore8 {
 		msg = &nodeTypes.LegacyMsgStake{
-			PublicKey:  kp.PublicKey,
+			PublicKey:  publicKey,
 			Chains:     chains,
 			Value:      amount,
 			ServiceUrl: serviceURL,
 		}
 	} else {
 		msg = &nodeTypes.MsgStake{
-			PublicKey:  kp.PublicKey,
+			PublicKey:  publicKey,
 			Chains:     chains,
 			Value:      amount,
 			ServiceUrl: serviceURL,
@@ -119,7 +149,7 @@ func LegacyStakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID
 		return nil, err
 	}
 	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
+		Addr:        fa.String(),
 		RawHexBytes: hex.EncodeToString(txBz),
 	}, nil
 }
@@ -165,7 +195,7 @@ func getFirstAddressAvailableInKeybase(
 
 // StakeNode - Deliver Stake message to node
 func StakeNode(chains []string, serviceURL, operatorPubKey, output, passphrase, chainID string, amount sdk.BigInt, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -191,11 +221,22 @@ func StakeNode(chains []string, serviceURL, operatorPubKey, output, passphrase,
 		operatorAddress,
 	}
 
-	if outputCur, err := getCurrentOutputAddress(operatorAddress); err == nil {
+	outputCur, err := getCurrentOutputAddress(operatorAddress)
+	if err == nil {
 		validSigners = append(validSigners, outputCur)
 	}
 
-	fromAddress := getFirstAddressAvailableInKeybase(kb, validSigners)
+	var fromAddress *sdk.Address
+	if generateOnly != "" {
+		// the key isn't on this machine, the transaction is signed offline by the output address,
+		// the current one when it is being changed
+		fromAddress = &outputAddress
+		if outputCur != nil {
+			fromAddress = &outputCur
+		}
+	} else {
+		fromAddress = getFirstAddressAvailableInKeybase(kb, validSigners)
+	}
 	if fromAddress == nil {
 		return nil, errors.New(
 			"None of the operator address, the new output address, or the current" +
@@ -266,7 +307,7 @@ func BuildStakeTx(
 	signerAddrStr,
 	passphrase string,
 ) (*rpc.SendRawTxParams, error) {
-	keybase, err := app.GetKeybase()
+	keybase, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -356,7 +397,7 @@ func UnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 			Signer:  fa,
 		}
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -395,7 +436,7 @@ func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 			Signer:        fa,
 		}
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -414,15 +455,11 @@ func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 }
 
 func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
-	fa, err := sdk.AddressFromHex(fromAddr)
-	if err != nil {
-		return nil, err
-	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
-	kp, err := kb.Get(fa)
+	fa, publicKey, err := txSigner(kb, fromAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -437,7 +474,7 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 		return nil, sdk.ErrInternal("must stake above zero")
 	}
 	msg := appsType.MsgStake{
-		PubKey: kp.PublicKey,
+		PubKey: publicKey,
 		Chains: chains,
 		Value:  amount,
 	}
@@ -450,7 +487,7 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 		return nil, err
 	}
 	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
+		Addr:        fa.String(),
 		RawHexBytes: hex.EncodeToString(txBz),
 	}, nil
 }
@@ -460,7 +497,7 @@ func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bo
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -481,6 +518,61 @@ func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bo
 	}, nil
 }
 
+// UnjailApp - Remove app from jail
+func UnjailApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := txKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := appsType.MsgUnjail{
+		AppAddr: fa,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// RevokeAppClients - Deliver a transaction to revoke client public keys of an app
+func RevokeAppClients(fromAddr string, clientPubKeys []string, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := txKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := appsType.MsgRevokeClients{
+		AppAddr:       fa,
+		ClientPubKeys: clientPubKeys,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 func TransferApp(
 	currentAppAddrStr, newAppPubKeyStr, passphrase, networkId string,
 	fee int64,
@@ -496,7 +588,7 @@ func TransferApp(
 		return nil, err
 	}
 
-	keybase, err := app.GetKeybase()
+	keybase, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -536,7 +628,7 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chain
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -560,12 +652,12 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chain
 	}, nil
 }
 
-func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, activationHeight int64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -576,9 +668,10 @@ func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passp
 
 	}
 	msg := govTypes.MsgChangeParam{
-		FromAddress: fa,
-		ParamKey:    paramACLKey,
-		ParamVal:    valueBytes,
+		FromAddress:      fa,
+		ParamKey:         paramACLKey,
+		ParamVal:         valueBytes,
+		ActivationHeight: activationHeight,
 	}
 	err = msg.ValidateBasic()
 	if err != nil {
@@ -599,7 +692,7 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -621,9 +714,178 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	}, nil
 }
 
+// SubmitProposal - Deliver a transaction proposing param changes and/or an upgrade
+func SubmitProposal(fromAddr string, changes map[string]json.RawMessage, upgrade *govTypes.Upgrade, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := txKeybase()
+	if err != nil {
+		return nil, err
+	}
+	// sort the keys so the proposal is deterministic
+	keys := make([]string, 0, len(changes))
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	paramChanges := make([]govTypes.ParamChange, 0, len(keys))
+	for _, key := range keys {
+		valueBytes, err := app.Codec().MarshalJSON(changes[key])
+		if err != nil {
+			return nil, err
+		}
+		paramChanges = append(paramChanges, govTypes.ParamChange{Key: key, Value: valueBytes})
+	}
+	msg := govTypes.MsgSubmitProposal{
+		Proposer: fa,
+		Changes:  paramChanges,
+		Upgrade:  upgrade,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// Vote - Deliver a transaction voting on a governance proposal
+func Vote(fromAddr string, proposalID uint64, approve bool, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := txKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgVote{
+		Voter:      fa,
+		ProposalID: proposalID,
+		Approve:    approve,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func CancelDAOTransfer(fromAddr string, transferID uint64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := txKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgCancelDAOTransfer{
+		FromAddress: fa,
+		TransferID:  transferID,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func CreateVestingAccount(fromAddr, toAddr, passphrase, chainID string, amount sdk.BigInt, startTime, endTime int64, delayed bool, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	ta, err := sdk.AddressFromHex(toAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := txKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.MsgCreateVestingAccount{
+		FromAddress: fa,
+		ToAddress:   ta,
+		Amount:      amount,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func SignalVersion(fromAddr, version, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := txKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgSignalVersion{
+		Address: fa,
+		Version: version,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// newTxBz - The signed and encoded transaction of msg; when the transaction is only generated (--generate-only)
+// it is written unsigned to the file instead, and no bytes are returned
 func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string, legacyCodec bool) (transactionBz []byte, err error) {
 	// fees
 	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee)))
+	if generateOnly != "" {
+		return nil, generateOfflineTx(cdc, msg, fromAddr, chainID, fees, memo)
+	}
+	if feePayer != "" {
+		return nil, errors.New("a transaction with a fee payer must be generated with --generate-only, to be co-signed with accounts sign-tx")
+	}
 	// entroyp
 	entropy := rand.Int64()
 	signBytes, err := auth.StdSignBytes(chainID, entropy, fees, msg, memo)
@@ -641,3 +903,157 @@ func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID s
 	}
 	return auth.DefaultTxEncoder(cdc)(tx, -1)
 }
+
+// newMultiMsgTxBz - The signed and encoded multi message transaction of msgs, all of them signed by fromAddr
+func newMultiMsgTxBz(cdc *codec.Codec, msgs []sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string) (transactionBz []byte, err error) {
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee)))
+	entropy := rand.Int64()
+	tx := authTypes.NewMultiMsgTx(msgs, fees, nil, memo, entropy)
+	signBytes, err := auth.StdSignBytesMulti(chainID, entropy, fees, tx.GetMsgs(), memo)
+	if err != nil {
+		return nil, err
+	}
+	sig, pubKey, err := keybase.Sign(fromAddr, passphrase, signBytes)
+	if err != nil {
+		return nil, err
+	}
+	tx.Signatures = []authTypes.StdSignature{{PublicKey: pubKey, Signature: sig}}
+	return auth.DefaultTxEncoder(cdc)(tx, -1)
+}
+
+// generateOnly - The file the unsigned transaction is written to, instead of signing and sending it
+var generateOnly string
+
+// feePayer - The address of the account paying the fee of the generated transaction, instead of its signer
+var feePayer string
+
+func init() {
+	for _, cmd := range []*cobra.Command{sendTxCmd, createVestingAccountCmd, appStakeCmd, appEditStakeCmd, appUnstakeCmd, appUnjailCmd, appRevokeClientsCmd,
+		appTransferCmd, govDAOTransfer, govDAOBurn, govChangeParam, govUpgrade, govFeatureEnable, govPropose, govVote,
+		govCancelTransfer, govSignalVersion, nodeUnstakeCmd, nodeUnjailCmd, stakeNewCmd, custodialStakeCmd, nonCustodialstakeCmd} {
+		cmd.Flags().StringVar(&generateOnly, "generate-only", "", "write the unsigned transaction to this file, to be signed offline with accounts sign-tx, instead of sending it")
+		cmd.Flags().StringVar(&feePayer, "fee-payer", "", "the address of the account paying the fee instead of the signer; it co-signs the transaction generated with --generate-only using accounts sign-tx --as-fee-payer")
+	}
+}
+
+// txKeybase - The keybase holding the key of the signer; a transaction that is only generated is signed offline,
+// so it doesn't need a keybase on this machine
+func txKeybase() (keys.Keybase, error) {
+	if generateOnly != "" {
+		return nil, nil
+	}
+	return app.GetKeybase()
+}
+
+// txSigner - The address and public key of the signer from the keybase; when the transaction is only generated the
+// key isn't on this machine, so the signer is given by its public key instead of its address
+func txSigner(kb keys.Keybase, signer string) (sdk.Address, crypto.PublicKey, error) {
+	if generateOnly != "" {
+		publicKey, err := crypto.NewPublicKey(signer)
+		if err != nil {
+			return nil, nil, fmt.Errorf("with --generate-only the signer is given by its public key: %s", err.Error())
+		}
+		return sdk.Address(publicKey.Address()), publicKey, nil
+	}
+	fa, err := sdk.AddressFromHex(signer)
+	if err != nil {
+		return nil, nil, err
+	}
+	kp, err := kb.Get(fa)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fa, kp.PublicKey, nil
+}
+
+// txPassphrase - Prompts for the passphrase of the signer, which isn't needed when the transaction is only generated
+func txPassphrase() string {
+	if generateOnly != "" {
+		return ""
+	}
+	fmt.Println("Enter passphrase: ")
+	return app.Credentials(pwd)
+}
+
+// sendRawTx - Sends the signed transaction through the node, unless it was only generated
+func sendRawTx(res *rpc.SendRawTxParams) {
+	if generateOnly != "" {
+		fmt.Printf("Unsigned transaction written to %s\n", generateOnly)
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	resp, err := QueryRPC(SendRawTxPath, j)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(resp)
+}
+
+// generateOfflineTx - Writes the unsigned transaction to the generateOnly file, with the chain data needed to sign
+// and encode it offline: the fee required by the message and the height of the codec upgrade
+func generateOfflineTx(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, fees sdk.Coins, memo string) error {
+	j, err := json.Marshal(rpc.FeeParams{MsgTypes: []string{msg.Type()}})
+	if err != nil {
+		return err
+	}
+	res, err := QueryRPC(GetFeePath, j)
+	if err != nil {
+		return err
+	}
+	var estimate app.FeeEstimate
+	if err = json.Unmarshal([]byte(res), &estimate); err != nil {
+		return err
+	}
+	if len(estimate.Fees) != 1 {
+		return fmt.Errorf("no fee was estimated for the message type %s", msg.Type())
+	}
+	j, err = json.Marshal(rpc.HeightParams{Height: estimate.Height})
+	if err != nil {
+		return err
+	}
+	res, err = QueryRPC(GetUpgradePath, j)
+	if err != nil {
+		return err
+	}
+	var upgrade govTypes.Upgrade
+	if err = json.Unmarshal([]byte(res), &upgrade); err != nil {
+		return err
+	}
+	codecUpgradeHeight := codec.CodecUpgradeHeight(upgrade.Height, upgrade.OldUpgradeHeight)
+	otx, err := authTypes.NewOfflineTx(cdc, chainID, msg, fees, memo, fromAddr, estimate.Height, codecUpgradeHeight, estimate.Fees[0])
+	if err != nil {
+		return err
+	}
+	if feePayer != "" {
+		fp, err := sdk.AddressFromHex(feePayer)
+		if err != nil {
+			return err
+		}
+		otx = otx.WithFeePayer(fp)
+	}
+	return writeOfflineTx(generateOnly, otx)
+}
+
+// readOfflineTx reads an offline transaction from its json file
+func readOfflineTx(path string) (otx authTypes.OfflineTx, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return otx, err
+	}
+	err = json.Unmarshal(bz, &otx)
+	return otx, err
+}
+
+// writeOfflineTx writes an offline transaction to its json file
+func writeOfflineTx(path string, otx authTypes.OfflineTx) error {
+	bz, err := json.MarshalIndent(otx, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, bz, 0644)
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// generateOnlyTestSetup - A node serving the fee and upgrade queries needed to generate a transaction, and a data
+// directory without a keybase
+func generateOnlyTestSetup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res interface{}
+		switch r.URL.Path {
+		case GetFeePath:
+			var params struct {
+				MsgTypes []string `json:"msg_types"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&params)
+			res = app.FeeEstimate{Height: 10, Fees: []authTypes.MsgFee{{MsgType: params.MsgTypes[0], BaseFee: sdk.NewInt(10000), Multiplier: 1, Fee: sdk.NewInt(10000)}}}
+		case GetUpgradePath:
+			res = govTypes.Upgrade{Height: 1, Version: "0.0.0"}
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	dataDir, remoteCLIURL := app.GlobalConfig.PocketConfig.DataDir, app.GlobalConfig.PocketConfig.RemoteCLIURL
+	app.GlobalConfig.PocketConfig.DataDir = t.TempDir()
+	app.GlobalConfig.PocketConfig.RemoteCLIURL = server.URL
+	generateOnly = filepath.Join(t.TempDir(), "tx.json")
+	t.Cleanup(func() {
+		server.Close()
+		app.GlobalConfig.PocketConfig.DataDir, app.GlobalConfig.PocketConfig.RemoteCLIURL = dataDir, remoteCLIURL
+		generateOnly = ""
+	})
+}
+
+func TestGenerateOnly_EmptyKeybase(t *testing.T) {
+	generateOnlyTestSetup(t)
+	_, err := app.GetKeybase()
+	assert.Equal(t, app.UninitializedKeybaseError, err)
+	signer := crypto.GenerateEd25519PrivKey().PublicKey()
+	signerAddr := sdk.Address(signer.Address())
+
+	// the app is given by its public key
+	_, err = StakeApp([]string{"0001"}, signerAddr.String(), "", "testnet", sdk.NewInt(1000000), 10000, false)
+	assert.NotNil(t, err)
+	res, err := StakeApp([]string{"0001"}, signer.RawString(), "", "testnet", sdk.NewInt(1000000), 10000, false)
+	assert.Nil(t, err)
+	assert.Equal(t, signerAddr.String(), res.Addr)
+	otx, err := readOfflineTx(generateOnly)
+	assert.Nil(t, err)
+	assert.Equal(t, signerAddr.String(), otx.Signer)
+	msg, err := otx.GetMsg(app.Codec())
+	assert.Nil(t, err)
+	assert.True(t, signer.Equals(msg.(*appsTypes.MsgStake).PubKey))
+
+	// so is the custodial node
+	_, err = LegacyStakeNode([]string{"0001"}, "https://www.pokt.network:443", signer.RawString(), "", "testnet", sdk.NewInt(1000000), 10000, false)
+	assert.Nil(t, err)
+	otx, err = readOfflineTx(generateOnly)
+	assert.Nil(t, err)
+	assert.Equal(t, signerAddr.String(), otx.Signer)
+	msg, err = otx.GetMsg(app.Codec())
+	assert.Nil(t, err)
+	assert.True(t, signer.Equals(msg.(*nodeTypes.MsgStake).PublicKey))
+
+	// a non custodial node is signed by its output address
+	operator := crypto.GenerateEd25519PrivKey().PublicKey()
+	_, err = StakeNode([]string{"0001"}, "https://www.pokt.network:443", operator.RawString(), signerAddr.String(), "", "testnet", sdk.NewInt(1000000), 10000, false)
+	assert.Nil(t, err)
+	otx, err = readOfflineTx(generateOnly)
+	assert.Nil(t, err)
+	assert.Equal(t, signerAddr.String(), otx.Signer)
+}
@@ -20,6 +20,7 @@ func init() {
 	utilCmd.AddCommand(decodeTxCmd)
 	utilCmd.AddCommand(exportGenesisForReset)
 	utilCmd.AddCommand(convertPocketEvidenceDB)
+	utilCmd.AddCommand(reindexTxsCmd)
 	utilCmd.AddCommand(completionCmd)
 	utilCmd.AddCommand(updateConfigsCmd)
 	utilCmd.AddCommand(printDefaultConfigCmd)
@@ -96,6 +97,17 @@ var decodeTxCmd = &cobra.Command{
 			fmt.Println("an error occurred unmarshalling the transaction string", err.Error())
 			return
 		}
+		if stdTx.IsMultiMsg() {
+			fmt.Printf("Fee:\t\t%s\nEntropy:\t%d\nMemo:\t\t%s\nSigners\t\t%v\n",
+				stdTx.GetFee().String(), stdTx.GetEntropy(), stdTx.GetMemo(), stdTx.GetSigners())
+			for i, msg := range stdTx.GetMsgs() {
+				fmt.Printf("Msg %d:\t\t%s %v\n", i, msg.Type(), msg)
+			}
+			for _, sig := range stdTx.Signatures {
+				fmt.Printf("Sig:\t\t%s\n", sig.GetPublicKey())
+			}
+			return
+		}
 		fmt.Printf(
 			"Type:\t\t%s\nMsg:\t\t%v\nFee:\t\t%s\nEntropy:\t%d\nMemo:\t\t%s\nSigners\t\t%v\nSig:\t\t%s\n",
 			stdTx.GetMsg().Type(), stdTx.GetMsg(), stdTx.GetFee().String(), stdTx.GetEntropy(), stdTx.GetMemo(), stdTx.GetMsg().GetSigners(),
@@ -155,6 +167,39 @@ var convertPocketEvidenceDB = &cobra.Command{
 	},
 }
 
+var reindexTxsCmd = &cobra.Command{
+	Use:   "reindex-txs [fromHeight]",
+	Short: "index the existing transactions by message type and memo",
+	Long: `Adds the message type index, the indexes of every message of the multi message transactions, and the memo index
+when index_tx_memos is enabled, to the transactions already indexed from fromHeight onwards (all of them by default).
+The node must be stopped.`,
+	Args: cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var fromHeight int64
+		if len(args) == 1 {
+			var err error
+			fromHeight, err = strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				fmt.Println("error parsing height: ", err)
+				return
+			}
+		}
+		db, err := app.OpenTxIndexerDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading the transaction indexer database: ", err)
+			return
+		}
+		defer db.Close()
+		count, err := app.NewTransactionIndexer(db).Reindex(fromHeight)
+		if err != nil {
+			fmt.Println("ERROR: ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully reindexed %d transactions\n", count)
+	},
+}
+
 var (
 	blocks bool
 )
@@ -14,6 +14,8 @@ import (
 	"github.com/julienschmidt/httprouter"
 
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
@@ -192,6 +194,61 @@ func SendRawTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+// SimulateTxParams - A raw tx, or a message (amino json) and the public key of its signer
+type SimulateTxParams struct {
+	RawHexBytes string          `json:"raw_hex_bytes"`
+	Msg         json.RawMessage `json:"msg"`
+	PubKey      string          `json:"pub_key"`
+	Memo        string          `json:"memo"`
+}
+
+func SimulateTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = SimulateTxParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	var res app.TxSimulation
+	if params.RawHexBytes != "" {
+		bz, err := hex.DecodeString(params.RawHexBytes)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		res, err = app.PCA.SimulateTx(bz)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+	} else {
+		if len(params.Msg) == 0 || params.PubKey == "" {
+			WriteErrorResponse(w, 400, "either raw_hex_bytes or msg and pub_key are required")
+			return
+		}
+		var msg sdk.ProtoMsg
+		if err := app.Codec().UnmarshalJSON(params.Msg, &msg); err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		pk, err := crypto.NewPublicKey(params.PubKey)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		res, err = app.PCA.SimulateUnsignedTx(msg, pk, params.Memo)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 type simRelayParams struct {
 	RelayNetworkID string        `json:"relay_network_id"` // RelayNetworkID
 	Payload        types.Payload `json:"payload"`          // the data payload of the request
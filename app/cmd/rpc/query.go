@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"net/http"
 	"strconv"
+	"time"
 
 	types4 "github.com/pokt-network/pocket-core/app/cmd/rpc/types"
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -98,6 +99,24 @@ type PaginatedHeightParams struct {
 	Sort    string `json:"order,omitempty"`
 }
 
+type PaginatedMessageTypeParams struct {
+	MessageType string `json:"message_type"`
+	Page        int    `json:"page,omitempty"`
+	PerPage     int    `json:"per_page,omitempty"`
+	Prove       bool   `json:"prove,omitempty"`
+	Sort        string `json:"order,omitempty"`
+	Height      int64  `json:"height,omitempty"`
+}
+
+type PaginatedMemoParams struct {
+	Memo    string `json:"memo"`
+	Page    int    `json:"page,omitempty"`
+	PerPage int    `json:"per_page,omitempty"`
+	Prove   bool   `json:"prove,omitempty"`
+	Sort    string `json:"order,omitempty"`
+	Height  int64  `json:"height,omitempty"`
+}
+
 type PaginatedHeightAndAddrParams struct {
 	Height  int64  `json:"height"`
 	Addr    string `json:"address"`
@@ -105,6 +124,62 @@ type PaginatedHeightAndAddrParams struct {
 	PerPage int    `json:"per_page,omitempty"`
 }
 
+type UnstakingParams struct {
+	Height      int64     `json:"height"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	StartHeight int64     `json:"start_height,omitempty"`
+	EndHeight   int64     `json:"end_height,omitempty"`
+	Page        int       `json:"page,omitempty"`
+	PerPage     int       `json:"per_page,omitempty"`
+}
+
+type ChainCoverageParams struct {
+	StartHeight int64 `json:"start_height"`
+	EndHeight   int64 `json:"end_height"`
+	Step        int64 `json:"step"`
+}
+
+type HeightAndProposalParams struct {
+	Height     int64  `json:"height"`
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+type ParamHistoryParams struct {
+	Height     int64  `json:"height"`
+	Key        string `json:"key"`
+	FromHeight int64  `json:"from_height"`
+	ToHeight   int64  `json:"to_height"`
+}
+
+type AppStakePreviewParams struct {
+	Height       int64      `json:"height"`
+	Address      string     `json:"address"`
+	Amount       sdk.BigInt `json:"amount"`
+	AddChains    []string   `json:"add_chains"`
+	RemoveChains []string   `json:"remove_chains"`
+}
+
+type SimulateParamsParams struct {
+	Height  int64                      `json:"height"`
+	Changes map[string]json.RawMessage `json:"changes"`
+}
+
+type FeeParams struct {
+	Height      int64    `json:"height"`
+	MsgTypes    []string `json:"msg_types"`
+	RawHexBytes string   `json:"raw_hex_bytes"`
+}
+
+type HeightAndVersionParams struct {
+	Height  int64  `json:"height"`
+	Version string `json:"version"`
+}
+
+type RelayUsageParams struct {
+	AppPubKey string `json:"app_pubkey"`
+}
+
 func Block(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -189,11 +264,13 @@ type RPCResultUnconfirmedTx struct {
 type RPCStdTx types2.StdTx
 
 type rPCStdTx struct {
-	Msg       json.RawMessage `json:"msg" yaml:"msg"`
-	Fee       sdk.Coins       `json:"fee" yaml:"fee"`
-	Signature RPCStdSignature `json:"signature" yaml:"signature"`
-	Memo      string          `json:"memo" yaml:"memo"`
-	Entropy   int64           `json:"entropy" yaml:"entropy"`
+	Msg        json.RawMessage   `json:"msg" yaml:"msg"`
+	Fee        sdk.Coins         `json:"fee" yaml:"fee"`
+	Signature  RPCStdSignature   `json:"signature" yaml:"signature"`
+	Memo       string            `json:"memo" yaml:"memo"`
+	Entropy    int64             `json:"entropy" yaml:"entropy"`
+	Msgs       []json.RawMessage `json:"msgs,omitempty" yaml:"msgs"`
+	Signatures []RPCStdSignature `json:"signatures,omitempty" yaml:"signatures"`
 }
 
 type RPCStdSignature struct {
@@ -202,6 +279,26 @@ type RPCStdSignature struct {
 }
 
 func (r RPCStdTx) MarshalJSON() ([]byte, error) {
+	if (types2.StdTx)(r).IsMultiMsg() {
+		msgs := make([]json.RawMessage, len(r.Msgs))
+		for i, msg := range r.Msgs {
+			msgs[i] = msg.GetSignBytes()
+		}
+		sigs := make([]RPCStdSignature, len(r.Signatures))
+		for i, s := range r.Signatures {
+			sigs[i] = RPCStdSignature{
+				PublicKey: s.GetPublicKey(),
+				Signature: hex.EncodeToString(s.Signature),
+			}
+		}
+		return json.Marshal(rPCStdTx{
+			Fee:        r.Fee,
+			Memo:       r.Memo,
+			Entropy:    r.Entropy,
+			Msgs:       msgs,
+			Signatures: sigs,
+		})
+	}
 	if r.Msg == nil {
 		return json.Marshal(rPCStdTx{})
 	}
@@ -281,14 +378,13 @@ func ResultUnconfirmedTxToRPC(res types.Tx, height int64) *RPCResultUnconfirmedT
 	}
 
 	stdTx, err := app.UnmarshalTx(res, height)
-
-	r.MessageType = stdTx.Msg.Type()
-
 	if err != nil {
 		fmt.Println("an error occurred unmarshalling the transaction", err.Error())
 		return r
 	}
 
+	r.MessageType = stdTx.GetMsg().Type()
+
 	r.StdTx = RPCStdTx(stdTx)
 
 	return r
@@ -336,6 +432,46 @@ func AccountTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+func MessageTypeTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginatedMessageTypeParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryMessageTypeTxs(params.MessageType, params.Page, params.PerPage, params.Prove, params.Sort, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	rpcResponse := ResultTxSearchToRPC(res)
+	s, er := json.MarshalIndent(rpcResponse, "", "  ")
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
+func MemoTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginatedMemoParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryMemoTxs(params.Memo, params.Page, params.PerPage, params.Prove, params.Sort, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	rpcResponse := ResultTxSearchToRPC(res)
+	s, er := json.MarshalIndent(rpcResponse, "", "  ")
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
 func BlockTxs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = PaginatedHeightParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -494,9 +630,40 @@ func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	vesting, err := app.PCA.QueryVestingBalances(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if vesting != nil {
+		s, err = withVestingBalances(s, vesting)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+	}
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+// withVestingBalances - Adds the vested, vesting and spendable coins to the json of a vesting account
+func withVestingBalances(accountJSON []byte, balances *app.VestingBalances) ([]byte, error) {
+	var account, vesting map[string]json.RawMessage
+	if err := json.Unmarshal(accountJSON, &account); err != nil {
+		return nil, err
+	}
+	vestingJSON, err := json.Marshal(balances)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(vestingJSON, &vesting); err != nil {
+		return nil, err
+	}
+	for key, value := range vesting {
+		account[key] = value
+	}
+	return json.Marshal(account)
+}
+
 func Accounts(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = PaginatedHeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -595,6 +762,81 @@ func SigningInfo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func SlashHistory(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginatedHeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QuerySlashHistory(params.Addr, params.Height, params.Page, params.PerPage)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.JSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Unstaking(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = UnstakingParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	window := app.UnstakingWindow{
+		StartTime:   params.StartTime,
+		EndTime:     params.EndTime,
+		StartHeight: params.StartHeight,
+		EndHeight:   params.EndHeight,
+	}
+	res, err := app.PCA.QueryUnstaking(params.Height, window, params.Page, params.PerPage)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.JSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ChainCoverage(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = ChainCoverageParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.EndHeight == 0 {
+		params.EndHeight = app.PCA.BaseApp.LastBlockHeight()
+	}
+	if params.StartHeight == 0 {
+		params.StartHeight = params.EndHeight
+	}
+	res, err := app.PCA.QueryChainCoverage(params.StartHeight, params.EndHeight, params.Step)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func SecondUpgrade(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -631,6 +873,74 @@ func Chains(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	}
 }
 
+func RelayUsage(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	var params = RelayUsageParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryRelayUsage(params.AppPubKey)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func RelayAllowance(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryRelayAllowance(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func RevokedClients(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryRevokedClients(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func NodeParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -1023,3 +1333,241 @@ func State(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 	WriteRaw(w, res, r.URL.Path, r.Host)
 }
+
+func Proposals(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndProposalParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	var res interface{}
+	var err error
+	// a zero proposal id returns every proposal
+	if params.ProposalID == 0 {
+		res, err = app.PCA.QueryProposals(params.Height)
+	} else {
+		res, err = app.PCA.QueryProposal(params.ProposalID, params.Height)
+	}
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Votes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndProposalParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryVotes(params.ProposalID, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func PendingParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryPendingParamChanges(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ParamHistory(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = ParamHistoryParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryParamHistory(params.Key, params.FromHeight, params.ToHeight, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func DAOQueue(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryDAOQueue(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func DAOSpending(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryDAOSpending(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func UpgradeReadiness(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndVersionParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryUpgradeReadiness(params.Version, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func SimulateParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = SimulateParamsParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.SimulateParamChanges(params.Changes, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func AppStakePreview(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = AppStakePreviewParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.PreviewAppEditStake(params.Address, params.Amount, params.AddChains, params.RemoveChains, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Fee(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = FeeParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	var res app.FeeEstimate
+	var err error
+	if params.RawHexBytes != "" {
+		bz, er := hex.DecodeString(params.RawHexBytes)
+		if er != nil {
+			WriteErrorResponse(w, 400, er.Error())
+			return
+		}
+		res, err = app.PCA.QueryTxFee(bz, params.Height)
+	} else {
+		res, err = app.PCA.QueryFees(params.MsgTypes, params.Height)
+	}
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
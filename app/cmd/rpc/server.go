@@ -92,6 +92,7 @@ func GetRoutes() Routes {
 		Route{Name: "HandleDispatch", Method: "POST", Path: "/v1/client/dispatch", HandlerFunc: Dispatch},
 		Route{Name: "HandleDispatchCORS", Method: "OPTIONS", Path: "/v1/client/dispatch", HandlerFunc: Dispatch},
 		Route{Name: "SendRawTx", Method: "POST", Path: "/v1/client/rawtx", HandlerFunc: SendRawTx},
+		Route{Name: "SimulateTx", Method: "POST", Path: "/v1/client/simulate", HandlerFunc: SimulateTx},
 		Route{Name: "Service", Method: "POST", Path: "/v1/client/relay", HandlerFunc: Relay},
 		Route{Name: "Stop", Method: "POST", Path: "/v1/private/stop", HandlerFunc: Stop},
 		Route{Name: "ServiceCORS", Method: "OPTIONS", Path: "/v1/client/relay", HandlerFunc: Relay},
@@ -100,14 +101,18 @@ func GetRoutes() Routes {
 		Route{Name: "QueryAccountTxs", Method: "POST", Path: "/v1/query/accounttxs", HandlerFunc: AccountTxs},
 		Route{Name: "QueryACL", Method: "POST", Path: "/v1/query/acl", HandlerFunc: ACL},
 		Route{Name: "QueryAllParams", Method: "POST", Path: "/v1/query/allparams", HandlerFunc: AllParams},
+		Route{Name: "QueryFee", Method: "POST", Path: "/v1/query/fee", HandlerFunc: Fee},
 		Route{Name: "QueryApp", Method: "POST", Path: "/v1/query/app", HandlerFunc: App},
 		Route{Name: "QueryAppParams", Method: "POST", Path: "/v1/query/appparams", HandlerFunc: AppParams},
+		Route{Name: "QueryAppStakePreview", Method: "POST", Path: "/v1/query/appstakepreview", HandlerFunc: AppStakePreview},
 		Route{Name: "QueryApps", Method: "POST", Path: "/v1/query/apps", HandlerFunc: Apps},
 		Route{Name: "QueryBalance", Method: "POST", Path: "/v1/query/balance", HandlerFunc: Balance},
 		Route{Name: "QueryBlock", Method: "POST", Path: "/v1/query/block", HandlerFunc: Block},
 		Route{Name: "QueryBlockTxs", Method: "POST", Path: "/v1/query/blocktxs", HandlerFunc: BlockTxs},
 		Route{Name: "QueryDAOOwner", Method: "POST", Path: "/v1/query/daoowner", HandlerFunc: DAOOwner},
 		Route{Name: "QueryHeight", Method: "POST", Path: "/v1/query/height", HandlerFunc: Height},
+		Route{Name: "QueryMemoTxs", Method: "POST", Path: "/v1/query/memotxs", HandlerFunc: MemoTxs},
+		Route{Name: "QueryMessageTypeTxs", Method: "POST", Path: "/v1/query/msgtypetxs", HandlerFunc: MessageTypeTxs},
 		Route{Name: "QueryNode", Method: "POST", Path: "/v1/query/node", HandlerFunc: Node},
 		Route{Name: "QueryNodeClaim", Method: "POST", Path: "/v1/query/nodeclaim", HandlerFunc: NodeClaim},
 		Route{Name: "QueryNodeClaims", Method: "POST", Path: "/v1/query/nodeclaims", HandlerFunc: NodeClaims},
@@ -121,8 +126,22 @@ func GetRoutes() Routes {
 		Route{Name: "QueryTX", Method: "POST", Path: "/v1/query/tx", HandlerFunc: Tx},
 		Route{Name: "QueryUpgrade", Method: "POST", Path: "/v1/query/upgrade", HandlerFunc: Upgrade},
 		Route{Name: "QuerySigningInfo", Method: "POST", Path: "/v1/query/signinginfo", HandlerFunc: SigningInfo},
+		Route{Name: "QuerySlashHistory", Method: "POST", Path: "/v1/query/slashhistory", HandlerFunc: SlashHistory},
+		Route{Name: "QueryUnstaking", Method: "POST", Path: "/v1/query/unstaking", HandlerFunc: Unstaking},
+		Route{Name: "QueryChainCoverage", Method: "POST", Path: "/v1/query/chaincoverage", HandlerFunc: ChainCoverage},
+		Route{Name: "QueryRelayAllowance", Method: "POST", Path: "/v1/query/relayallowance", HandlerFunc: RelayAllowance},
+		Route{Name: "QueryRevokedClients", Method: "POST", Path: "/v1/query/revokedclients", HandlerFunc: RevokedClients},
+		Route{Name: "QueryProposals", Method: "POST", Path: "/v1/query/proposals", HandlerFunc: Proposals},
+		Route{Name: "QueryVotes", Method: "POST", Path: "/v1/query/votes", HandlerFunc: Votes},
+		Route{Name: "QueryPendingParams", Method: "POST", Path: "/v1/query/pendingparams", HandlerFunc: PendingParams},
+		Route{Name: "QueryParamHistory", Method: "POST", Path: "/v1/query/paramhistory", HandlerFunc: ParamHistory},
+		Route{Name: "QueryDAOQueue", Method: "POST", Path: "/v1/query/daoqueue", HandlerFunc: DAOQueue},
+		Route{Name: "QueryDAOSpending", Method: "POST", Path: "/v1/query/daospending", HandlerFunc: DAOSpending},
+		Route{Name: "QueryUpgradeReadiness", Method: "POST", Path: "/v1/query/upgradereadiness", HandlerFunc: UpgradeReadiness},
+		Route{Name: "QuerySimulateParams", Method: "POST", Path: "/v1/query/simulateparams", HandlerFunc: SimulateParams},
 		Route{Name: "LocalNodes", Method: "POST", Path: "/v1/private/nodes", HandlerFunc: LocalNodes},
 		Route{Name: "QueryChains", Method: "POST", Path: "/v1/private/chains", HandlerFunc: Chains},
+		Route{Name: "QueryRelayUsage", Method: "POST", Path: "/v1/private/relayusage", HandlerFunc: RelayUsage},
 		Route{Name: "QueryUnconfirmedTxs", Method: "POST", Path: "/v1/query/unconfirmedtxs", HandlerFunc: UnconfirmedTxs},
 		Route{Name: "QueryUnconfirmedTx", Method: "POST", Path: "/v1/query/unconfirmedtx", HandlerFunc: UnconfirmedTx},
 	}
@@ -110,6 +110,10 @@ func InitApp(datadir, tmNode, persistentPeers, seeds, remoteCLIURL string, keyba
 	}
 	// init key files
 	InitKeyfiles(logger)
+	// init the signer of the automatic node maintenance transactions
+	if GlobalConfig.PocketConfig.IsNodeMaintenanceEnabled() {
+		InitMaintenanceKey(logger)
+	}
 	// init configs & evidence/session caches
 	InitPocketCoreConfig(chains, logger)
 	// init genesis
@@ -369,6 +373,17 @@ func InitTendermint(keybase bool, chains *types.HostedBlockchains, logger log.Lo
 		log2.Fatal(err)
 	}
 	app.pocketKeeper.TmNode = local.New(tmNode)
+	// the stake bins are chain params, so the auto stake floor is checked against the state the node starts with
+	if GlobalConfig.PocketConfig.AutoStakeFloor > 0 && app.LastBlockHeight() > 0 {
+		ctx, err := app.NewContext(app.LastBlockHeight())
+		if err != nil {
+			log2.Fatal(err)
+		}
+		if err := app.pocketKeeper.ValidateAutoStakeFloor(ctx); err != nil {
+			logger.Error("Invalid auto_stake_floor in the config: " + err.Error())
+			os.Exit(1)
+		}
+	}
 	if err := tmNode.Start(); err != nil {
 		log2.Fatal(err)
 	}
@@ -437,6 +452,28 @@ func InitNodesLean(logger log.Logger) error {
 	return nil
 }
 
+// InitMaintenanceKey loads the key used to sign automatic unjail/stake transactions, defaulting to the node keys
+func InitMaintenanceKey(logger log.Logger) {
+	keyPath := GlobalConfig.PocketConfig.GetMaintenanceKeyFilePath()
+	if _, err := os.Stat(keyPath); err != nil && os.IsNotExist(err) {
+		logger.Info("No maintenance key found in " + keyPath + ", the pocket nodes will sign their own maintenance transactions")
+		if GlobalConfig.PocketConfig.AutoStakeFloor > 0 {
+			logger.Info("The stake is only topped up for the custodial nodes, the output address of a non-custodial node pays for it and must be the maintenance key")
+		}
+		return
+	}
+	key, err := ReadMaintenanceKeyFile(keyPath)
+	if err != nil {
+		logger.Error("Can't read the maintenance key file", keyPath, err)
+		os.Exit(1)
+	}
+	logger.Info("Using " + key.PublicKey().Address().String() + " to sign the maintenance transactions")
+	if GlobalConfig.PocketConfig.AutoStakeFloor > 0 {
+		logger.Info("The stake is only topped up for the nodes with " + key.PublicKey().Address().String() + " as output address, which pays for it")
+	}
+	types.GlobalMaintenanceKey = key
+}
+
 func InitLogger() (logger log.Logger) {
 	logger = log.NewTMLoggerWithColorFn(log.NewSyncWriter(os.Stdout), func(keyvals ...interface{}) term.FgBgColor {
 		if keyvals[0] != kitlevel.Key() {
@@ -943,6 +980,18 @@ func ReadValidatorPrivateKeyFileLean(filePath string) ([]crypto.PrivateKey, erro
 	return pks, nil
 }
 
+func ReadMaintenanceKeyFile(filePath string) (crypto.PrivateKey, error) {
+	var pkf privval.PrivateKeyFile
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("an error occurred attempting to read the key file: %s", err.Error())
+	}
+	if err := json.Unmarshal(data, &pkf); err != nil {
+		return nil, fmt.Errorf("an error occurred unmarshalling the key into json format. Please make sure the input for this is a proper json object with priv_key as key value")
+	}
+	return crypto.NewPrivateKey(pkf.PrivateKey)
+}
+
 func SetValidatorsFilesLean(keys []crypto.PrivateKey) error {
 	if len(keys) == 0 {
 		return errors.New("user key file contained zero validator keys")
@@ -6,18 +6,23 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
+	appsExported "github.com/pokt-network/pocket-core/x/apps/exported"
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/auth/exported"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/pokt-network/pocket-core/x/auth/util"
 	"github.com/pokt-network/pocket-core/x/gov/types"
+	nodesExported "github.com/pokt-network/pocket-core/x/nodes/exported"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
@@ -27,6 +32,8 @@ const (
 	heightQuery            = "tx.height=%d"
 	transferRecipientQuery = "tx.recipient='%s'"
 	txHeightQuery          = "tx.height=%d"
+	messageTypeQuery       = "tx.message_type='%s'"
+	memoQuery              = "tx.memo='%s'"
 )
 
 // zero for height = latest
@@ -83,6 +90,39 @@ func (app PocketCoreApp) QueryRecipientTxs(addr string, page, perPage int, prove
 	return
 }
 
+// QueryMessageTypeTxs - The transactions of a message type, from height onwards when it isn't zero
+func (app PocketCoreApp) QueryMessageTypeTxs(messageType string, page, perPage int, prove bool, sort string, height int64) (res *core_types.ResultTxSearch, err error) {
+	if messageType == "" || strings.ContainsAny(messageType, "'/ ") {
+		return nil, fmt.Errorf("invalid message type: %q", messageType)
+	}
+	tmClient := app.GetClient()
+	defer func() { _ = tmClient.Stop() }()
+	query := fmt.Sprintf(messageTypeQuery, messageType)
+	if height > 0 {
+		query = fmt.Sprintf("%s AND %s", query, fmt.Sprintf(heightQuery, height))
+	}
+	page, perPage = checkPagination(page, perPage)
+	res, err = tmClient.TxSearch(query, prove, page, perPage, checkSort(sort))
+	return
+}
+
+// QueryMemoTxs - The transactions with the memo, from height onwards when it isn't zero; the memos are only indexed by
+// the nodes with index_tx_memos enabled
+func (app PocketCoreApp) QueryMemoTxs(memo string, page, perPage int, prove bool, sort string, height int64) (res *core_types.ResultTxSearch, err error) {
+	if memo == "" {
+		return nil, fmt.Errorf("the memo is empty")
+	}
+	tmClient := app.GetClient()
+	defer func() { _ = tmClient.Stop() }()
+	query := fmt.Sprintf(memoQuery, hex.EncodeToString([]byte(memo)))
+	if height > 0 {
+		query = fmt.Sprintf("%s AND %s", query, fmt.Sprintf(heightQuery, height))
+	}
+	page, perPage = checkPagination(page, perPage)
+	res, err = tmClient.TxSearch(query, prove, page, perPage, checkSort(sort))
+	return
+}
+
 func (app PocketCoreApp) QueryBlockTxs(height int64, page, perPage int, prove bool, sort string) (res *core_types.ResultTxSearch, err error) {
 	tmClient := app.GetClient()
 	defer func() { _ = tmClient.Stop() }()
@@ -228,6 +268,35 @@ func (app PocketCoreApp) QueryAccount(addr string, height int64) (res *exported.
 	return &acc, nil
 }
 
+// VestingBalances - The coins of a vesting account that are vested, still vesting and spendable at the time of a block
+type VestingBalances struct {
+	VestedCoins    sdk.Coins `json:"vested_coins"`
+	VestingCoins   sdk.Coins `json:"vesting_coins"`
+	SpendableCoins sdk.Coins `json:"spendable_coins"`
+}
+
+// QueryVestingBalances - The balances of the vesting account at height, nil if the account is not a vesting account
+func (app PocketCoreApp) QueryVestingBalances(addr string, height int64) (res *VestingBalances, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	vacc, ok := app.accountKeeper.GetAccount(ctx, a).(exported.VestingAccount)
+	if !ok {
+		return nil, nil
+	}
+	blockTime := ctx.BlockHeader().Time
+	return &VestingBalances{
+		VestedCoins:    vacc.GetVestedCoins(blockTime),
+		VestingCoins:   vacc.GetVestingCoins(blockTime),
+		SpendableCoins: vacc.SpendableCoins(blockTime),
+	}, nil
+}
+
 func (app PocketCoreApp) QueryAccounts(height int64, page, perPage int) (res Page, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -323,6 +392,124 @@ func (app PocketCoreApp) QuerySigningInfos(address string, height int64, page, p
 	return paginate(page, perPage, signingInfos, int(app.nodesKeeper.MaxValidators(ctx)))
 }
 
+func (app PocketCoreApp) QuerySlashHistory(address string, height int64, page, perPage int) (res Page, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	var records []nodesTypes.SlashRecord
+	page, perPage = checkPagination(page, perPage)
+	if address != "" {
+		addr, err := sdk.AddressFromHex(address)
+		if err != nil {
+			return Page{}, err
+		}
+		records = app.nodesKeeper.GetSlashHistory(ctx, addr)
+	} else {
+		records = make([]nodesTypes.SlashRecord, 0)
+		app.nodesKeeper.IterateAndExecuteOverSlashHistory(ctx, func(record nodesTypes.SlashRecord) (stop bool) {
+			records = append(records, record)
+			return false
+		})
+	}
+	return paginate(page, perPage, records, int(app.nodesKeeper.MaxValidators(ctx)))
+}
+
+// UnstakingEntry is a node or app scheduled to finish unstaking
+type UnstakingEntry struct {
+	Address         sdk.Address `json:"address"`
+	Type            string      `json:"type"`
+	Amount          sdk.BigInt  `json:"amount"`
+	OutputAddress   sdk.Address `json:"output_address"`
+	UnstakingTime   time.Time   `json:"unstaking_time"`
+	EstimatedHeight int64       `json:"estimated_height"`
+}
+
+const (
+	UnstakingEntryNode = "node"
+	UnstakingEntryApp  = "app"
+)
+
+// UnstakingWindow bounds the unstaking queue query; times take precedence over heights and unset bounds are open
+type UnstakingWindow struct {
+	StartTime   time.Time
+	EndTime     time.Time
+	StartHeight int64
+	EndHeight   int64
+}
+
+// resolve converts the window into inclusive time bounds relative to the block at height
+func (w UnstakingWindow) resolve(height int64, blockTime time.Time) (start, end time.Time) {
+	switch {
+	case !w.StartTime.IsZero():
+		start = w.StartTime
+	case w.StartHeight > 0:
+		start = EstimateTimeAtHeight(height, blockTime, w.StartHeight)
+	default:
+		start = time.Unix(0, 0).UTC()
+	}
+	switch {
+	case !w.EndTime.IsZero():
+		end = w.EndTime
+	case w.EndHeight > 0:
+		end = EstimateTimeAtHeight(height, blockTime, w.EndHeight)
+	default:
+		end = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	}
+	return
+}
+
+// QueryUnstaking returns the nodes and apps that finish unstaking within the window, ordered by unstaking time
+func (app PocketCoreApp) QueryUnstaking(height int64, window UnstakingWindow, page, perPage int) (res Page, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	start, end := window.resolve(ctx.BlockHeight(), ctx.BlockTime())
+	page, perPage = checkPagination(page, perPage)
+	entries := make([]UnstakingEntry, 0)
+	for _, v := range app.nodesKeeper.GetUnstakingValidatorsInWindow(ctx, start, end) {
+		output, _ := app.nodesKeeper.GetValidatorOutputAddress(ctx, v.Address)
+		entries = append(entries, UnstakingEntry{
+			Address:         v.Address,
+			Type:            UnstakingEntryNode,
+			Amount:          v.StakedTokens,
+			OutputAddress:   output,
+			UnstakingTime:   v.UnstakingCompletionTime,
+			EstimatedHeight: EstimateHeightAtTime(ctx.BlockHeight(), ctx.BlockTime(), v.UnstakingCompletionTime),
+		})
+	}
+	for _, a := range app.appsKeeper.GetUnstakingApplicationsInWindow(ctx, start, end) {
+		entries = append(entries, UnstakingEntry{
+			Address:         a.Address,
+			Type:            UnstakingEntryApp,
+			Amount:          a.StakedTokens,
+			OutputAddress:   a.Address,
+			UnstakingTime:   a.UnstakingCompletionTime,
+			EstimatedHeight: EstimateHeightAtTime(ctx.BlockHeight(), ctx.BlockTime(), a.UnstakingCompletionTime),
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].UnstakingTime.Before(entries[j].UnstakingTime)
+	})
+	return paginate(page, perPage, entries, 10000)
+}
+
+// EstimateHeightAtTime estimates the first height at or after t, assuming a block every CreateEmptyBlocksInterval
+func EstimateHeightAtTime(height int64, blockTime, t time.Time) int64 {
+	interval := GlobalConfig.TendermintConfig.Consensus.CreateEmptyBlocksInterval
+	if interval <= 0 || !t.After(blockTime) {
+		return height
+	}
+	return height + int64(math.Ceil(float64(t.Sub(blockTime))/float64(interval)))
+}
+
+// EstimateTimeAtHeight estimates the block time of h, assuming a block every CreateEmptyBlocksInterval
+func EstimateTimeAtHeight(height int64, blockTime time.Time, h int64) time.Time {
+	interval := GlobalConfig.TendermintConfig.Consensus.CreateEmptyBlocksInterval
+	return blockTime.Add(time.Duration(h-height) * interval)
+}
+
 func (app PocketCoreApp) QueryTotalNodeCoins(height int64) (stakedTokens sdk.BigInt, totalTokens sdk.BigInt, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -365,6 +552,83 @@ func (app PocketCoreApp) QueryACL(height int64) (res types.ACL, err error) {
 	return app.govKeeper.GetACL(ctx), nil
 }
 
+// QueryProposals returns all of the governance proposals at height
+func (app PocketCoreApp) QueryProposals(height int64) (res []types.Proposal, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetProposals(ctx), nil
+}
+
+// QueryProposal returns the governance proposal with id at height
+func (app PocketCoreApp) QueryProposal(id uint64, height int64) (res types.Proposal, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res, found := app.govKeeper.GetProposal(ctx, id)
+	if !found {
+		return res, types.ErrProposalNotFound(types.DefaultCodespace, id)
+	}
+	return
+}
+
+// QueryVotes returns the votes on the governance proposal with id at height
+func (app PocketCoreApp) QueryVotes(id uint64, height int64) (res []types.Vote, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetVotes(ctx, id), nil
+}
+
+// QueryPendingParamChanges returns the param changes scheduled for a later height at height
+func (app PocketCoreApp) QueryPendingParamChanges(height int64) (res []types.PendingParamChange, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetPendingParamChanges(ctx), nil
+}
+
+// QueryDAOQueue returns the dao transfers and burns waiting for their timelock at height
+func (app PocketCoreApp) QueryDAOQueue(height int64) (res []types.QueuedDAOTransfer, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetQueuedDAOTransfers(ctx), nil
+}
+
+// QueryDAOSpending returns the dao spending of the current period against its cap at height
+func (app PocketCoreApp) QueryDAOSpending(height int64) (res types.DAOSpendingStatus, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetDAOSpendingStatus(ctx), nil
+}
+
+// QueryUpgradeReadiness returns the share of the stake that signalled at least version
+// (the scheduled upgrade version if empty) at height
+func (app PocketCoreApp) QueryUpgradeReadiness(version string, height int64) (res types.UpgradeReadiness, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetUpgradeReadiness(ctx, version), nil
+}
+
+// QueryParamHistory returns the applied changes of key (all keys if empty) between fromHeight and toHeight at height
+func (app PocketCoreApp) QueryParamHistory(key string, fromHeight, toHeight, height int64) (res []types.ParamChangeRecord, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetParamHistory(ctx, key, fromHeight, toHeight), nil
+}
+
 type AllParamsReturn struct {
 	AppParams    []SingleParamReturn `json:"app_params"`
 	NodeParams   []SingleParamReturn `json:"node_params"`
@@ -373,6 +637,70 @@ type AllParamsReturn struct {
 	AuthParams   []SingleParamReturn `json:"auth_params"`
 }
 
+// FeeEstimate - The fees required at a height, broken down by message type
+type FeeEstimate struct {
+	Height int64              `json:"height"`
+	Fees   []authTypes.MsgFee `json:"fees"`
+}
+
+// msgBaseFees - The fee of every message type before its multiplier is applied
+func msgBaseFees() map[string]int64 {
+	fees := make(map[string]int64)
+	for _, feeMap := range []map[string]int64{nodesTypes.NodeFeeMap, appsTypes.AppFeeMap, pocketTypes.PocketFeeMap, types.GovFeeMap, authTypes.AuthFeeMap} {
+		for msgType, fee := range feeMap {
+			fees[msgType] = fee
+		}
+	}
+	return fees
+}
+
+// QueryFees - The fees required at height by the msg types, every msg type if none is given
+func (app PocketCoreApp) QueryFees(msgTypes []string, height int64) (res FeeEstimate, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	baseFees := msgBaseFees()
+	if len(msgTypes) == 0 {
+		for msgType := range baseFees {
+			msgTypes = append(msgTypes, msgType)
+		}
+		sort.Strings(msgTypes)
+	}
+	feeMultipliers := app.accountKeeper.GetParams(ctx).FeeMultiplier
+	res = FeeEstimate{Height: height, Fees: make([]authTypes.MsgFee, 0, len(msgTypes))}
+	for _, msgType := range msgTypes {
+		baseFee, found := baseFees[msgType]
+		if !found {
+			return FeeEstimate{}, fmt.Errorf("unknown message type: %s", msgType)
+		}
+		res.Fees = append(res.Fees, feeMultipliers.GetMsgFee(msgType, sdk.NewInt(baseFee)))
+	}
+	return
+}
+
+// QueryTxFee - The fee required at height by the transaction, which does not need to be signed
+func (app PocketCoreApp) QueryTxFee(txBytes []byte, height int64) (res FeeEstimate, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	tx, err := UnmarshalTx(txBytes, height)
+	if err != nil {
+		return
+	}
+	if tx.GetMsg() == nil {
+		return res, fmt.Errorf("the transaction has no message")
+	}
+	// a multi message transaction requires the fee of every message
+	feeMultipliers := app.accountKeeper.GetParams(ctx).FeeMultiplier
+	res.Height = height
+	for _, msg := range tx.GetMsgs() {
+		res.Fees = append(res.Fees, feeMultipliers.GetMsgFee(msg.Type(), msg.GetFee()))
+	}
+	return
+}
+
 type SingleParamReturn struct {
 	Key   string `json:"param_key"`
 	Value string `json:"param_value"`
@@ -448,6 +776,131 @@ func (app PocketCoreApp) QueryParam(height int64, paramkey string) (r SinglePara
 	return
 }
 
+// ParamChangeSimulation is the outcome of applying param changes to the state at a height, without broadcasting them
+type ParamChangeSimulation struct {
+	Height           int64                  `json:"height"`
+	Changes          []SimulatedParamChange `json:"changes"`
+	ValidationErrors []string               `json:"validation_errors"`
+	RelayRewards     []SimulatedRelayReward `json:"relay_rewards"`
+	MaxRelays        []SimulatedMaxRelays   `json:"max_relays"`
+}
+
+// SimulatedParamChange is a single param change of a simulation
+type SimulatedParamChange struct {
+	Key      string      `json:"param_key"`
+	Owner    sdk.Address `json:"owner"`
+	OldValue string      `json:"old_value"`
+	NewValue string      `json:"new_value"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// SimulatedRelayReward is the reward of a sample node for a single relay before and after the param changes
+type SimulatedRelayReward struct {
+	Address      sdk.Address `json:"address"`
+	Chain        string      `json:"chain"`
+	StakedTokens sdk.BigInt  `json:"staked_tokens"`
+	Before       sdk.BigInt  `json:"before"`
+	After        sdk.BigInt  `json:"after"`
+}
+
+// SimulatedMaxRelays is the max relays of an application before and after the param changes
+type SimulatedMaxRelays struct {
+	Address sdk.Address `json:"address"`
+	Before  sdk.BigInt  `json:"before"`
+	After   sdk.BigInt  `json:"after"`
+}
+
+// MaxSimulatedRewardSamples bounds the amount of sample nodes of a param change simulation
+const MaxSimulatedRewardSamples = 5
+
+// SimulateParamChanges applies changes (module/param to json value) to a cached copy of the state at height,
+// validates the resulting params of every module and reports the derived effects; nothing is committed
+func (app PocketCoreApp) SimulateParamChanges(changes map[string]json.RawMessage, height int64) (res ParamChangeSimulation, err error) {
+	if len(changes) == 0 {
+		return res, types.ErrEmptyChanges(types.ModuleName)
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	simCtx, _ := ctx.CacheContext()
+	keys := make([]string, 0, len(changes))
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	acl := app.govKeeper.GetACL(ctx)
+	oldValues := app.govKeeper.GetAllParamNameValue(ctx)
+	res = ParamChangeSimulation{
+		Height:           height,
+		Changes:          make([]SimulatedParamChange, 0, len(keys)),
+		ValidationErrors: make([]string, 0),
+		RelayRewards:     make([]SimulatedRelayReward, 0),
+		MaxRelays:        make([]SimulatedMaxRelays, 0),
+	}
+	for _, key := range keys {
+		change := SimulatedParamChange{Key: key, Owner: acl.GetOwner(key), OldValue: oldValues[key]}
+		if err := app.govKeeper.UpdateParam(simCtx, key, changes[key]); err != nil {
+			change.Error = err.Error()
+		}
+		res.Changes = append(res.Changes, change)
+	}
+	newValues := app.govKeeper.GetAllParamNameValue(simCtx)
+	for i := range res.Changes {
+		res.Changes[i].NewValue = newValues[res.Changes[i].Key]
+	}
+	// validate the params of every module
+	if err := app.nodesKeeper.GetParams(simCtx).Validate(); err != nil {
+		res.ValidationErrors = append(res.ValidationErrors, fmt.Sprintf("%s: %s", nodesTypes.ModuleName, err.Error()))
+	}
+	if err := app.appsKeeper.GetParams(simCtx).Validate(); err != nil {
+		res.ValidationErrors = append(res.ValidationErrors, fmt.Sprintf("%s: %s", appsTypes.ModuleName, err.Error()))
+	}
+	if err := app.pocketKeeper.GetParams(simCtx).Validate(); err != nil {
+		res.ValidationErrors = append(res.ValidationErrors, fmt.Sprintf("%s: %s", pocketTypes.ModuleName, err.Error()))
+	}
+	if err := app.govKeeper.GetACL(simCtx).Validate(app.govKeeper.GetAllParamNames(simCtx)); err != nil {
+		res.ValidationErrors = append(res.ValidationErrors, fmt.Sprintf("%s: %s", types.ModuleName, err.Error()))
+	}
+	// the reward of a single relay for sample nodes with distinct stakes
+	sampledStakes := make(map[string]bool)
+	app.nodesKeeper.IterateAndExecuteOverStakedVals(ctx, func(_ int64, validator nodesExported.ValidatorI) (stop bool) {
+		stake := validator.GetTokens()
+		if sampledStakes[stake.String()] {
+			return false
+		}
+		sampledStakes[stake.String()] = true
+		chain := ""
+		if chains := validator.GetChains(); len(chains) > 0 {
+			chain = chains[0]
+		}
+		before, _ := app.nodesKeeper.CalculateRelayReward(ctx, chain, sdk.OneInt(), stake)
+		after, _ := app.nodesKeeper.CalculateRelayReward(simCtx, chain, sdk.OneInt(), stake)
+		res.RelayRewards = append(res.RelayRewards, SimulatedRelayReward{
+			Address:      validator.GetAddress(),
+			Chain:        chain,
+			StakedTokens: stake,
+			Before:       before,
+			After:        after,
+		})
+		return len(res.RelayRewards) >= MaxSimulatedRewardSamples
+	})
+	// the applications whose max relays change
+	app.appsKeeper.IterateAndExecuteOverStakedApps(ctx, func(_ int64, a appsExported.ApplicationI) (stop bool) {
+		application, ok := a.(appsTypes.Application)
+		if !ok {
+			return false
+		}
+		before := app.appsKeeper.CalculateAppRelays(ctx, application)
+		after := app.appsKeeper.CalculateAppRelays(simCtx, application)
+		if !before.Equal(after) {
+			res.MaxRelays = append(res.MaxRelays, SimulatedMaxRelays{Address: application.Address, Before: before, After: after})
+		}
+		return false
+	})
+	return res, nil
+}
+
 func (app PocketCoreApp) QueryApps(height int64, opts appsTypes.QueryApplicationsWithOpts) (res Page, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -475,6 +928,77 @@ func (app PocketCoreApp) QueryApp(addr string, height int64) (res appsTypes.Appl
 	return
 }
 
+// QueryRevokedClients returns the client public keys revoked by the application at height
+func (app PocketCoreApp) QueryRevokedClients(addr string, height int64) (res []appsTypes.RevokedClient, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.appsKeeper.GetRevokedClients(ctx, a), nil
+}
+
+// AppStakePreview is the outcome of an edit of the stake of an application, computed without signing or broadcasting it
+type AppStakePreview struct {
+	Address          sdk.Address `json:"address"`
+	Chains           []string    `json:"chains"`
+	MaxChains        int64       `json:"max_chains"`
+	StakedTokens     sdk.BigInt  `json:"staked_tokens"`
+	CurrentMaxRelays sdk.BigInt  `json:"current_max_relays"`
+	MaxRelays        sdk.BigInt  `json:"max_relays"`
+	Fee              sdk.BigInt  `json:"fee"`
+	Error            string      `json:"error,omitempty"`
+}
+
+// PreviewAppEditStake computes the stake of the staked application at address after the chain changes and
+// the new amount (the current stake if zero) at height; the validation error of the edit is reported in the preview
+func (app PocketCoreApp) PreviewAppEditStake(address string, amount sdk.BigInt, addChains, removeChains []string, height int64) (res AppStakePreview, err error) {
+	a, err := sdk.AddressFromHex(address)
+	if err != nil {
+		return
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	current, found := app.appsKeeper.GetApplication(ctx, a)
+	if !found || !current.IsStaked() {
+		return res, fmt.Errorf("application %s is not staked at height %d", a, height)
+	}
+	if amount.IsZero() {
+		amount = current.StakedTokens
+	}
+	msg := appsTypes.MsgStake{
+		PubKey: current.PublicKey,
+		Chains: appsTypes.EditChains(current.Chains, addChains, removeChains),
+		Value:  amount,
+	}
+	res = AppStakePreview{
+		Address:          current.Address,
+		Chains:           msg.Chains,
+		MaxChains:        app.appsKeeper.MaxChains(ctx),
+		StakedTokens:     amount,
+		CurrentMaxRelays: current.MaxRelays,
+		MaxRelays:        current.MaxRelays,
+		Fee:              app.accountKeeper.GetParams(ctx).FeeMultiplier.GetFee(&msg),
+	}
+	// the max relays are only recalculated when the stake is raised
+	if amount.GT(current.StakedTokens) {
+		edited := current
+		edited.StakedTokens = amount
+		res.MaxRelays = app.appsKeeper.CalculateAppRelays(ctx, edited)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		res.Error = err.Error()
+	} else if err := app.appsKeeper.ValidateApplicationStaking(ctx, appsTypes.NewApplication(a, current.PublicKey, msg.Chains, sdk.ZeroInt()), amount); err != nil {
+		res.Error = err.Error()
+	}
+	return res, nil
+}
+
 func (app PocketCoreApp) QueryTotalAppCoins(height int64) (staked sdk.BigInt, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -500,6 +1024,81 @@ func (app PocketCoreApp) QueryValidatorByChain(height int64, chain string) (amou
 	return int64(count), nil
 }
 
+// ChainCoverage is the serving capacity staked for a relay chain
+type ChainCoverage struct {
+	Chain     string     `json:"chain"`
+	NodeCount int64      `json:"node_count"`
+	NodeStake sdk.BigInt `json:"node_stake"`
+	AppCount  int64      `json:"app_count"`
+	AppStake  sdk.BigInt `json:"app_stake"`
+}
+
+// ChainCoverageSample is the coverage of every supported chain at a height
+type ChainCoverageSample struct {
+	Height int64           `json:"height"`
+	Chains []ChainCoverage `json:"chains"`
+}
+
+// MaxChainCoverageSamples bounds the amount of heights loaded by a single chain coverage query
+const MaxChainCoverageSamples = 100
+
+// QueryChainCoverage samples the coverage of the supported chains every step blocks from startHeight to endHeight
+func (app PocketCoreApp) QueryChainCoverage(startHeight, endHeight, step int64) (res []ChainCoverageSample, err error) {
+	if startHeight <= 0 || endHeight < startHeight {
+		return nil, fmt.Errorf("invalid height range: %d to %d", startHeight, endHeight)
+	}
+	if step <= 0 {
+		step = 1
+	}
+	if (endHeight-startHeight)/step+1 > MaxChainCoverageSamples {
+		return nil, fmt.Errorf("the height range produces more than %d samples, increase the step", MaxChainCoverageSamples)
+	}
+	res = make([]ChainCoverageSample, 0)
+	for height := startHeight; height <= endHeight; height += step {
+		sample, err := app.queryChainCoverageAtHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, sample)
+	}
+	return res, nil
+}
+
+func (app PocketCoreApp) queryChainCoverageAtHeight(height int64) (res ChainCoverageSample, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	chains := app.pocketKeeper.SupportedBlockchains(ctx)
+	coverage := make(map[string]*ChainCoverage, len(chains))
+	for _, chain := range chains {
+		coverage[chain] = &ChainCoverage{Chain: chain, NodeStake: sdk.ZeroInt(), AppStake: sdk.ZeroInt()}
+	}
+	app.nodesKeeper.IterateAndExecuteOverStakedVals(ctx, func(_ int64, validator nodesExported.ValidatorI) (stop bool) {
+		for _, chain := range validator.GetChains() {
+			if c, ok := coverage[chain]; ok {
+				c.NodeCount++
+				c.NodeStake = c.NodeStake.Add(validator.GetTokens())
+			}
+		}
+		return false
+	})
+	app.appsKeeper.IterateAndExecuteOverStakedApps(ctx, func(_ int64, application appsExported.ApplicationI) (stop bool) {
+		for _, chain := range application.GetChains() {
+			if c, ok := coverage[chain]; ok {
+				c.AppCount++
+				c.AppStake = c.AppStake.Add(application.GetTokens())
+			}
+		}
+		return false
+	})
+	res = ChainCoverageSample{Height: height, Chains: make([]ChainCoverage, 0, len(chains))}
+	for _, chain := range chains {
+		res.Chains = append(res.Chains, *coverage[chain])
+	}
+	return
+}
+
 func (app PocketCoreApp) QueryPocketSupportedBlockchains(height int64) (res []string, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -565,6 +1164,96 @@ func (app PocketCoreApp) QueryClaims(address string, height int64, page, perPage
 	return p, nil
 }
 
+// RelayAllowance is the per session relay budget of an application
+type RelayAllowance struct {
+	Address            sdk.Address `json:"address"`
+	Chains             []string    `json:"chains"`
+	MaxRelays          sdk.BigInt  `json:"max_relays"`
+	SessionBlockHeight int64       `json:"session_block_height"`
+	SessionNodeCount   int64       `json:"session_node_count"`
+	// the relays each session node may serve the app per chain before an OverServiceError
+	RelaysPerNode sdk.BigInt `json:"relays_per_node"`
+}
+
+// QueryRelayAllowance computes the relay allowance of the application for the session at height
+func (app PocketCoreApp) QueryRelayAllowance(address string, height int64) (res RelayAllowance, err error) {
+	a, err := sdk.AddressFromHex(address)
+	if err != nil {
+		return
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	sessionBlockHeight := app.pocketKeeper.GetLatestSessionBlockHeight(ctx)
+	sessionCtx, err := ctx.PrevCtx(sessionBlockHeight)
+	if err != nil {
+		return
+	}
+	application, found := app.appsKeeper.GetApplication(sessionCtx, a)
+	if !found {
+		return res, fmt.Errorf("application %s not found at the session height %d", a, sessionBlockHeight)
+	}
+	sessionNodeCount := app.pocketKeeper.SessionNodeCount(sessionCtx)
+	return RelayAllowance{
+		Address:            application.Address,
+		Chains:             application.Chains,
+		MaxRelays:          application.MaxRelays,
+		SessionBlockHeight: sessionBlockHeight,
+		SessionNodeCount:   sessionNodeCount,
+		RelaysPerNode:      pocketTypes.MaxPossibleRelays(application, sessionNodeCount),
+	}, nil
+}
+
+// RelayUsage is the amount of relays a local node served an application in a session
+type RelayUsage struct {
+	Servicer           string     `json:"servicer"`
+	AppPubKey          string     `json:"app_pubkey"`
+	Chain              string     `json:"chain"`
+	SessionBlockHeight int64      `json:"session_block_height"`
+	Relays             int64      `json:"relays"`
+	MaxRelays          sdk.BigInt `json:"max_relays"`
+	Sealed             bool       `json:"sealed"`
+}
+
+// QueryRelayUsage returns the relays served by the local nodes per app and session, read from the evidence stores
+func (app PocketCoreApp) QueryRelayUsage(appPubKey string) (res []RelayUsage, err error) {
+	res = make([]RelayUsage, 0)
+	for _, node := range pocketTypes.GlobalPocketNodes {
+		if node == nil || node.EvidenceStore == nil {
+			continue
+		}
+		it := pocketTypes.EvidenceIterator(node.EvidenceStore)
+		for ; it.Valid(); it.Next() {
+			evidence := it.Value()
+			if evidence.EvidenceType != pocketTypes.RelayEvidence {
+				continue
+			}
+			if appPubKey != "" && evidence.ApplicationPubKey != appPubKey {
+				continue
+			}
+			usage := RelayUsage{
+				Servicer:           node.GetAddress().String(),
+				AppPubKey:          evidence.ApplicationPubKey,
+				Chain:              evidence.Chain,
+				SessionBlockHeight: evidence.SessionBlockHeight,
+				Relays:             evidence.NumOfProofs,
+				MaxRelays:          sdk.ZeroInt(),
+				Sealed:             node.EvidenceStore.IsSealed(evidence),
+			}
+			// the allowance is unknown once the session height is pruned or the app is gone
+			if sessionCtx, er := app.NewContext(evidence.SessionBlockHeight); er == nil {
+				if application, found := pocketTypes.GetAppFromPublicKey(sessionCtx, app.appsKeeper, evidence.ApplicationPubKey); found {
+					usage.MaxRelays = pocketTypes.MaxPossibleRelays(application, app.pocketKeeper.SessionNodeCount(sessionCtx))
+				}
+			}
+			res = append(res, usage)
+		}
+		it.Close()
+	}
+	return
+}
+
 func (app PocketCoreApp) QueryPocketParams(height int64) (res pocketTypes.Params, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/pokt-network/pocket-core/types"
 	apps "github.com/pokt-network/pocket-core/x/apps"
 	types3 "github.com/pokt-network/pocket-core/x/apps/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/pokt-network/pocket-core/x/gov"
 	"github.com/pokt-network/pocket-core/x/nodes"
 	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
@@ -560,6 +561,126 @@ func TestQueryPocketSupportedBlockchains(t *testing.T) {
 	}
 }
 
+func TestQueryChainCoverage(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	_, _, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	height := PCA.LastBlockHeight()
+	got, err := PCA.QueryChainCoverage(height, height, 1)
+	assert.Nil(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, height, got[0].Height)
+	var coverage ChainCoverage
+	for _, c := range got[0].Chains {
+		if c.Chain == sdk.PlaceholderHash {
+			coverage = c
+		}
+	}
+	assert.Equal(t, sdk.PlaceholderHash, coverage.Chain)
+	assert.True(t, coverage.NodeCount > 0)
+	assert.True(t, coverage.NodeStake.IsPositive())
+	assert.Equal(t, int64(1), coverage.AppCount)
+	assert.True(t, coverage.AppStake.IsPositive())
+	_, err = PCA.QueryChainCoverage(height, height-1, 1)
+	assert.NotNil(t, err)
+	cleanup()
+	stopCli()
+}
+
+func TestQueryRelayAllowance(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	_, _, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	height := PCA.LastBlockHeight()
+	ctx, err := PCA.NewContext(height)
+	assert.Nil(t, err)
+	application := PCA.appsKeeper.GetAllApplications(ctx)[0]
+	got, err := PCA.QueryRelayAllowance(application.Address.String(), height)
+	assert.Nil(t, err)
+	assert.Equal(t, PCA.pocketKeeper.GetLatestSessionBlockHeight(ctx), got.SessionBlockHeight)
+	assert.True(t, got.MaxRelays.Equal(application.MaxRelays))
+	assert.True(t, got.RelaysPerNode.Equal(types.MaxPossibleRelays(application, PCA.pocketKeeper.SessionNodeCount(ctx))))
+	_, err = PCA.QueryRelayAllowance(crypto.GenerateEd25519PrivKey().PublicKey().Address().String(), height)
+	assert.NotNil(t, err)
+	// relays served by the local node
+	header := types.SessionHeader{
+		ApplicationPubKey:  application.PublicKey.RawString(),
+		Chain:              sdk.PlaceholderHash,
+		SessionBlockHeight: 1,
+	}
+	node := types.GetPocketNode()
+	evidence, err := types.GetEvidence(header, types.RelayEvidence, sdk.NewInt(1000), node.EvidenceStore)
+	assert.Nil(t, err)
+	evidence.NumOfProofs = 3
+	types.SetEvidence(evidence, node.EvidenceStore)
+	usage, err := PCA.QueryRelayUsage(header.ApplicationPubKey)
+	assert.Nil(t, err)
+	assert.Len(t, usage, 1)
+	assert.Equal(t, int64(3), usage[0].Relays)
+	assert.Equal(t, node.GetAddress().String(), usage[0].Servicer)
+	assert.True(t, usage[0].MaxRelays.IsPositive())
+	usage, err = PCA.QueryRelayUsage(crypto.GenerateEd25519PrivKey().PublicKey().RawString())
+	assert.Nil(t, err)
+	assert.Empty(t, usage)
+	cleanup()
+	stopCli()
+}
+
+func TestPreviewAppEditStake(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	// the fee of the stake is multiplied
+	_ = oneAppTwoNodeGenesis()
+	var authGenState authTypes.GenesisState
+	memCodec().MustUnmarshalJSON(GenState[authTypes.ModuleName], &authGenState)
+	authGenState.Params.FeeMultiplier = authTypes.FeeMultipliers{
+		FeeMultis: []authTypes.FeeMultiplier{{Key: types3.MsgAppStakeName, Multiplier: 3}},
+		Default:   1,
+	}
+	GenState[authTypes.ModuleName] = memCodec().MustMarshalJSON(authGenState)
+	genesisBz, err := memCodec().MarshalJSONIndent(GenState, "", "    ")
+	assert.Nil(t, err)
+	_, _, cleanup := NewInMemoryTendermintNodeProto(t, genesisBz)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	<-evtChan // edit stake is enabled after the upgrade height
+	height := PCA.LastBlockHeight()
+	ctx, err := PCA.NewContext(height)
+	assert.Nil(t, err)
+	application := PCA.appsKeeper.GetAllApplications(ctx)[0]
+	addr := application.Address.String()
+	// chain changes keep the stake and the max relays
+	preview, err := PCA.PreviewAppEditStake(addr, sdk.ZeroInt(), []string{"0002"}, nil, height)
+	assert.Nil(t, err)
+	assert.Empty(t, preview.Error)
+	assert.Equal(t, append(application.Chains, "0002"), preview.Chains)
+	assert.Equal(t, PCA.appsKeeper.MaxChains(ctx), preview.MaxChains)
+	assert.True(t, preview.StakedTokens.Equal(application.StakedTokens))
+	assert.True(t, preview.MaxRelays.Equal(application.MaxRelays))
+	assert.True(t, preview.Fee.Equal(sdk.NewInt(types3.AppFeeMap[types3.MsgAppStakeName]*3)))
+	// removing every chain is invalid
+	preview, err = PCA.PreviewAppEditStake(addr, sdk.ZeroInt(), nil, application.Chains, height)
+	assert.Nil(t, err)
+	assert.Empty(t, preview.Chains)
+	assert.NotEmpty(t, preview.Error)
+	// lowering the stake is invalid
+	preview, err = PCA.PreviewAppEditStake(addr, application.StakedTokens.SubRaw(1), nil, nil, height)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, preview.Error)
+	// raising the stake raises the max relays
+	preview, err = PCA.PreviewAppEditStake(addr, application.StakedTokens.MulRaw(2), nil, nil, height)
+	assert.Nil(t, err)
+	assert.True(t, preview.MaxRelays.GT(application.MaxRelays))
+	_, err = PCA.PreviewAppEditStake(crypto.GenerateEd25519PrivKey().PublicKey().Address().String(), sdk.ZeroInt(), nil, nil, height)
+	assert.NotNil(t, err)
+	cleanup()
+	stopCli()
+}
+
 func TestQueryPocketParams(t *testing.T) {
 	tt := []struct {
 		name         string
@@ -975,6 +1096,35 @@ func TestQueryAllParams(t *testing.T) {
 		})
 	}
 }
+func TestQueryFees(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	res, err := PCA.QueryFees([]string{types2.MsgSendName, types3.MsgAppStakeName}, PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.Len(t, res.Fees, 2)
+	assert.Equal(t, types2.MsgSendName, res.Fees[0].MsgType)
+	assert.True(t, res.Fees[0].BaseFee.Equal(sdk.NewInt(types2.SendFee)))
+	assert.True(t, res.Fees[0].DefaultMultiplier)
+	assert.True(t, res.Fees[1].Fee.Equal(sdk.NewInt(types3.StakeFee)))
+	// every message type is returned when none is given
+	res, err = PCA.QueryFees(nil, PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.Len(t, res.Fees, len(msgBaseFees()))
+	_, err = PCA.QueryFees([]string{"unknown"}, PCA.LastBlockHeight())
+	assert.NotNil(t, err)
+	// the transaction does not need to be signed
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	txBz, err := memCodec().MarshalBinaryLengthPrefixed(authTypes.StdTx{
+		Msg: &types2.MsgSend{FromAddress: cb.GetAddress(), ToAddress: cb.GetAddress(), Amount: sdk.OneInt()},
+	}, PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	res, err = PCA.QueryTxFee(txBz, PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.Len(t, res.Fees, 1)
+	assert.True(t, res.Fees[0].Fee.Equal(sdk.NewInt(types2.SendFee)))
+	cleanup()
+}
+
 func TestQueryParam(t *testing.T) {
 
 	tt := []struct {
@@ -999,6 +1149,44 @@ func TestQueryParam(t *testing.T) {
 	}
 }
 
+func TestSimulateParamChanges(t *testing.T) {
+	resetTestACL()
+	_, _, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	height := PCA.LastBlockHeight()
+	before, err := PCA.QueryParam(height, "pos/RelaysToTokensMultiplier")
+	assert.Nil(t, err)
+	res, err := PCA.SimulateParamChanges(map[string]json.RawMessage{
+		"pos/RelaysToTokensMultiplier":    json.RawMessage(`"20000"`),
+		"pos/StakeMinimum":                json.RawMessage(`"1"`),
+		"application/StabilityAdjustment": json.RawMessage(`"100"`),
+		"pos/UnknownParam":                json.RawMessage(`"1"`),
+	}, height)
+	assert.Nil(t, err)
+	assert.Equal(t, height, res.Height)
+	assert.Len(t, res.Changes, 4)
+	// the changes are sorted by key
+	assert.Equal(t, "application/StabilityAdjustment", res.Changes[0].Key)
+	assert.Equal(t, `"100"`, res.Changes[0].NewValue)
+	assert.NotEmpty(t, res.Changes[3].Error)
+	assert.Len(t, res.ValidationErrors, 1)
+	assert.NotEmpty(t, res.RelayRewards)
+	for _, reward := range res.RelayRewards {
+		assert.True(t, reward.After.GT(reward.Before))
+	}
+	assert.Len(t, res.MaxRelays, 1)
+	assert.True(t, res.MaxRelays[0].After.Equal(res.MaxRelays[0].Before.AddRaw(100)))
+	// nothing is committed
+	after, err := PCA.QueryParam(height, "pos/RelaysToTokensMultiplier")
+	assert.Nil(t, err)
+	assert.Equal(t, before, after)
+	_, err = PCA.SimulateParamChanges(nil, height)
+	assert.NotNil(t, err)
+	cleanup()
+	stopCli()
+}
+
 func TestQueryAccountBalance(t *testing.T) {
 
 	tt := []struct {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/node"
@@ -102,7 +103,7 @@ func NewClient(c config, creator AppCreator) (*node.Node, *PocketCoreApp, error)
 	if err != nil {
 		return nil, nil, err
 	}
-	transactionIndexer := sdk.NewTransactionIndexer(txDB)
+	transactionIndexer := NewTransactionIndexer(txDB)
 	// open the tracewriter
 	traceWriter, err := openTraceWriter(c.TraceWriter)
 	if err != nil {
@@ -149,6 +150,16 @@ func OpenApplicationDB(config sdk.Config) (dbm.DB, error) {
 	return sdk.NewLevelDB(sdk.ApplicationDBName, dataDir, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
 }
 
+// NewTransactionIndexer - The transaction indexer of the node, indexing every message of the multi message
+// transactions and also the memos when they are enabled in the config
+func NewTransactionIndexer(txDB dbm.DB) *sdk.TransactionIndexer {
+	transactionIndexer := sdk.NewTransactionIndexer(txDB).WithTxDecoder(auth.DefaultTxDecoder(Codec()))
+	if GlobalConfig.PocketConfig.IndexTxMemos {
+		transactionIndexer = transactionIndexer.WithMemoIndexing(auth.DefaultTxDecoder(Codec()))
+	}
+	return transactionIndexer
+}
+
 func OpenTxIndexerDB(config sdk.Config) (dbm.DB, error) {
 	dataDir := filepath.Join(config.TendermintConfig.RootDir, GlobalConfig.TendermintConfig.DBPath)
 	return sdk.NewLevelDB(sdk.TransactionIndexerDBName, dataDir, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
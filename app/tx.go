@@ -1,7 +1,11 @@
 package app
 
 import (
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/pokt-network/pocket-core/x/auth/util"
 )
 
@@ -21,3 +25,62 @@ func (app PocketCoreApp) SendRawTx(fromAddr string, txBytes []byte) (sdk.TxRespo
 	cliCtx.BroadcastMode = util.BroadcastSync
 	return cliCtx.BroadcastTx(txBytes)
 }
+
+// TxSimulation - The outcome of running a transaction against the latest state without committing it
+type TxSimulation struct {
+	Height    int64            `json:"height"`
+	Code      uint32           `json:"code"`
+	Codespace string           `json:"codespace"`
+	Log       string           `json:"log"`
+	Events    sdk.StringEvents `json:"events"`
+	Fee       sdk.BigInt       `json:"fee"`
+}
+
+// SimulateTx - Run the tx bytes through the ante handler and the message handler without committing
+func (app *PocketCoreApp) SimulateTx(txBytes []byte) (TxSimulation, error) {
+	height := app.LastBlockHeight()
+	tx, err := UnmarshalTx(txBytes, height)
+	if err != nil {
+		return TxSimulation{}, err
+	}
+	return app.simulateTx(txBytes, tx, height)
+}
+
+// SimulateUnsignedTx - Run the msg as if it was signed by the holder of pubKey and paid the required fee
+func (app *PocketCoreApp) SimulateUnsignedTx(msg sdk.ProtoMsg, pubKey crypto.PublicKey, memo string) (TxSimulation, error) {
+	height := app.LastBlockHeight()
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return TxSimulation{}, err
+	}
+	fee := app.accountKeeper.GetParams(ctx).FeeMultiplier.GetFee(msg)
+	// the signature is only a placeholder, simulate mode does not verify it
+	sig := types.StdSignature{PublicKey: pubKey, Signature: make([]byte, crypto.Ed25519SignatureSize)}
+	tx := types.NewTx(msg, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, fee)), sig, memo, 0)
+	return app.simulateTx(nil, tx, height)
+}
+
+func (app *PocketCoreApp) simulateTx(txBytes []byte, tx sdk.Tx, height int64) (TxSimulation, error) {
+	if tx.GetMsg() == nil {
+		return TxSimulation{}, fmt.Errorf("the transaction has no message")
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return TxSimulation{}, err
+	}
+	// simulate mode runs against a cache of the state committed at height and skips signature verification
+	result := app.BaseApp.SimulateWithContext(ctx, txBytes, tx)
+	feeMultipliers := app.accountKeeper.GetParams(ctx).FeeMultiplier
+	fee := feeMultipliers.GetFee(tx.GetMsg())
+	if multiMsgTx, ok := tx.(sdk.MultiMsgTx); ok && multiMsgTx.IsMultiMsg() {
+		fee = feeMultipliers.GetFees(multiMsgTx.GetMsgs())
+	}
+	return TxSimulation{
+		Height:    height,
+		Code:      uint32(result.Code),
+		Codespace: string(result.Codespace),
+		Log:       result.Log,
+		Events:    sdk.StringifyEvents(result.Events.ToABCIEvents()),
+		Fee:       fee,
+	}, nil
+}
@@ -832,6 +832,211 @@ func TestDuplicateTxWithRawTx(t *testing.T) {
 
 }
 
+func TestSimulateTx(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	_, kb, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	msg := &nodeTypes.MsgSend{
+		FromAddress: cb.GetAddress(),
+		ToAddress:   kp.GetAddress(),
+		Amount:      sdk.NewInt(1000),
+	}
+	txBz, err := types.DefaultTxEncoder(memCodec())(types.NewTestTx(sdk.Context{}.WithChainID("pocket-test"), msg, pk, rand2.Int64(),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(100000)))), -1)
+	assert.Nil(t, err)
+	res, err := PCA.SimulateTx(txBz)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), res.Code, res.Log)
+	assert.True(t, res.Fee.Equal(msg.GetFee()))
+	assert.NotEmpty(t, res.Events)
+	<-evtChan // Wait for block
+	// nothing is committed
+	balance, err := PCA.QueryBalance(kp.GetAddress().String(), PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.True(t, balance.IsZero())
+	// an unsigned message only needs the public key of the signer
+	res, err = PCA.SimulateUnsignedTx(msg, pk.PublicKey(), "")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), res.Code, res.Log)
+	msg.Amount = sdk.NewInt(1000000000000000)
+	res, err = PCA.SimulateUnsignedTx(msg, pk.PublicKey(), "")
+	assert.Nil(t, err)
+	assert.NotEqual(t, uint32(0), res.Code)
+	// the public key must belong to the signer
+	res, err = PCA.SimulateUnsignedTx(msg, kp.PublicKey, "")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(sdk.CodeUnauthorized), res.Code)
+	cleanup()
+	stopCli()
+}
+
+func TestMultiMsgTransaction(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	codec.UpgradeFeatureMap[codec.MultiMsgTxKey] = 1
+	t.Cleanup(func() {
+		delete(codec.UpgradeFeatureMap, codec.MultiMsgTxKey)
+	})
+	_, kb, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp1, err := kb.Create("test")
+	assert.Nil(t, err)
+	kp2, err := kb.Create("test")
+	assert.Nil(t, err)
+	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
+	multiMsgTx := func(amount1, amount2 int64, fee int64) []byte {
+		msgs := []sdk.ProtoMsg{
+			&nodeTypes.MsgSend{FromAddress: cb.GetAddress(), ToAddress: kp1.GetAddress(), Amount: sdk.NewInt(amount1)},
+			&nodeTypes.MsgSend{FromAddress: cb.GetAddress(), ToAddress: kp2.GetAddress(), Amount: sdk.NewInt(amount2)},
+		}
+		tx := types.NewMultiMsgTx(msgs, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee))), nil, "", rand2.Int64())
+		signBytes, err := types.StdSignBytesMulti("pocket-test", tx.Entropy, tx.Fee, tx.GetMsgs(), tx.Memo)
+		assert.Nil(t, err)
+		sig, err := pk.Sign(signBytes)
+		assert.Nil(t, err)
+		tx.Signatures = []types.StdSignature{{PublicKey: pk.PublicKey(), Signature: sig}}
+		txBz, err := types.DefaultTxEncoder(memCodec())(tx, -1)
+		assert.Nil(t, err)
+		return txBz
+	}
+	_, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	memCli, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	// the fee must cover the fee of every message
+	txResp, err := nodes.RawTx(memCodec(), memCli, cb.GetAddress(), multiMsgTx(1000, 2000, 10000))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(types.CodeInsufficientFee), txResp.Code)
+	// the messages are executed atomically
+	txResp, err = nodes.RawTx(memCodec(), memCli, cb.GetAddress(), multiMsgTx(1000, 1000000000000000, 20000))
+	assert.Nil(t, err)
+	assert.Zero(t, txResp.Code, txResp.RawLog)
+	<-evtChan // Wait for tx
+	balance, err := PCA.QueryBalance(kp1.GetAddress().String(), PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.True(t, balance.IsZero())
+	txResp, err = nodes.RawTx(memCodec(), memCli, cb.GetAddress(), multiMsgTx(1000, 2000, 20000))
+	assert.Nil(t, err)
+	assert.Zero(t, txResp.Code, txResp.RawLog)
+	<-evtChan // Wait for tx
+	balance, err = PCA.QueryBalance(kp1.GetAddress().String(), PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.True(t, balance.Equal(sdk.NewInt(1000)))
+	balance, err = PCA.QueryBalance(kp2.GetAddress().String(), PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.True(t, balance.Equal(sdk.NewInt(2000)))
+	cleanup()
+	stopCli()
+}
+
+func TestFeePayerTransaction(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	codec.UpgradeFeatureMap[codec.FeePayerKey] = 1
+	t.Cleanup(func() {
+		delete(codec.UpgradeFeatureMap, codec.FeePayerKey)
+	})
+	_, kb, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp1, err := kb.Create("test")
+	assert.Nil(t, err)
+	kp2, err := kb.Create("test")
+	assert.Nil(t, err)
+	payerKey, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
+	signerKey, err := kb.ExportPrivateKeyObject(kp1.GetAddress(), "test")
+	assert.Nil(t, err)
+	feePayerTx := func(validFeePayerSig bool) []byte {
+		msg := &nodeTypes.MsgSend{FromAddress: kp1.GetAddress(), ToAddress: kp2.GetAddress(), Amount: sdk.NewInt(1000)}
+		fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10000)))
+		entropy := rand2.Int64()
+		signBytes, err := types.StdSignBytesWithFeePayer("pocket-test", entropy, fee, msg, "", cb.GetAddress())
+		assert.Nil(t, err)
+		sig, err := signerKey.Sign(signBytes)
+		assert.Nil(t, err)
+		if !validFeePayerSig {
+			// the fee payer signs the bytes without itself
+			signBytes, err = types.StdSignBytes("pocket-test", entropy, fee, msg, "")
+			assert.Nil(t, err)
+		}
+		feePayerSig, err := payerKey.Sign(signBytes)
+		assert.Nil(t, err)
+		tx := types.StdTx{
+			Msg:       msg,
+			Fee:       fee,
+			Signature: types.StdSignature{PublicKey: signerKey.PublicKey(), Signature: sig},
+			Entropy:   entropy,
+			FeePayer:  &types.StdSignature{PublicKey: payerKey.PublicKey(), Signature: feePayerSig},
+		}
+		txBz, err := types.DefaultTxEncoder(memCodec())(tx, -1)
+		assert.Nil(t, err)
+		return txBz
+	}
+	_, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	memCli, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	// fund the signer with just the amount it sends
+	sendResp, err := nodes.Send(memCodec(), memCli, kb, cb.GetAddress(), kp1.GetAddress(), "test", sdk.NewInt(1000), true)
+	assert.Nil(t, err)
+	assert.Zero(t, sendResp.Code, sendResp.RawLog)
+	<-evtChan // Wait for tx
+	// the fee payer must sign the transaction with itself as the fee payer
+	txResp, err := nodes.RawTx(memCodec(), memCli, cb.GetAddress(), feePayerTx(false))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(sdk.CodeUnauthorized), txResp.Code)
+	// the fee is deducted from the fee payer
+	txResp, err = nodes.RawTx(memCodec(), memCli, cb.GetAddress(), feePayerTx(true))
+	assert.Nil(t, err)
+	assert.Zero(t, txResp.Code, txResp.RawLog)
+	<-evtChan // Wait for tx
+	balance, err := PCA.QueryBalance(kp1.GetAddress().String(), PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.True(t, balance.IsZero())
+	balance, err = PCA.QueryBalance(kp2.GetAddress().String(), PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.True(t, balance.Equal(sdk.NewInt(1000)))
+	cleanup()
+	stopCli()
+}
+
+func TestMultiMsgTransaction_ACLActivation(t *testing.T) {
+	maxMsgsKey := "auth/MaxMsgsPerTx"
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	codec.UpgradeFeatureMap[codec.MultiMsgTxKey] = 3
+	resetTestACL()
+	t.Cleanup(func() {
+		delete(codec.UpgradeFeatureMap, codec.MultiMsgTxKey)
+	})
+	_, kb, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	defer cleanup()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	defer stopCli()
+	<-evtChan // Wait for block 1
+	<-evtChan // Wait for block 2
+	// the param has no owner before the activation height
+	acl, err := PCA.QueryACL(PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.Nil(t, acl.GetOwner(maxMsgsKey))
+	<-evtChan // Wait for block 3
+	// the dao owns it from the activation height on
+	acl, err = PCA.QueryACL(PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.Equal(t, cb.GetAddress().String(), acl.GetOwner(maxMsgsKey).String())
+}
+
 func TestChangeParamsComplexTypeTx(t *testing.T) {
 	tt := []struct {
 		name         string
@@ -1814,3 +2019,56 @@ func NewValidChallengeProof(t *testing.T, privateKeys []crypto.PrivateKey, numOf
 	}
 	return proofs
 }
+
+func TestSimulateTxLeavesStateUnchanged(t *testing.T) {
+	codec.UpgradeHeight = 2
+	_ = memCodecMod(true)
+	_, kb, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	before, err := PCA.QueryBalance(cb.GetAddress().String(), PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	msg := &nodeTypes.MsgSend{
+		FromAddress: cb.GetAddress(),
+		ToAddress:   kp.GetAddress(),
+		Amount:      sdk.NewInt(1000),
+	}
+	txBz, err := types.DefaultTxEncoder(memCodec())(types.NewTestTx(sdk.Context{}.WithChainID("pocket-test"), msg, pk, rand2.Int64(),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(100000)))), -1)
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		res, err := PCA.SimulateTx(txBz)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(0), res.Code, res.Log)
+	}
+	// the message runs after the fee is deducted, so the entire balance can't be sent
+	whole := &nodeTypes.MsgSend{
+		FromAddress: cb.GetAddress(),
+		ToAddress:   kp.GetAddress(),
+		Amount:      before,
+	}
+	res, err := PCA.SimulateUnsignedTx(whole, pk.PublicKey(), "")
+	assert.Nil(t, err)
+	assert.NotEqual(t, uint32(0), res.Code)
+	whole.Amount = before.Sub(res.Fee)
+	res, err = PCA.SimulateUnsignedTx(whole, pk.PublicKey(), "")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), res.Code, res.Log)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	// neither the fee nor the amount has left the sender and nothing reached the recipient
+	after, err := PCA.QueryBalance(cb.GetAddress().String(), PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.True(t, before.Equal(after), fmt.Sprintf("before %s after %s", before, after))
+	balance, err := PCA.QueryBalance(kp.GetAddress().String(), PCA.LastBlockHeight())
+	assert.Nil(t, err)
+	assert.True(t, balance.IsZero())
+	cleanup()
+	stopCli()
+}
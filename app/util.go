@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"reflect"
 
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -27,20 +26,25 @@ func GenerateAAT(appPubKey, clientPubKey string, appPrivKey crypto.PrivateKey) (
 	return json.MarshalIndent(aat, "", "  ")
 }
 
+// GenerateScopedAAT generates an AAT that expires after expirationHeight and,
+// if chains is not empty, may only be used to relay to the listed chains.
+func GenerateScopedAAT(appPubKey, clientPubKey string, expirationHeight int64, chains []string, appPrivKey crypto.PrivateKey) (aatjson []byte, err error) {
+	aat, er := pocketKeeper.ScopedAATGeneration(appPubKey, clientPubKey, expirationHeight, chains, appPrivKey)
+	if er != nil {
+		return nil, er
+	}
+	return json.MarshalIndent(aat, "", "  ")
+}
+
 func BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.PublicKeyMultiSig, fees int64, legacyCodec bool) ([]byte, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	var m sdk.Msg
-	if err := Codec().UnmarshalJSON([]byte(jsonMessage), &m); err != nil {
+	protoMsg, err := types.MsgFromJSON(Codec(), []byte(jsonMessage))
+	if err != nil {
 		return nil, err
 	}
-	// use reflection to convert to proto msg
-	val := reflect.ValueOf(m)
-	vp := reflect.New(val.Type())
-	vp.Elem().Set(val)
-	protoMsg := vp.Interface().(sdk.ProtoMsg)
 	kb, err := GetKeybase()
 	if err != nil {
 		return nil, err
@@ -95,6 +99,103 @@ func SignMultisigOutOfOrder(fromAddr, txHex, passphrase, chainID string, keys []
 	return txBuilder.SignMultisigTransaction(fa, keys, passphrase, bz, legacyCodec)
 }
 
+// NewPartialTx - An unsigned transaction of the json message from the multisig public key
+func NewPartialTx(jsonMessage, chainID string, pk crypto.PublicKeyMultiSig, fees int64, memo string) (types.PartiallySignedTx, error) {
+	protoMsg, err := types.MsgFromJSON(Codec(), []byte(jsonMessage))
+	if err != nil {
+		return types.PartiallySignedTx{}, err
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fees)))
+	return types.NewPartiallySignedTx(Codec(), chainID, protoMsg, fee, memo, pk)
+}
+
+// SignPartialTx - Add the signature of fromAddr to the partially signed transaction
+func SignPartialTx(fromAddr, passphrase string, ptx types.PartiallySignedTx) (types.PartiallySignedTx, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return ptx, err
+	}
+	kb, err := GetKeybase()
+	if err != nil {
+		return ptx, err
+	}
+	signBytes, err := ptx.SignBytes(Codec())
+	if err != nil {
+		return ptx, err
+	}
+	sig, pubKey, err := kb.Sign(fa, passphrase, signBytes)
+	if err != nil {
+		return ptx, err
+	}
+	return ptx.AddSignature(Codec(), pubKey, sig)
+}
+
+// FinalizePartialTx - The encoded transaction, once the partially signed transaction has the signatures it needs
+func FinalizePartialTx(ptx types.PartiallySignedTx) ([]byte, error) {
+	tx, err := ptx.Finalize(Codec())
+	if err != nil {
+		return nil, err
+	}
+	return auth.DefaultTxEncoder(cdc)(tx, -1)
+}
+
+// SignOfflineTx - Add the signature of the signer to the offline transaction, with no network calls
+func SignOfflineTx(passphrase string, otx types.OfflineTx) (types.OfflineTx, error) {
+	fa, err := sdk.AddressFromHex(otx.Signer)
+	if err != nil {
+		return otx, err
+	}
+	kb, err := GetKeybase()
+	if err != nil {
+		return otx, err
+	}
+	signBytes, err := otx.SignBytes(Codec())
+	if err != nil {
+		return otx, err
+	}
+	sig, pubKey, err := kb.Sign(fa, passphrase, signBytes)
+	if err != nil {
+		return otx, err
+	}
+	return otx.AddSignature(Codec(), pubKey, sig)
+}
+
+// SignOfflineTxAsFeePayer - Add the signature of the fee payer to the offline transaction, with no network calls
+func SignOfflineTxAsFeePayer(passphrase string, otx types.OfflineTx) (types.OfflineTx, error) {
+	if !otx.HasFeePayer() {
+		return otx, fmt.Errorf("the transaction has no fee payer")
+	}
+	fa, err := sdk.AddressFromHex(otx.FeePayer)
+	if err != nil {
+		return otx, err
+	}
+	kb, err := GetKeybase()
+	if err != nil {
+		return otx, err
+	}
+	signBytes, err := otx.SignBytes(Codec())
+	if err != nil {
+		return otx, err
+	}
+	sig, pubKey, err := kb.Sign(fa, passphrase, signBytes)
+	if err != nil {
+		return otx, err
+	}
+	return otx.AddFeePayerSignature(Codec(), pubKey, sig)
+}
+
+// EncodeOfflineTx - The encoded signed offline transaction, with the codec of the height it was generated at
+func EncodeOfflineTx(otx types.OfflineTx) ([]byte, error) {
+	tx, err := otx.Finalize(Codec())
+	if err != nil {
+		return nil, err
+	}
+	if otx.IsLegacyCodec() {
+		return auth.DefaultTxEncoder(cdc)(tx, 0)
+	}
+	return auth.DefaultTxEncoder(cdc)(tx, -1)
+}
+
 func SortJSON(toSortJSON []byte) string {
 	var c interface{}
 	err := json.Unmarshal(toSortJSON, &c)
@@ -54,3 +54,44 @@ func TestBuildSignMultisig(t *testing.T) {
 	cleanup()
 	stopCli()
 }
+
+func TestBuildSignThresholdMultisig(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	codec.UpgradeFeatureMap[codec.ThresholdMultiSigKey] = 1
+	t.Cleanup(func() {
+		delete(codec.UpgradeFeatureMap, codec.ThresholdMultiSigKey)
+	})
+	_, kb, cleanup := NewInMemoryTendermintNodeAmino(t, oneAppTwoNodeGenesis())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp2, err := kb.Create("test")
+	assert.Nil(t, err)
+	kp3, err := kb.Create("test")
+	assert.Nil(t, err)
+	pms, err := crypto.NewThresholdMultiKey(2, cb.PublicKey, kp2.PublicKey, kp3.PublicKey)
+	assert.Nil(t, err)
+	msg := types.MsgSend{
+		FromAddress: sdk.Address(pms.Address()),
+		ToAddress:   kp2.GetAddress(),
+		Amount:      sdk.NewInt(1),
+	}
+	// the last and the first keys sign, without the list of keys
+	bz, err := gov.BuildAndSignMulti(memCodec(), kp3.GetAddress(), pms, &msg, getInMemoryTMClient(), kb, "test", 10000000, true)
+	assert.Nil(t, err)
+	bz, err = gov.SignMulti(memCodec(), cb.GetAddress(), bz, nil, getInMemoryTMClient(), kb, "test", true)
+	assert.Nil(t, err)
+	// the threshold is reached
+	_, err = gov.SignMulti(memCodec(), kp2.GetAddress(), bz, nil, getInMemoryTMClient(), kb, "test", true)
+	assert.NotNil(t, err)
+	_, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	memCli, stopCli, evtChan := subscribeTo(t, tmTypes.EventTx)
+	_, err = nodes.Send(memCodec(), memCli, kb, cb.GetAddress(), sdk.Address(pms.Address()), "test", sdk.NewInt(100000000), true)
+	assert.Nil(t, err)
+	<-evtChan // Wait for tx
+	txRaw, err := nodes.RawTx(memCodec(), memCli, sdk.Address(pms.Address()), bz)
+	assert.Nil(t, err)
+	assert.Zero(t, txRaw.Code, txRaw.RawLog)
+	cleanup()
+	stopCli()
+}
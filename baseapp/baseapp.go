@@ -62,6 +62,9 @@ const (
 
 	codeDuplicateTransaction = 6
 	authCodespace            = "auth"
+
+	// ExitCodeHalt is the exit code of a node halted by RequestHalt
+	ExitCodeHalt = 2
 )
 
 // BaseApp reflects the ABCI application implementation.
@@ -114,6 +117,9 @@ type BaseApp struct {
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// reason of a halt requested by a module, the node shuts down at the next commit
+	haltReason string
+
 	// application's version string
 	appVersion string
 }
@@ -788,6 +794,15 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 	var messageType string
 	var duplicateTransaction bool
 
+	if app.haltReason != "" {
+		// a halting node never commits the block, so the rest of its txs are not executed
+		result = sdk.ErrInternal("the node is halting: " + app.haltReason).Result()
+		return abci.ResponseDeliverTx{
+			Code:      uint32(result.Code),
+			Log:       result.Log,
+			Codespace: string(result.Codespace),
+		}
+	}
 	if _, ok := app.transactionCache[TxCacheKey(req.Tx, runTxModeDeliver)]; ok {
 		duplicateTransaction = true
 	} else {
@@ -915,6 +930,61 @@ func (app *BaseApp) runMsg(ctx sdk.Ctx, msg sdk.Msg, mode runTxMode, signer cryp
 	return result
 }
 
+// runMsgs executes the messages of a multi message transaction in order, each one with the signer of its signature,
+// stopping at the first failed message.
+func (app *BaseApp) runMsgs(ctx sdk.Ctx, tx sdk.MultiMsgTx, mode runTxMode) (result sdk.Result) {
+	var msgLogs sdk.ABCIMessageLogs
+	msgs := tx.GetMsgs()
+
+	if GetABCILogging() {
+		msgLogs = make(sdk.ABCIMessageLogs, 0, len(msgs))
+	}
+
+	var (
+		data      []byte
+		code      sdk.CodeType
+		codespace sdk.CodespaceType
+	)
+	events := sdk.EmptyEvents()
+	for i, msg := range msgs {
+		msgRoute := msg.Route()
+		handler := app.router.Route(msgRoute)
+		if handler == nil {
+			return sdk.ErrUnknownRequest("unrecognized ProtoMsg type: " + msgRoute).Result()
+		}
+		var msgResult sdk.Result
+		// skip actual execution for CheckTx mode
+		if mode != runTxModeCheck {
+			msgResult = handler(ctx, msg, tx.GetMsgSigner(i))
+		}
+		data = append(data, msgResult.Data...)
+		// append events from the message's execution and a message action event
+		msgEvents := sdk.EmptyEvents().AppendEvent(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type())))
+		msgEvents = msgEvents.AppendEvents(msgResult.Events)
+		events = events.AppendEvents(msgEvents)
+		// stop execution and return on first failed message
+		if !msgResult.IsOK() {
+			if GetABCILogging() {
+				msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), false, msgResult.Log, msgEvents))
+			}
+			code = msgResult.Code
+			codespace = msgResult.Codespace
+			break
+		}
+		if GetABCILogging() {
+			msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), true, msgResult.Log, msgEvents))
+		}
+	}
+	return sdk.Result{
+		Code:      code,
+		Codespace: codespace,
+		Data:      data,
+		Log:       strings.TrimSpace(msgLogs.String()),
+		GasUsed:   0,
+		Events:    events,
+	}
+}
+
 // Returns the applications's deliverState if app is in runTxModeDeliver,
 // otherwise it returns the application's checkstate.
 func (app *BaseApp) getState(mode runTxMode) *state {
@@ -966,13 +1036,19 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Ctx, txBytes []byte) (
 // further details on transaction execution, reference the BaseApp SDK
 // documentation.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk.Result, signer crypto.PublicKey) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+}
+
+// runTxWithContext processes a transaction on the provided context, see runTx.
+func (app *BaseApp) runTxWithContext(ctx sdk.Ctx, mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk.Result, signer crypto.PublicKey) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
+	// the ante handler cache of a simulation, on which its messages run
+	var simulateMS sdk.CacheMultiStore
 
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
@@ -1022,9 +1098,15 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 			}
 		}
 	}()
-	var msgs = tx.GetMsg()
-	if err := validateBasicTxMsgs(msgs); err != nil {
-		return err.Result(), nil
+	var msgs = []sdk.Msg{tx.GetMsg()}
+	multiMsgTx, isMultiMsg := tx.(sdk.MultiMsgTx)
+	if isMultiMsg = isMultiMsg && multiMsgTx.IsMultiMsg(); isMultiMsg {
+		msgs = multiMsgTx.GetMsgs()
+	}
+	for _, msg := range msgs {
+		if err := validateBasicTxMsgs(msg); err != nil {
+			return err.Result(), nil
+		}
 	}
 
 	if app.anteHandler != nil {
@@ -1061,12 +1143,34 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		if mode == runTxModeDeliver {
 			msCache.Write()
 		}
+		if mode == runTxModeSimulate {
+			simulateMS = msCache
+		}
 	}
 
 	// Create a new context based off of the existing context with a cache wrapped
 	// multi-store in case message processing fails.
-	runMsgCtx, newMS := app.txContext(ctx, txBytes) // todo edit here!!!
-	result = app.runMsg(runMsgCtx, msgs, mode, signer)
+	var runMsgCtx sdk.Ctx
+	var newMS sdk.MultiStore
+	switch {
+	case mode == runTxModeSimulate && simulateMS != nil:
+		// the messages of a simulation see the fee deducted by the ante handler, the cache is never written
+		runMsgCtx = ctx.WithMultiStore(simulateMS)
+	case mode == runTxModeSimulate:
+		runMsgCtx, _ = app.cacheTxContext(ctx, txBytes)
+	default:
+		runMsgCtx, newMS = app.txContext(ctx, txBytes) // todo edit here!!!
+	}
+	if isMultiMsg {
+		// the writes of the messages are cached until all of them pass, so that they are executed atomically
+		msgsCtx, msCache := app.cacheTxContext(runMsgCtx, txBytes)
+		result = app.runMsgs(msgsCtx, multiMsgTx, mode)
+		if result.IsOK() {
+			msCache.Write()
+		}
+	} else {
+		result = app.runMsg(runMsgCtx, msgs[0], mode, signer)
+	}
 	result.GasWanted = gasWanted
 
 	// Safety check: don't write the cache state unless we're in DeliverTx.
@@ -1088,7 +1192,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	//	app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	//} // todo edit here!!!!
 
-	if app.endBlocker != nil {
+	// a halting node never commits the block, so its end blocker is not executed
+	if app.endBlocker != nil && app.haltReason == "" {
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 	app.transactionCache = make(map[string]struct{})
@@ -1113,6 +1218,9 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
 	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
 		halt = true
+
+	case app.haltReason != "":
+		halt = true
 	}
 
 	if halt {
@@ -1147,7 +1255,11 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
-	app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
+	if app.haltReason != "" {
+		app.logger.Error("halting node", "reason", app.haltReason)
+	} else {
+		app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
+	}
 
 	p, err := os.FindProcess(os.Getpid())
 	if err == nil {
@@ -1163,7 +1275,22 @@ func (app *BaseApp) halt() {
 	// Resort to exiting immediately if the process could not be found or killed
 	// via SIGINT/SIGTERM signals.
 	app.logger.Info("failed to send SIGINT/SIGTERM; exiting...")
-	os.Exit(0)
+	os.Exit(app.ExitCode())
+}
+
+// RequestHalt makes the node gracefully shutdown at the next commit, without committing the block,
+// and exit with ExitCodeHalt; used when the node can't safely process the chain any further. The txs
+// and the end blocker of the block are not executed once the halt is requested
+func (app *BaseApp) RequestHalt(reason string) {
+	app.haltReason = reason
+}
+
+// ExitCode returns the code the node process should exit with after a shutdown
+func (app *BaseApp) ExitCode() int {
+	if app.haltReason != "" {
+		return ExitCodeHalt
+	}
+	return 0
 }
 
 // ----------------------------------------------------------------------------
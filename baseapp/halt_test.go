@@ -0,0 +1,29 @@
+package baseapp
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestRequestHalt_SkipsBlock(t *testing.T) {
+	app := NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), false, 0, nil, nil)
+	endBlocked := false
+	app.SetEndBlocker(func(ctx sdk.Ctx, req abci.RequestEndBlock) abci.ResponseEndBlock {
+		endBlocked = true
+		return abci.ResponseEndBlock{}
+	})
+	assert.Equal(t, 0, app.ExitCode())
+	app.RequestHalt("unsupported upgrade")
+	// the txs of the block are rejected without being decoded or run
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")})
+	assert.Equal(t, uint32(sdk.CodeInternal), res.Code)
+	assert.Contains(t, res.Log, "unsupported upgrade")
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	assert.False(t, endBlocked)
+	assert.Equal(t, ExitCodeHalt, app.ExitCode())
+}
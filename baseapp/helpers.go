@@ -18,7 +18,14 @@ func (app *BaseApp) Check(tx sdk.Tx) (result sdk.Result) {
 
 // nolint - full tx execution
 func (app *BaseApp) Simulate(txBytes []byte, tx sdk.Tx) (result sdk.Result) {
-	result, _ = app.runTx(runTxModeSimulate, nil, tx)
+	result, _ = app.runTx(runTxModeSimulate, txBytes, tx)
+	return
+}
+
+// SimulateWithContext - run the tx in simulate mode on a cache of the provided context, which is never written
+func (app *BaseApp) SimulateWithContext(ctx sdk.Ctx, txBytes []byte, tx sdk.Tx) (result sdk.Result) {
+	ctx, _ = ctx.WithTxBytes(txBytes).CacheContext()
+	result, _ = app.runTxWithContext(ctx, runTxModeSimulate, txBytes, tx)
 	return
 }
 
@@ -61,16 +61,38 @@ const (
 	PerChainRTTM                 = "PerChainRTTM"
 	AppTransferKey               = "AppTransfer"
 	RewardDelegatorsKey          = "RewardDelegators"
+	SlashHistoryKey              = "SlashHistory"
+	ScopedAATKey                 = "ScopedAAT"
+	AppRevocationKey             = "AppRevocation"
+	GovProposalKey               = "GovProposal"
+	ScheduledParamKey            = "ScheduledParam"
+	ParamHistoryKey              = "ParamHistory"
+	DAOTreasuryKey               = "DAOTreasury"
+	UpgradeSignalKey             = "UpgradeSignal"
+	ThresholdMultiSigKey         = "ThresholdMultiSig"
+	MultiMsgTxKey                = "MultiMsgTx"
+	VestingAccountKey            = "VestingAccount"
+	FeePayerKey                  = "FeePayer"
+	AppJailKey                   = "AppJail"
 )
 
 func GetCodecUpgradeHeight() int64 {
-	if UpgradeHeight >= UpgradeCodecHeight {
+	return CodecUpgradeHeight(UpgradeHeight, OldUpgradeHeight)
+}
+
+// CodecUpgradeHeight - The height of the codec upgrade given the heights of the governance upgrade; a zero
+// upgradeHeight means no upgrade was ever scheduled
+func CodecUpgradeHeight(upgradeHeight, oldUpgradeHeight int64) int64 {
+	if upgradeHeight == 0 {
+		upgradeHeight = math.MaxInt64
+	}
+	if upgradeHeight >= UpgradeCodecHeight {
 		return UpgradeCodecHeight
 	} else {
-		if OldUpgradeHeight != 0 && OldUpgradeHeight < UpgradeHeight {
-			return OldUpgradeHeight
+		if oldUpgradeHeight != 0 && oldUpgradeHeight < upgradeHeight {
+			return oldUpgradeHeight
 		} else {
-			return UpgradeHeight
+			return upgradeHeight
 		}
 	}
 }
@@ -301,6 +323,84 @@ func (cdc *Codec) IsAfterRewardDelegatorUpgrade(height int64) bool {
 		TestMode <= -3
 }
 
+func (cdc *Codec) IsAfterSlashHistoryUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[SlashHistoryKey] != 0 &&
+		height >= UpgradeFeatureMap[SlashHistoryKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterScopedAATUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[ScopedAATKey] != 0 &&
+		height >= UpgradeFeatureMap[ScopedAATKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterAppRevocationUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[AppRevocationKey] != 0 &&
+		height >= UpgradeFeatureMap[AppRevocationKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterGovProposalUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[GovProposalKey] != 0 &&
+		height >= UpgradeFeatureMap[GovProposalKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterScheduledParamUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[ScheduledParamKey] != 0 &&
+		height >= UpgradeFeatureMap[ScheduledParamKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterParamHistoryUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[ParamHistoryKey] != 0 &&
+		height >= UpgradeFeatureMap[ParamHistoryKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterDAOTreasuryUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[DAOTreasuryKey] != 0 &&
+		height >= UpgradeFeatureMap[DAOTreasuryKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterUpgradeSignalUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[UpgradeSignalKey] != 0 &&
+		height >= UpgradeFeatureMap[UpgradeSignalKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterThresholdMultiSigUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[ThresholdMultiSigKey] != 0 &&
+		height >= UpgradeFeatureMap[ThresholdMultiSigKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterMultiMsgTxUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[MultiMsgTxKey] != 0 &&
+		height >= UpgradeFeatureMap[MultiMsgTxKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterVestingAccountUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[VestingAccountKey] != 0 &&
+		height >= UpgradeFeatureMap[VestingAccountKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterFeePayerUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[FeePayerKey] != 0 &&
+		height >= UpgradeFeatureMap[FeePayerKey]) ||
+		TestMode <= -3
+}
+
+func (cdc *Codec) IsAfterAppJailUpgrade(height int64) bool {
+	return (UpgradeFeatureMap[AppJailKey] != 0 &&
+		height >= UpgradeFeatureMap[AppJailKey]) ||
+		TestMode <= -3
+}
+
 // IsOnNonCustodialUpgrade Note: includes the actual upgrade height
 func (cdc *Codec) IsOnNonCustodialUpgrade(height int64) bool {
 	return (UpgradeFeatureMap[NonCustodialUpdateKey] != 0 && height == UpgradeFeatureMap[NonCustodialUpdateKey]) || TestMode <= -3
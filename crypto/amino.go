@@ -25,4 +25,6 @@ func RegisterAmino(cdc *amino.Codec) {
 	cdc.RegisterInterface((*PublicKeyMultiSig)(nil), nil)
 	cdc.RegisterConcrete(PublicKeyMultiSignature{}, "crypto/public_key_multi_signature", nil)
 	cdc.RegisterConcrete(MultiSignature{}, "crypto/multi_signature", nil)
+	cdc.RegisterConcrete(PublicKeyThresholdMultiSignature{}, "crypto/public_key_threshold_multi_signature", nil)
+	cdc.RegisterConcrete(ThresholdMultiSignature{}, "crypto/threshold_multi_signature", nil)
 }
@@ -93,6 +93,8 @@ func NewPublicKeyBz(b []byte) (PublicKey, error) {
 		return Secp256k1PublicKey{}.NewPublicKey(b)
 	} else if pk, err := PublicKeyMultiSignature.NewPublicKey(PublicKeyMultiSignature{}, b); err == nil {
 		return pk, err
+	} else if pk, err := (PublicKeyThresholdMultiSignature{}).NewPublicKey(b); err == nil {
+		return pk, err
 	} else {
 		return nil, fmt.Errorf("unsupported public key type, length of: %d", x)
 	}
@@ -0,0 +1,111 @@
+// Package hd derives ed25519 keys from BIP-39 mnemonics following SLIP-10, where every level of the path is hardened.
+package hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/go-bip39"
+)
+
+const (
+	// CoinType is the SLIP-44 coin type of POKT
+	CoinType = 635
+	// MnemonicEntropySize is the entropy of the generated mnemonics, in bits (24 words)
+	MnemonicEntropySize = 256
+	// HardenedOffset is added to the index of the hardened levels
+	HardenedOffset uint32 = 0x80000000
+
+	masterSecret = "ed25519 seed" // the HMAC key of the master key (SLIP-10)
+)
+
+// FullPath returns the derivation path of the account index: m/44'/635'/0'/0'/index'
+func FullPath(index uint32) string {
+	return fmt.Sprintf("m/44'/%d'/0'/0'/%d'", CoinType, index)
+}
+
+// NewMnemonic generates a random 24 word mnemonic
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(MnemonicEntropySize)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// NewSeed returns the BIP-39 seed of the mnemonic and the optional bip39 passphrase, failing on an invalid mnemonic
+func NewSeed(mnemonic, bip39Passphrase string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %s", err.Error())
+	}
+	return seed, nil
+}
+
+// ParsePath parses a path like m/44'/635'/0'; ed25519 only supports hardened levels
+func ParsePath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("the path %q doesn't start with m", path)
+	}
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		if !strings.HasSuffix(part, "'") && !strings.HasSuffix(part, "H") {
+			return nil, fmt.Errorf("the level %q of the path %q isn't hardened", part, path)
+		}
+		index, err := strconv.ParseUint(part[:len(part)-1], 10, 32)
+		if err != nil || uint32(index) >= HardenedOffset {
+			return nil, fmt.Errorf("invalid level %q of the path %q", part, path)
+		}
+		indexes = append(indexes, uint32(index)+HardenedOffset)
+	}
+	return indexes, nil
+}
+
+// NewMasterKey returns the master private key and chain code of the seed
+func NewMasterKey(seed []byte) (key, chainCode [32]byte) {
+	return hmacSplit([]byte(masterSecret), seed)
+}
+
+// DeriveChild returns the hardened child private key and chain code of the parent ones
+func DeriveChild(key, chainCode [32]byte, index uint32) ([32]byte, [32]byte, error) {
+	if index < HardenedOffset {
+		return [32]byte{}, [32]byte{}, fmt.Errorf("the index %d isn't hardened", index)
+	}
+	data := make([]byte, 0, 37)
+	data = append(data, 0)
+	data = append(data, key[:]...)
+	data = binary.BigEndian.AppendUint32(data, index)
+	childKey, childChainCode := hmacSplit(chainCode[:], data)
+	return childKey, childChainCode, nil
+}
+
+// DerivePrivateKeyForPath returns the ed25519 private key seed of the path
+func DerivePrivateKeyForPath(seed []byte, path string) ([32]byte, error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	key, chainCode := NewMasterKey(seed)
+	for _, index := range indexes {
+		key, chainCode, err = DeriveChild(key, chainCode, index)
+		if err != nil {
+			return [32]byte{}, err
+		}
+	}
+	return key, nil
+}
+
+func hmacSplit(key, data []byte) (left, right [32]byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	copy(left[:], sum[:32])
+	copy(right[:], sum[32:])
+	return
+}
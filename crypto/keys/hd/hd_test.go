@@ -0,0 +1,99 @@
+package hd
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type slip10Vector struct {
+	path       string
+	chainCode  string
+	privateKey string
+	publicKey  string
+}
+
+// the ed25519 test vectors of https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+var slip10Vectors = []struct {
+	seed    string
+	vectors []slip10Vector
+}{
+	{
+		seed: "000102030405060708090a0b0c0d0e0f",
+		vectors: []slip10Vector{
+			{"m", "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", "00a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"},
+			{"m/0H", "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", "008c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c"},
+			{"m/0H/1H", "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2", "001932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"},
+			{"m/0H/1H/2H", "2e69929e00b5ab250f49c3fb1c12f252de4fed2c1db88387094a0f8c4c9ccd6c", "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9", "00ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1"},
+			{"m/0H/1H/2H/2H", "8f6d87f93d750e0efccda017d662a1b31a266e4a6f5993b15f5c1f07f74dd5cc", "30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662", "008abae2d66361c879b900d204ad2cc4984fa2aa344dd7ddc46007329ac76c429c"},
+			{"m/0H/1H/2H/2H/1000000000H", "68789923a0cac2cd5a29172a475fe9e0fb14cd6adb5ad98a3fa70333e7afa230", "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793", "003c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a"},
+		},
+	},
+	{
+		seed: "fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542",
+		vectors: []slip10Vector{
+			{"m", "ef70a74db9c3a5af931b5fe73ed8e1a53464133654fd55e7a66f8570b8e33c3b", "171cb88b1b3c1db25add599712e36245d75bc65a1a5c9e18d76f9f2b1eab4012", "008fe9693f8fa62a4305a140b9764c5ee01e455963744fe18204b4fb948249308a"},
+			{"m/0H", "0b78a3226f915c082bf118f83618a618ab6dec793752624cbeb622acb562862d", "1559eb2bbec5790b0c65d8693e4d0875b1747f4970ae8b650486ed7470845635", "0086fab68dcb57aa196c77c5f264f215a112c22a912c10d123b0d03c3c28ef1037"},
+		},
+	},
+}
+
+func TestSLIP10Vectors(t *testing.T) {
+	for _, tc := range slip10Vectors {
+		seed, err := hex.DecodeString(tc.seed)
+		require.Nil(t, err)
+		for _, v := range tc.vectors {
+			indexes, err := ParsePath(v.path)
+			require.Nil(t, err)
+			key, chainCode := NewMasterKey(seed)
+			for _, index := range indexes {
+				key, chainCode, err = DeriveChild(key, chainCode, index)
+				require.Nil(t, err)
+			}
+			require.Equal(t, v.chainCode, hex.EncodeToString(chainCode[:]), v.path)
+			require.Equal(t, v.privateKey, hex.EncodeToString(key[:]), v.path)
+			pub := ed25519.NewKeyFromSeed(key[:]).Public().(ed25519.PublicKey)
+			require.Equal(t, v.publicKey, "00"+hex.EncodeToString(pub), v.path)
+			derived, err := DerivePrivateKeyForPath(seed, v.path)
+			require.Nil(t, err)
+			require.Equal(t, key, derived)
+		}
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	indexes, err := ParsePath(FullPath(3))
+	require.Nil(t, err)
+	require.Equal(t, []uint32{44 + HardenedOffset, CoinType + HardenedOffset, HardenedOffset, HardenedOffset, 3 + HardenedOffset}, indexes)
+	// ed25519 doesn't support normal levels
+	_, err = ParsePath("m/44'/635'/0")
+	require.NotNil(t, err)
+	_, err = ParsePath("44'/635'")
+	require.NotNil(t, err)
+	_, err = ParsePath("m/2147483648'")
+	require.NotNil(t, err)
+	_, _, err = DeriveChild([32]byte{}, [32]byte{}, 1)
+	require.NotNil(t, err)
+}
+
+func TestNewSeed(t *testing.T) {
+	// the first test vector of https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	seed, err := NewSeed(mnemonic, "TREZOR")
+	require.Nil(t, err)
+	require.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed))
+	// extra whitespace is ignored
+	spaced, err := NewSeed("  abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon\nabout ", "TREZOR")
+	require.Nil(t, err)
+	require.Equal(t, seed, spaced)
+	// invalid checksum
+	_, err = NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", "")
+	require.NotNil(t, err)
+	// generated mnemonics are valid
+	generated, err := NewMnemonic()
+	require.Nil(t, err)
+	_, err = NewSeed(generated, "")
+	require.Nil(t, err)
+}
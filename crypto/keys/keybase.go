@@ -1,11 +1,13 @@
 package keys
 
 import (
+	"crypto/ed25519"
 	"fmt"
 	"github.com/pokt-network/pocket-core/crypto"
 
 	"github.com/pkg/errors"
 
+	"github.com/pokt-network/pocket-core/crypto/keys/hd"
 	"github.com/pokt-network/pocket-core/crypto/keys/mintkey"
 	"github.com/pokt-network/pocket-core/types"
 
@@ -169,6 +171,45 @@ func (kb dbKeybase) Create(encryptPassphrase string) (KeyPair, error) {
 	return kp, nil
 }
 
+// CreateMnemonic generates a new mnemonic, derives its first account (see hd.FullPath) and encrypts it to disk using
+// encryptPassphrase. The mnemonic is the only backup of the account, so it must be written down by the caller.
+func (kb dbKeybase) CreateMnemonic(encryptPassphrase string) (KeyPair, string, error) {
+	mnemonic, err := hd.NewMnemonic()
+	if err != nil {
+		return KeyPair{}, "", err
+	}
+	kp, err := kb.Recover(mnemonic, "", encryptPassphrase, 0)
+	if err != nil {
+		return KeyPair{}, "", err
+	}
+	return kp, mnemonic, nil
+}
+
+// Recover derives the account index of the mnemonic and the optional bip39Passphrase (see hd.FullPath).
+// It returns an error if the mnemonic is invalid or a key with the same address exists.
+func (kb dbKeybase) Recover(mnemonic, bip39Passphrase, encryptPassphrase string, index uint32) (KeyPair, error) {
+	privKey, err := DerivePrivateKey(mnemonic, bip39Passphrase, index)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	return kb.ImportPrivateKeyObject(privKey, encryptPassphrase)
+}
+
+// DerivePrivateKey returns the raw ed25519 private key of the account index of the mnemonic and bip39Passphrase
+func DerivePrivateKey(mnemonic, bip39Passphrase string, index uint32) ([64]byte, error) {
+	seed, err := hd.NewSeed(mnemonic, bip39Passphrase)
+	if err != nil {
+		return [64]byte{}, err
+	}
+	secret, err := hd.DerivePrivateKeyForPath(seed, hd.FullPath(index))
+	if err != nil {
+		return [64]byte{}, err
+	}
+	var privKey [64]byte
+	copy(privKey[:], ed25519.NewKeyFromSeed(secret[:]))
+	return privKey, nil
+}
+
 // ImportPrivKey imports a private key in ASCII armor format.
 // It returns an error if a key with the same address exists or a wrong decryptPassphrase is
 // supplied.
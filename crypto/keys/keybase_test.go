@@ -3,6 +3,7 @@ package keys
 import (
 	"crypto/rand"
 	"github.com/pokt-network/pocket-core/crypto"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -215,3 +216,43 @@ func TestCoinbase(t *testing.T) {
 	require.NotEmpty(t, coinbase)
 	require.Equal(t, coinbase, kp)
 }
+
+func TestMnemonicCreateRecover(t *testing.T) {
+	cstore := NewInMemory()
+	passphrase := "1234"
+
+	// Create an account from a new mnemonic
+	kp, mnemonic, err := cstore.CreateMnemonic(passphrase)
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 24)
+
+	// It can't be recovered while it exists
+	_, err = cstore.Recover(mnemonic, "", passphrase, 0)
+	require.Error(t, err)
+
+	// Remove it and recover it from the mnemonic
+	require.NoError(t, cstore.Delete(kp.GetAddress(), passphrase))
+	recoveredKp, err := cstore.Recover(mnemonic, "", passphrase, 0)
+	require.NoError(t, err)
+	require.Equal(t, kp.GetAddress(), recoveredKp.GetAddress())
+	msg := []byte("mnemonic")
+	sig, pub, err := cstore.Sign(recoveredKp.GetAddress(), passphrase, msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// More accounts from the same mnemonic
+	secondKp, err := cstore.Recover(mnemonic, "", passphrase, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, kp.GetAddress(), secondKp.GetAddress())
+	// The bip39 passphrase derives other accounts
+	otherKp, err := cstore.Recover(mnemonic, "extra", passphrase, 0)
+	require.NoError(t, err)
+	require.NotEqual(t, kp.GetAddress(), otherKp.GetAddress())
+	kpList, err := cstore.List()
+	require.NoError(t, err)
+	require.Len(t, kpList, 3)
+
+	// Invalid mnemonic checksum
+	_, err = cstore.Recover(strings.Repeat("abandon ", 24), "", passphrase, 0)
+	require.Error(t, err)
+}
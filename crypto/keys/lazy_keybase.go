@@ -121,6 +121,26 @@ func (lkb lazyKeybase) Create(encryptPassphrase string) (KeyPair, error) {
 	return newDbKeybase(db).Create(encryptPassphrase)
 }
 
+func (lkb lazyKeybase) CreateMnemonic(encryptPassphrase string) (KeyPair, string, error) {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
+	if err != nil {
+		return KeyPair{}, "", err
+	}
+	defer db.Close()
+
+	return newDbKeybase(db).CreateMnemonic(encryptPassphrase)
+}
+
+func (lkb lazyKeybase) Recover(mnemonic, bip39Passphrase, encryptPassphrase string, index uint32) (KeyPair, error) {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
+	if err != nil {
+		return KeyPair{}, err
+	}
+	defer db.Close()
+
+	return newDbKeybase(db).Recover(mnemonic, bip39Passphrase, encryptPassphrase, index)
+}
+
 func (lkb lazyKeybase) ImportPrivKey(armor, decryptPassphrase, encryptPassphrase string) (KeyPair, error) {
 	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
 	if err != nil {
@@ -675,3 +675,17 @@ func Test_lazyKeybase_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_lazyKeybase_CreateMnemonicRecover(t *testing.T) {
+	dir, cleanup := NewTestCaseDir(t)
+	defer cleanup()
+	kb := New("keybasename", dir)
+	kp, mnemonic, err := kb.CreateMnemonic("test")
+	require.NoError(t, err)
+	require.NoError(t, kb.Delete(kp.GetAddress(), "test"))
+	recoveredKp, err := kb.Recover(mnemonic, "", "test", 0)
+	require.NoError(t, err)
+	require.Equal(t, kp.GetAddress(), recoveredKp.GetAddress())
+	_, err = kb.Recover(mnemonic, "", "test", 0)
+	require.Error(t, err)
+}
@@ -26,6 +26,12 @@ type Keybase interface {
 	// Create a new KeyPair and encrypt it to disk using encryptPassphrase
 	Create(encryptPassphrase string) (KeyPair, error)
 
+	// CreateMnemonic generates a new mnemonic, derives its first account and encrypts it to disk using encryptPassphrase
+	CreateMnemonic(encryptPassphrase string) (kp KeyPair, mnemonic string, err error)
+
+	// Recover derives the account index of the mnemonic and bip39Passphrase, and encrypts it to disk using encryptPassphrase
+	Recover(mnemonic, bip39Passphrase, encryptPassphrase string, index uint32) (KeyPair, error)
+
 	// ImportPrivKey using Armored private key string. Decrypts armor with decryptPassphrase, and stores locally using encryptPassphrase
 	ImportPrivKey(armor, decryptPassphrase, encryptPassphrase string) (KeyPair, error)
 
@@ -0,0 +1,266 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var _ PublicKeyMultiSig = PublicKeyThresholdMultiSignature{}
+var _ crypto.PubKey = PublicKeyThresholdMultiSignature{}
+var _ PublicKey = PublicKeyThresholdMultiSignature{}
+
+// PublicKeyThresholdMultiSignature - A k-of-n multisig public key: any Threshold of the keys may sign
+type PublicKeyThresholdMultiSignature struct {
+	Threshold  uint64      `json:"threshold"`
+	PublicKeys []PublicKey `json:"keys"`
+}
+
+// NewThresholdMultiKey - A multisig public key that needs the signatures of threshold of the keys
+func NewThresholdMultiKey(threshold uint64, keys ...PublicKey) (PublicKeyMultiSig, error) {
+	return PublicKeyThresholdMultiSignature{Threshold: threshold}.NewMultiKey(keys...)
+}
+
+func (pms PublicKeyThresholdMultiSignature) NewMultiKey(keys ...PublicKey) (PublicKeyMultiSig, error) {
+	if keys == nil || len(keys) < 2 {
+		return nil, errors.New("must have at least two public keys")
+	}
+	if pms.Threshold == 0 || pms.Threshold > uint64(len(keys)) {
+		return nil, fmt.Errorf("the threshold must be between 1 and the number of public keys (%d)", len(keys))
+	}
+	pms.PublicKeys = keys
+	return pms, nil
+}
+
+// VerifyBytes - Exactly Threshold signatures, each one valid for the key it is marked for in the bitmap.
+// Extra signatures and padded bitmaps are rejected so that a signed transaction can't be re-encoded
+func (pms PublicKeyThresholdMultiSignature) VerifyBytes(msg []byte, multiSignature []byte) bool {
+	var multiSig ThresholdMultiSignature
+	err := cdc.UnmarshalBinaryBare(multiSignature, &multiSig)
+	if err != nil {
+		return false
+	}
+	if pms.Threshold == 0 || !multiSig.isValid() || !multiSig.isCanonical(len(pms.PublicKeys)) ||
+		uint64(multiSig.NumOfSigs()) != pms.Threshold {
+		return false
+	}
+	signed := 0
+	for i := 0; i < len(pms.PublicKeys); i++ {
+		signature, found := multiSig.GetSignatureByIndex(i)
+		if !found {
+			continue
+		}
+		if !pms.PublicKeys[i].VerifyBytes(msg, signature) {
+			return false
+		}
+		signed++
+	}
+	// ensure no signature is marked for a key that doesn't exist
+	return signed == multiSig.NumOfSigs()
+}
+
+func (pms PublicKeyThresholdMultiSignature) Address() crypto.Address {
+	return crypto.AddressHash(pms.Bytes())
+}
+
+func (pms PublicKeyThresholdMultiSignature) String() string {
+	return hex.EncodeToString(pms.Bytes())
+}
+
+func (pms PublicKeyThresholdMultiSignature) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pms)
+}
+
+func (pms PublicKeyThresholdMultiSignature) Keys() []PublicKey {
+	return pms.PublicKeys
+}
+
+func (pms PublicKeyThresholdMultiSignature) Equals(other crypto.PubKey) bool {
+	otherKey, sameType := other.(PublicKeyThresholdMultiSignature)
+	if !sameType {
+		return false
+	}
+	if pms.Threshold != otherKey.Threshold || len(pms.PublicKeys) != len(otherKey.PublicKeys) {
+		return false
+	}
+	for i := 0; i < len(pms.PublicKeys); i++ {
+		if !pms.PublicKeys[i].Equals(otherKey.PublicKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (pms PublicKeyThresholdMultiSignature) NewPublicKey(res []byte) (PublicKey, error) {
+	err := cdc.UnmarshalBinaryBare(res, &pms)
+	return pms, err
+}
+
+func (pms PublicKeyThresholdMultiSignature) PubKey() crypto.PubKey {
+	return nil
+}
+
+func (pms PublicKeyThresholdMultiSignature) RawBytes() []byte {
+	return pms.Bytes()
+}
+
+func (pms PublicKeyThresholdMultiSignature) RawString() string {
+	return pms.String()
+}
+
+func (pms PublicKeyThresholdMultiSignature) PubKeyToPublicKey(crypto.PubKey) PublicKey {
+	return nil
+}
+
+func (pms PublicKeyThresholdMultiSignature) Size() int {
+	if len(pms.PublicKeys) != 0 {
+		return pms.PublicKeys[0].Size()
+	}
+	return 0
+}
+
+// ThresholdMultiSignature - The signatures of a threshold multisig; bit i of the bitmap is set when key i signed,
+// and the signatures are ordered by the index of their key
+type ThresholdMultiSignature struct {
+	Bitmap []byte   `json:"bitmap"`
+	Sigs   [][]byte `json:"signatures"`
+}
+
+var _ MultiSig = ThresholdMultiSignature{}
+
+func (ms ThresholdMultiSignature) AddSignature(sig []byte, key PublicKey, keys []PublicKey) (MultiSig, error) {
+	index := getIndex(key, keys)
+	if index == -1 {
+		return nil, fmt.Errorf("provided key %s doesn't exist in the list of public keys", key.RawString())
+	}
+	if len(ms.Bitmap) < bitmapSize(len(keys)) {
+		bitmap := make([]byte, bitmapSize(len(keys)))
+		copy(bitmap, ms.Bitmap)
+		ms.Bitmap = bitmap
+	}
+	return ms.AddSignatureByIndex(sig, index), nil
+}
+
+func (ms ThresholdMultiSignature) AddSignatureByIndex(sig []byte, index int) MultiSig {
+	position := ms.position(index)
+	if position > len(ms.Sigs) {
+		position = len(ms.Sigs)
+	}
+	// Signature already exists, just replace the value there
+	if ms.isSet(index) && position < len(ms.Sigs) {
+		sigs := make([][]byte, len(ms.Sigs))
+		copy(sigs, ms.Sigs)
+		sigs[position] = sig
+		ms.Sigs = sigs
+		return ms
+	}
+	// else mark the key in the bitmap and insert the signature in the order of the keys
+	bitmap := make([]byte, len(ms.Bitmap))
+	copy(bitmap, ms.Bitmap)
+	for len(bitmap) <= index/8 {
+		bitmap = append(bitmap, 0)
+	}
+	bitmap[index/8] |= 1 << uint(index%8)
+	sigs := make([][]byte, 0, len(ms.Sigs)+1)
+	sigs = append(sigs, ms.Sigs[:position]...)
+	sigs = append(sigs, sig)
+	sigs = append(sigs, ms.Sigs[position:]...)
+	ms.Bitmap, ms.Sigs = bitmap, sigs
+	return ms
+}
+
+func (ms ThresholdMultiSignature) NewMultiSignature() MultiSig {
+	return ThresholdMultiSignature{Bitmap: make([]byte, 0, 1), Sigs: make([][]byte, 0, 2)}
+}
+
+func (ms ThresholdMultiSignature) Marshal() []byte {
+	return cdc.MustMarshalBinaryBare(ms)
+}
+
+func (ms ThresholdMultiSignature) Unmarshal(sig []byte) MultiSig {
+	cdc.MustUnmarshalBinaryBare(sig, &ms)
+	return ms
+}
+
+func (ms ThresholdMultiSignature) String() string {
+	return hex.EncodeToString(ms.Marshal())
+}
+
+func (ms ThresholdMultiSignature) NumOfSigs() int {
+	return len(ms.Sigs)
+}
+
+func (ms ThresholdMultiSignature) Signatures() [][]byte {
+	return ms.Sigs
+}
+
+// GetSignatureByIndex - The signature of the key at index i
+func (ms ThresholdMultiSignature) GetSignatureByIndex(i int) (sig []byte, found bool) {
+	if i < 0 || !ms.isSet(i) || !ms.isValid() {
+		return nil, false
+	}
+	sig = ms.Sigs[ms.position(i)]
+	if sig == nil {
+		return sig, false
+	}
+	return sig, true
+}
+
+func (ms ThresholdMultiSignature) GetSignatureByKey(pubKey PublicKey, keys []PublicKey) (sig []byte, found bool) {
+	i := getIndex(pubKey, keys)
+	return ms.GetSignatureByIndex(i)
+}
+
+// isSet - Whether the key at index signed
+func (ms ThresholdMultiSignature) isSet(index int) bool {
+	return index/8 < len(ms.Bitmap) && ms.Bitmap[index/8]&(1<<uint(index%8)) != 0
+}
+
+// position - The number of keys before index that signed
+func (ms ThresholdMultiSignature) position(index int) (count int) {
+	for i := 0; i < index/8 && i < len(ms.Bitmap); i++ {
+		count += bits.OnesCount8(ms.Bitmap[i])
+	}
+	if index/8 < len(ms.Bitmap) {
+		count += bits.OnesCount8(ms.Bitmap[index/8] & (1<<uint(index%8) - 1))
+	}
+	return
+}
+
+// isCanonical - Whether the bitmap has exactly one bit per key and no bit is set past the last key
+func (ms ThresholdMultiSignature) isCanonical(numOfKeys int) bool {
+	if len(ms.Bitmap) != bitmapSize(numOfKeys) {
+		return false
+	}
+	for i := numOfKeys; i < len(ms.Bitmap)*8; i++ {
+		if ms.isSet(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// bitmapSize - The number of bytes needed to mark numOfKeys keys
+func bitmapSize(numOfKeys int) int {
+	return (numOfKeys + 7) / 8
+}
+
+// isValid - Whether there is a signature for every key marked in the bitmap
+func (ms ThresholdMultiSignature) isValid() bool {
+	count := 0
+	for _, b := range ms.Bitmap {
+		count += bits.OnesCount8(b)
+	}
+	return count == len(ms.Sigs)
+}
+
+// NewMultiSigForKey - An empty signature structure of the kind verified by the multisig public key
+func NewMultiSigForKey(pk PublicKey) MultiSig {
+	if thresholdKey, ok := pk.(PublicKeyThresholdMultiSignature); ok {
+		return ThresholdMultiSignature{Bitmap: make([]byte, bitmapSize(len(thresholdKey.PublicKeys))), Sigs: make([][]byte, 0, 2)}
+	}
+	return MultiSignature{}.NewMultiSignature()
+}
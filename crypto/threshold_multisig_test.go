@@ -0,0 +1,122 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ThresholdMultiSigSetup(t *testing.T) (pubKey PublicKeyMultiSig, privateKeys []PrivateKey) {
+	privateKeys = append(privateKeys, getRandomPrivateKey(t), getRandomPrivateKey(t), getRandomPrivateKey(t))
+	pubKey, err := NewThresholdMultiKey(2, privateKeys[0].PublicKey(), privateKeys[1].PublicKey(), privateKeys[2].PublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func TestThresholdMultiSignature_NewMultiKey(t *testing.T) {
+	pubKey, privKeys := ThresholdMultiSigSetup(t)
+	_, err := NewThresholdMultiKey(0, pubKey.Keys()...)
+	assert.NotNil(t, err)
+	_, err = NewThresholdMultiKey(4, pubKey.Keys()...)
+	assert.NotNil(t, err)
+	// the threshold is part of the key and of its address
+	other, err := NewThresholdMultiKey(3, pubKey.Keys()...)
+	assert.Nil(t, err)
+	assert.False(t, pubKey.Equals(other))
+	assert.NotEqual(t, pubKey.Address(), other.Address())
+	assert.NotEqual(t, pubKey.Address(), PublicKeyMultiSignature{PublicKeys: pubKey.Keys()}.Address())
+	// the key is decoded from its bytes
+	decoded, err := NewPublicKeyBz(pubKey.RawBytes())
+	assert.Nil(t, err)
+	assert.True(t, pubKey.Equals(decoded))
+	decoded, err = NewPublicKey(pubKey.RawString())
+	assert.Nil(t, err)
+	assert.IsType(t, PublicKeyThresholdMultiSignature{}, decoded)
+	assert.Len(t, privKeys, 3)
+}
+
+func TestThresholdMultiSignature_AddSignatureVerifyBytes(t *testing.T) {
+	msg := []byte("foo")
+	pubKey, privKeys := ThresholdMultiSigSetup(t)
+	var sigs [][]byte
+	for _, pk := range privKeys {
+		sig, err := pk.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigs = append(sigs, sig)
+	}
+	// any two of the three keys, in any order
+	ms := NewMultiSigForKey(pubKey)
+	ms, err := ms.AddSignature(sigs[2], pubKey.Keys()[2], pubKey.Keys())
+	assert.Nil(t, err)
+	assert.False(t, pubKey.VerifyBytes(msg, ms.Marshal()))
+	ms, err = ms.AddSignature(sigs[0], pubKey.Keys()[0], pubKey.Keys())
+	assert.Nil(t, err)
+	assert.True(t, pubKey.VerifyBytes(msg, ms.Marshal()))
+	sig, found := ms.GetSignatureByIndex(2)
+	assert.True(t, found)
+	assert.Equal(t, sigs[2], sig)
+	_, found = ms.GetSignatureByIndex(1)
+	assert.False(t, found)
+	// replacing a signature
+	msWS := ms.AddSignatureByIndex(sigs[1], 0)
+	assert.False(t, pubKey.VerifyBytes(msg, msWS.Marshal()))
+	assert.True(t, pubKey.VerifyBytes(msg, ms.Marshal()))
+	// wrong message
+	assert.False(t, pubKey.VerifyBytes([]byte("bar"), ms.Marshal()))
+	// more signatures than the threshold
+	msES := ms.AddSignatureByIndex(sigs[1], 1)
+	assert.Equal(t, 3, msES.NumOfSigs())
+	assert.False(t, pubKey.VerifyBytes(msg, msES.Marshal()))
+	// a signature for a key that doesn't exist
+	msUK := ThresholdMultiSignature{}.NewMultiSignature().AddSignatureByIndex(sigs[0], 0).AddSignatureByIndex(sigs[1], 9)
+	assert.False(t, pubKey.VerifyBytes(msg, msUK.Marshal()))
+	// a bitmap that doesn't match the signatures
+	msBB := ms.(ThresholdMultiSignature)
+	msBB.Bitmap = []byte{7}
+	assert.False(t, pubKey.VerifyBytes(msg, msBB.Marshal()))
+	// a padded bitmap changes the transaction bytes, so it must not verify
+	msPB := ms.(ThresholdMultiSignature)
+	msPB.Bitmap = append(append([]byte{}, msPB.Bitmap...), 0)
+	assert.NotEqual(t, ms.Marshal(), msPB.Marshal())
+	assert.False(t, pubKey.VerifyBytes(msg, msPB.Marshal()))
+	// a bit set past the last key
+	msHB := ms.(ThresholdMultiSignature)
+	msHB.Bitmap = []byte{msHB.Bitmap[0] | 1<<7}
+	assert.False(t, pubKey.VerifyBytes(msg, msHB.Marshal()))
+	// the signatures of a regular multisig
+	regular := MultiSignature{}.NewMultiSignature().AddSignatureByIndex(sigs[0], 0).AddSignatureByIndex(sigs[1], 1)
+	assert.False(t, pubKey.VerifyBytes(msg, regular.Marshal()))
+	// empty signatures
+	assert.False(t, pubKey.VerifyBytes(msg, ThresholdMultiSignature{}.Marshal()))
+}
+
+func TestThresholdMultiSignature_BitmapSize(t *testing.T) {
+	msg := []byte("foo")
+	var keys []PublicKey
+	var privKeys []PrivateKey
+	for i := 0; i < 10; i++ {
+		pk := getRandomPrivateKey(t)
+		privKeys = append(privKeys, pk)
+		keys = append(keys, pk.PublicKey())
+	}
+	pubKey, err := NewThresholdMultiKey(2, keys...)
+	assert.Nil(t, err)
+	// the bitmap has one bit per key even when only the first keys sign
+	ms := NewMultiSigForKey(pubKey)
+	for _, i := range []int{1, 0} {
+		sig, err := privKeys[i].Sign(msg)
+		assert.Nil(t, err)
+		ms, err = ms.AddSignature(sig, keys[i], keys)
+		assert.Nil(t, err)
+	}
+	assert.Len(t, ms.(ThresholdMultiSignature).Bitmap, 2)
+	assert.True(t, pubKey.VerifyBytes(msg, ms.Marshal()))
+	// a bitmap that is too short
+	msSB := ms.(ThresholdMultiSignature)
+	msSB.Bitmap = msSB.Bitmap[:1]
+	assert.False(t, pubKey.VerifyBytes(msg, msSB.Marshal()))
+}
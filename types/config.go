@@ -51,12 +51,25 @@ type PocketConfig struct {
 	LeanPocket                 bool   `json:"lean_pocket"`
 	LeanPocketUserKeyFileName  string `json:"lean_pocket_user_key_file"`
 	PreventNegativeRewardClaim bool   `json:"prevent_negative_reward_claim"`
+	AutoUnjail                 bool   `json:"auto_unjail"`
+	AutoStakeFloor             int64  `json:"auto_stake_floor"`
+	MaintenanceKeyFileName     string `json:"maintenance_key_file"`
+	IndexTxMemos               bool   `json:"index_tx_memos"`
 }
 
 func (c PocketConfig) GetLeanPocketUserKeyFilePath() string {
 	return path.Join(c.DataDir, c.LeanPocketUserKeyFileName)
 }
 
+func (c PocketConfig) GetMaintenanceKeyFilePath() string {
+	return path.Join(c.DataDir, c.MaintenanceKeyFileName)
+}
+
+// IsNodeMaintenanceEnabled returns true if the node should watch its own validators and submit unjail/stake transactions
+func (c PocketConfig) IsNodeMaintenanceEnabled() bool {
+	return c.AutoUnjail || c.AutoStakeFloor > 0
+}
+
 type Config struct {
 	TendermintConfig config.Config `json:"tendermint_config"`
 	PocketConfig     PocketConfig  `json:"pocket_config"`
@@ -115,6 +128,10 @@ const (
 	DefaultGenerateTokenOnStart        = true
 	DefaultLeanPocket                  = false
 	DefaultLeanPocketUserKeyFileName   = "lean_nodes_keys.json"
+	DefaultAutoUnjail                  = false
+	DefaultAutoStakeFloor              = 0
+	DefaultMaintenanceKeyFileName      = "maintenance_key.json"
+	DefaultIndexTxMemos                = false
 )
 
 func DefaultConfig(dataDir string) Config {
@@ -151,6 +168,10 @@ func DefaultConfig(dataDir string) Config {
 			GenerateTokenOnStart:       DefaultGenerateTokenOnStart,
 			LeanPocket:                 DefaultLeanPocket,
 			LeanPocketUserKeyFileName:  DefaultLeanPocketUserKeyFileName,
+			AutoUnjail:                 DefaultAutoUnjail,
+			AutoStakeFloor:             DefaultAutoStakeFloor,
+			MaintenanceKeyFileName:     DefaultMaintenanceKeyFileName,
+			IndexTxMemos:               DefaultIndexTxMemos,
 		},
 	}
 	c.TendermintConfig.LevelDBOptions = config.DefaultLevelDBOpts()
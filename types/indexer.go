@@ -34,22 +34,41 @@ const (
 	TxSignerKey         = "tx.signer"
 	TxRecipientKey      = "tx.recipient"
 	TxHashKey           = "tx.hash"
+	TxMessageTypeKey    = "tx.message_type"
+	TxMemoKey           = "tx.memo" // the operand of the query is the hex of the memo
 	SortAscending       = "asc"
 	SortDescending      = "desc"
 	AuthCodespace       = "auth"
 	sep                 = "/"
 	maxPerPage          = 10000
 	AnteHandlerMaxError = 10
+	reindexBatchSize    = 1000
 )
 
 type TransactionIndexer struct {
-	store dbm.DB
+	store      dbm.DB
+	txDecoder  TxDecoder // decodes the messages and memos of the transactions, nil when they aren't indexed
+	indexMemos bool
 }
 
 func NewTransactionIndexer(store dbm.DB) *TransactionIndexer {
 	return &TransactionIndexer{store: store}
 }
 
+// WithTxDecoder - The indexer also indexing every message of a multi message transaction, decoded with txDecoder;
+// without it only the first message is indexed
+func (t *TransactionIndexer) WithTxDecoder(txDecoder TxDecoder) *TransactionIndexer {
+	t.txDecoder = txDecoder
+	return t
+}
+
+// WithMemoIndexing - The indexer also indexing the transactions by their memo, decoded with txDecoder
+func (t *TransactionIndexer) WithMemoIndexing(txDecoder TxDecoder) *TransactionIndexer {
+	t.txDecoder = txDecoder
+	t.indexMemos = true
+	return t
+}
+
 func (t *TransactionIndexer) AddBatch(b *txindex.Batch) error {
 	storeBatch := t.store.NewBatch()
 	defer storeBatch.Close()
@@ -58,27 +77,9 @@ func (t *TransactionIndexer) AddBatch(b *txindex.Batch) error {
 		if result.Result.Codespace == AuthCodespace && result.Result.Code < AnteHandlerMaxError {
 			continue // don't index any ante handler level errors
 		}
-		hash := result.Tx.Hash()
-
-		// index tx by sender
-		if result.Result.Signer != nil {
-			storeBatch.Set(keyForSigner(result), hash)
-		}
-
-		// index tx by recipient
-		if result.Result.Recipient != nil {
-			storeBatch.Set(keyForRecipient(result), hash)
-		}
-
-		// index tx by height
-		storeBatch.Set(keyForHeight(result), hash)
-
-		// index tx by hash
-		rawBytes, err := cdc.MarshalBinaryBare(result, 0) // TODO make protobuf compatible
-		if err != nil {
+		if err := t.index(storeBatch, result); err != nil {
 			return err
 		}
-		storeBatch.Set(hash, rawBytes)
 	}
 
 	return storeBatch.WriteSync()
@@ -90,16 +91,15 @@ func (t *TransactionIndexer) Index(result *types.TxResult) error {
 	if result.Result.Codespace == AuthCodespace && result.Result.Code < AnteHandlerMaxError {
 		return nil // no indexing for ante handler level errors
 	}
-	hash := result.Tx.Hash()
-	// index tx by sender
-	if result.Result.Signer != nil {
-		storeBatch.Set(keyForSigner(result), hash)
+	if err := t.index(storeBatch, result); err != nil {
+		return err
 	}
+	return storeBatch.WriteSync()
+}
 
-	// index tx by recipient
-	if result.Result.Recipient != nil {
-		storeBatch.Set(keyForRecipient(result), hash)
-	}
+func (t *TransactionIndexer) index(storeBatch dbm.Batch, result *types.TxResult) error {
+	hash := result.Tx.Hash()
+	t.indexSecondary(storeBatch, result, hash)
 
 	// index tx by height
 	storeBatch.Set(keyForHeight(result), hash)
@@ -110,8 +110,106 @@ func (t *TransactionIndexer) Index(result *types.TxResult) error {
 		return err
 	}
 	storeBatch.Set(hash, rawBytes)
+	return nil
+}
 
-	return storeBatch.WriteSync()
+// indexSecondary sets the indexes of the transaction, other than by hash and by height, that point to its hash
+func (t *TransactionIndexer) indexSecondary(storeBatch dbm.Batch, result *types.TxResult, hash []byte) {
+	// index tx by sender
+	if result.Result.Signer != nil {
+		storeBatch.Set(keyForSigner(result.Result.Signer, result), hash)
+	}
+
+	// index tx by recipient
+	if result.Result.Recipient != nil {
+		storeBatch.Set(keyForRecipient(result.Result.Recipient, result), hash)
+	}
+
+	// index tx by message type
+	if result.Result.MessageType != "" {
+		storeBatch.Set(keyForMessageType(result.Result.MessageType, result), hash)
+	}
+
+	tx := t.decode(result)
+	if tx == nil {
+		return
+	}
+
+	// the result only holds the first message of a multi message tx, so the others are indexed from the tx itself
+	if multiMsgTx, ok := tx.(MultiMsgTx); ok && multiMsgTx.IsMultiMsg() {
+		for i, msg := range multiMsgTx.GetMsgs() {
+			if signer := multiMsgTx.GetMsgSigner(i); signer != nil {
+				storeBatch.Set(keyForSigner(Address(signer.Address()), result), hash)
+			}
+			if recipient := msg.GetRecipient(); recipient != nil {
+				storeBatch.Set(keyForRecipient(recipient, result), hash)
+			}
+			storeBatch.Set(keyForMessageType(msg.Type(), result), hash)
+		}
+	}
+
+	// index tx by memo
+	if memoTx, ok := tx.(interface{ GetMemo() string }); ok && t.indexMemos && memoTx.GetMemo() != "" {
+		storeBatch.Set(keyForMemo(memoTx.GetMemo(), result), hash)
+	}
+}
+
+// decode returns the transaction of the result, nil when there is no tx decoder or the transaction can't be decoded
+func (t *TransactionIndexer) decode(result *types.TxResult) Tx {
+	if t.txDecoder == nil {
+		return nil
+	}
+	// decoded as in DeliverTx, with the height of the previous block
+	tx, err := t.txDecoder(result.Tx, result.Height-1)
+	if err != nil {
+		return nil
+	}
+	return tx
+}
+
+// Reindex - Sets again the indexes of the transactions already indexed from fromHeight onwards, to add the indexes
+// that didn't exist (or weren't enabled) when they were first indexed; returns the number of transactions reindexed
+func (t *TransactionIndexer) Reindex(fromHeight int64) (int, error) {
+	startKey := []byte(fmt.Sprintf("%s/%s",
+		TxHeightKey,
+		elenEncoder.EncodeInt(int(fromHeight)),
+	))
+	endKey := []byte(fmt.Sprintf("%s/%s",
+		TxHeightKey,
+		elenEncoder.EncodeInt(math.MaxInt64),
+	))
+	it, err := t.store.Iterator(startKey, endKey)
+	if err != nil {
+		return 0, errors.Wrap(err, "error creating the iterator for reindex")
+	}
+	defer it.Close()
+	b := t.store.NewBatch()
+	count := 0
+	for ; it.Valid(); it.Next() {
+		hash := append([]byte{}, it.Value()...)
+		result, err := t.Get(hash)
+		if err != nil {
+			b.Close()
+			return count, errors.Wrap(err, "error during reindex get()")
+		}
+		if result == nil {
+			continue
+		}
+		t.indexSecondary(b, result, hash)
+		count++
+		// write in batches to bound the memory used
+		if count%reindexBatchSize == 0 {
+			err = b.WriteSync()
+			b.Close()
+			if err != nil {
+				return count, err
+			}
+			b = t.store.NewBatch()
+		}
+	}
+	err = b.WriteSync()
+	b.Close()
+	return count, err
 }
 
 func (t *TransactionIndexer) Get(hash []byte) (*types.TxResult, error) {
@@ -133,8 +231,8 @@ func (t *TransactionIndexer) Get(hash []byte) (*types.TxResult, error) {
 	return txResult, nil
 }
 
-// NOTE: Only supports op.Equal for hash, height, signer, or recipient, we only support op.Equal for simplicity and
-// optimization of our use case
+// NOTE: Only supports op.Equal for hash, height, signer, recipient, message type or memo, we only support op.Equal for
+// simplicity and optimization of our use case
 func (t *TransactionIndexer) Search(ctx context.Context, q *query.Query) (res []*types.TxResult, total int, err error) {
 	conditions, err := q.Conditions()
 	if err != nil {
@@ -167,6 +265,10 @@ func (t *TransactionIndexer) Search(ctx context.Context, q *query.Query) (res []
 		return t.signerQuery(primaryCondition, secondaryCondition, q.Pagination)
 	case TxRecipientKey:
 		return t.recipientQuery(primaryCondition, secondaryCondition, q.Pagination)
+	case TxMessageTypeKey:
+		return t.messageTypeQuery(primaryCondition, secondaryCondition, q.Pagination)
+	case TxMemoKey:
+		return t.memoQuery(primaryCondition, secondaryCondition, q.Pagination)
 	case TxHashKey:
 		return t.hashQuery(primaryCondition)
 	default:
@@ -246,6 +348,40 @@ func (t *TransactionIndexer) recipientQuery(primaryCondition query.Condition, se
 	return t.getByPrefix(prefixKeyForRecipient(recipient), pagination)
 }
 
+func (t *TransactionIndexer) messageTypeQuery(primaryCondition query.Condition, secondaryCondition query.Condition, pagination *query.Page) (res []*types.TxResult, total int, err error) {
+	messageType, ok := primaryCondition.Operand.(string)
+	if !ok {
+		return nil, 0, errors.New("error during searching for a message type in the query, c.Operand not type string")
+	}
+	if secondaryCondition.CompositeKey == TxHeightKey {
+		height, ok := secondaryCondition.Operand.(int64)
+		if !ok {
+			return nil, 0, errors.New("error during searching for a height in the query, c.Operand not type int64")
+		}
+		return t.getByPrefix(prefixKeyForMessageTypeAndHeight(messageType, height), pagination)
+	}
+	return t.getByPrefix(prefixKeyForMessageType(messageType), pagination)
+}
+
+func (t *TransactionIndexer) memoQuery(primaryCondition query.Condition, secondaryCondition query.Condition, pagination *query.Page) (res []*types.TxResult, total int, err error) {
+	memoHex, ok := primaryCondition.Operand.(string)
+	if !ok {
+		return nil, 0, errors.New("error during searching for a memo in the query, c.Operand not type string")
+	}
+	memo, err := hex.DecodeString(memoHex)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error during searching for a memo in the query")
+	}
+	if secondaryCondition.CompositeKey == TxHeightKey {
+		height, ok := secondaryCondition.Operand.(int64)
+		if !ok {
+			return nil, 0, errors.New("error during searching for a height in the query, c.Operand not type int64")
+		}
+		return t.getByPrefix(prefixKeyForMemoAndHeight(string(memo), height), pagination)
+	}
+	return t.getByPrefix(prefixKeyForMemo(string(memo)), pagination)
+}
+
 func (t *TransactionIndexer) getByPrefix(prefix []byte, pagination *query.Page) (res []*types.TxResult, total int, err error) {
 	it, err := PrefixIterator(t.store, prefix, pagination.Sort)
 	if err != nil {
@@ -286,10 +422,10 @@ func prefixKeyForHeight(height int64) []byte {
 	))
 }
 
-func keyForSigner(result *types.TxResult) []byte {
+func keyForSigner(signer Address, result *types.TxResult) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s/%s",
 		TxSignerKey,
-		Address(result.Result.Signer),
+		signer,
 		elenEncoder.EncodeInt(int(result.Height)),
 		elenEncoder.EncodeInt(int(result.Index)),
 	))
@@ -311,10 +447,10 @@ func prefixKeyForSignerAndHeight(signer Address, height int64) []byte {
 	))
 }
 
-func keyForRecipient(result *types.TxResult) []byte {
+func keyForRecipient(recipient Address, result *types.TxResult) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s/%s",
 		TxRecipientKey,
-		Address(result.Result.Recipient),
+		recipient,
 		elenEncoder.EncodeInt(int(result.Height)),
 		elenEncoder.EncodeInt(int(result.Index)),
 	))
@@ -336,6 +472,57 @@ func prefixKeyForRecipientAndHeight(recipient Address, height int64) []byte {
 	))
 }
 
+func keyForMessageType(messageType string, result *types.TxResult) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s",
+		TxMessageTypeKey,
+		messageType,
+		elenEncoder.EncodeInt(int(result.Height)),
+		elenEncoder.EncodeInt(int(result.Index)),
+	))
+}
+
+func prefixKeyForMessageType(messageType string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s",
+		TxMessageTypeKey,
+		messageType,
+		elenEncoder.EncodeInt(0),
+	))
+}
+
+func prefixKeyForMessageTypeAndHeight(messageType string, height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s",
+		TxMessageTypeKey,
+		messageType,
+		elenEncoder.EncodeInt(int(height)),
+	))
+}
+
+// the memo is hex encoded in the keys, as it may contain the separator
+func keyForMemo(memo string, result *types.TxResult) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s",
+		TxMemoKey,
+		hex.EncodeToString([]byte(memo)),
+		elenEncoder.EncodeInt(int(result.Height)),
+		elenEncoder.EncodeInt(int(result.Index)),
+	))
+}
+
+func prefixKeyForMemo(memo string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s",
+		TxMemoKey,
+		hex.EncodeToString([]byte(memo)),
+		elenEncoder.EncodeInt(0),
+	))
+}
+
+func prefixKeyForMemoAndHeight(memo string, height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s",
+		TxMemoKey,
+		hex.EncodeToString([]byte(memo)),
+		elenEncoder.EncodeInt(int(height)),
+	))
+}
+
 // contract: caller must close iterator
 func PrefixIterator(db dbm.DB, prefix []byte, order string) (dbm.Iterator, error) {
 	switch order {
@@ -0,0 +1,177 @@
+package types
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type memoTx struct {
+	memo string
+}
+
+func (tx memoTx) GetMsg() Msg          { return nil }
+func (tx memoTx) ValidateBasic() Error { return nil }
+func (tx memoTx) GetMemo() string      { return tx.memo }
+
+// the memo of the test transactions is their bytes before '#', the rest makes their hash unique
+func memoTxDecoder(txBytes []byte, _ int64) (Tx, Error) {
+	return memoTx{memo: strings.Split(string(txBytes), "#")[0]}, nil
+}
+
+type sendTestMsg struct {
+	from, to Address
+}
+
+func (msg sendTestMsg) Route() string         { return "pos" }
+func (msg sendTestMsg) Type() string          { return "send" }
+func (msg sendTestMsg) ValidateBasic() Error  { return nil }
+func (msg sendTestMsg) GetSignBytes() []byte  { return nil }
+func (msg sendTestMsg) GetSigners() []Address { return []Address{msg.from} }
+func (msg sendTestMsg) GetRecipient() Address { return msg.to }
+func (msg sendTestMsg) GetFee() BigInt        { return NewInt(10000) }
+
+type multiMsgTestTx struct {
+	msgs    []Msg
+	signers []crypto.PublicKey
+}
+
+func (tx multiMsgTestTx) GetMsg() Msg                         { return tx.msgs[0] }
+func (tx multiMsgTestTx) ValidateBasic() Error                { return nil }
+func (tx multiMsgTestTx) IsMultiMsg() bool                    { return true }
+func (tx multiMsgTestTx) GetMsgs() []Msg                      { return tx.msgs }
+func (tx multiMsgTestTx) GetMsgSigner(i int) crypto.PublicKey { return tx.signers[i] }
+
+func indexerTestResults() []*types.TxResult {
+	result := func(height int64, index uint32, memo, messageType string) *types.TxResult {
+		return &types.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     types.Tx(fmt.Sprintf("%s#%d/%d", memo, height, index)),
+			Result: abci.ResponseDeliverTx{MessageType: messageType},
+		}
+	}
+	return []*types.TxResult{
+		result(1, 0, "payment/1", "send"),
+		result(1, 1, "", "claim"),
+		result(2, 0, "payment/2", "send"),
+		result(3, 0, "payment/1", "proof"),
+		result(3, 1, "payment/1/other", "send"),
+	}
+}
+
+func searchIndexer(t *testing.T, indexer *TransactionIndexer, q string) []*types.TxResult {
+	parsed := query.MustParse(q)
+	parsed.AddPage(100, 0, SortDescending)
+	res, total, err := indexer.Search(context.Background(), parsed)
+	require.Nil(t, err)
+	require.Len(t, res, total)
+	return res
+}
+
+func TestTransactionIndexer_MessageTypeAndMemo(t *testing.T) {
+	indexer := NewTransactionIndexer(dbm.NewMemDB()).WithMemoIndexing(memoTxDecoder)
+	// a batch per block
+	batches := make(map[int64]*txindex.Batch)
+	for _, result := range indexerTestResults() {
+		if batches[result.Height] == nil {
+			batches[result.Height] = &txindex.Batch{}
+		}
+		batches[result.Height].Ops = append(batches[result.Height].Ops, result)
+	}
+	for _, batch := range batches {
+		require.Nil(t, indexer.AddBatch(batch))
+	}
+	require.Len(t, searchIndexer(t, indexer, "tx.message_type='send'"), 3)
+	require.Len(t, searchIndexer(t, indexer, "tx.message_type='claim'"), 1)
+	require.Len(t, searchIndexer(t, indexer, "tx.message_type='stake'"), 0)
+	// from a height onwards
+	require.Len(t, searchIndexer(t, indexer, "tx.message_type='send' AND tx.height=2"), 2)
+	// the memo is matched exactly, even with the separator
+	memo := hex.EncodeToString([]byte("payment/1"))
+	res := searchIndexer(t, indexer, "tx.memo='"+memo+"'")
+	require.Len(t, res, 2)
+	for _, r := range res {
+		require.True(t, strings.HasPrefix(string(r.Tx), "payment/1#"))
+	}
+	require.Len(t, searchIndexer(t, indexer, "tx.memo='"+memo+"' AND tx.height=3"), 1)
+	// the memo operand is hex
+	parsed := query.MustParse("tx.memo='payment'")
+	parsed.AddPage(100, 0, SortDescending)
+	_, _, err := indexer.Search(context.Background(), parsed)
+	require.NotNil(t, err)
+	// and a string
+	parsed = query.MustParse("tx.memo=5")
+	parsed.AddPage(100, 0, SortDescending)
+	_, _, err = indexer.Search(context.Background(), parsed)
+	require.NotNil(t, err)
+}
+
+func TestTransactionIndexer_Reindex(t *testing.T) {
+	db := dbm.NewMemDB()
+	// indexed without memos
+	indexer := NewTransactionIndexer(db)
+	for _, result := range indexerTestResults() {
+		require.Nil(t, indexer.Index(result))
+	}
+	memo := hex.EncodeToString([]byte("payment/1"))
+	require.Len(t, searchIndexer(t, indexer, "tx.memo='"+memo+"'"), 0)
+	// reindexed with memos
+	indexer = NewTransactionIndexer(db).WithMemoIndexing(memoTxDecoder)
+	count, err := indexer.Reindex(2)
+	require.Nil(t, err)
+	require.Equal(t, 3, count)
+	require.Len(t, searchIndexer(t, indexer, "tx.memo='"+memo+"'"), 1)
+	count, err = indexer.Reindex(0)
+	require.Nil(t, err)
+	require.Equal(t, 5, count)
+	require.Len(t, searchIndexer(t, indexer, "tx.memo='"+memo+"'"), 2)
+	require.Len(t, searchIndexer(t, indexer, "tx.message_type='send'"), 3)
+}
+
+func TestTransactionIndexer_MultiMsg(t *testing.T) {
+	payer, other := crypto.GenerateEd25519PrivKey().PublicKey(), crypto.GenerateEd25519PrivKey().PublicKey()
+	recipients := []Address{Address(crypto.GenerateEd25519PrivKey().PublicKey().Address()), Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())}
+	tx := multiMsgTestTx{
+		msgs: []Msg{
+			sendTestMsg{from: Address(payer.Address()), to: recipients[0]},
+			sendTestMsg{from: Address(other.Address()), to: recipients[1]},
+		},
+		signers: []crypto.PublicKey{payer, other},
+	}
+	decoder := func(txBytes []byte, _ int64) (Tx, Error) { return tx, nil }
+	// the result only holds the first message
+	result := &types.TxResult{
+		Height: 1,
+		Tx:     types.Tx("multi"),
+		Result: abci.ResponseDeliverTx{Signer: payer.Address(), Recipient: recipients[0], MessageType: "send"},
+	}
+	count := func(indexer *TransactionIndexer, key string, addr Address) int {
+		return len(searchIndexer(t, indexer, fmt.Sprintf("%s='%s'", key, addr)))
+	}
+	// every message is indexed
+	indexer := NewTransactionIndexer(dbm.NewMemDB()).WithTxDecoder(decoder)
+	require.Nil(t, indexer.Index(result))
+	for _, recipient := range recipients {
+		require.Equal(t, 1, count(indexer, TxRecipientKey, recipient))
+	}
+	require.Equal(t, 1, count(indexer, TxSignerKey, Address(payer.Address())))
+	require.Equal(t, 1, count(indexer, TxSignerKey, Address(other.Address())))
+	require.Len(t, searchIndexer(t, indexer, "tx.message_type='send'"), 1)
+	// without a tx decoder only the first message is
+	indexer = NewTransactionIndexer(dbm.NewMemDB())
+	require.Nil(t, indexer.Index(result))
+	require.Equal(t, 1, count(indexer, TxRecipientKey, recipients[0]))
+	require.Equal(t, 0, count(indexer, TxRecipientKey, recipients[1]))
+	require.Equal(t, 0, count(indexer, TxSignerKey, Address(other.Address())))
+}
@@ -27,6 +27,15 @@ var (
 		"ServicerStakeWeightMultiplier":        codec.RSCALKey,
 		"ServicerStakeWeightCeiling":           codec.RSCALKey,
 		"ServicerStakeFloorMultiplierExponent": codec.RSCALKey,
+		"proposalVotingPeriod":                 codec.GovProposalKey,
+		"proposalVoterSet":                     codec.GovProposalKey,
+		"proposalQuorum":                       codec.GovProposalKey,
+		"proposalThreshold":                    codec.GovProposalKey,
+		"daoSpendPeriod":                       codec.DAOTreasuryKey,
+		"daoSpendCap":                          codec.DAOTreasuryKey,
+		"daoTimelockThreshold":                 codec.DAOTreasuryKey,
+		"daoTimelockPeriod":                    codec.DAOTreasuryKey,
+		"MaxMsgsPerTx":                         codec.MultiMsgTxKey,
 	}
 )
 
@@ -245,6 +254,28 @@ func (s Subspace) Update(ctx Ctx, key []byte, param []byte) error {
 	return nil
 }
 
+// UpdateUntracked stores raw parameter bytes like Update, without recording the
+// change in the transient store, which contexts of past heights do not have.
+// It returns error if the parameter is not registered.
+func (s Subspace) UpdateUntracked(ctx Ctx, key []byte, param []byte) error {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return errors.New("parameter " + string(key) + " not registered")
+	}
+
+	dest := reflect.New(attr.ty).Interface()
+	s.GetIfExists(ctx, key, dest)
+	err := s.cdc.UnmarshalJSON(param, dest)
+	if err != nil {
+		return err
+	}
+
+	store := s.kvStore(ctx)
+	// Sort marshaled JSON to make sure a map param value is canonicalized.
+	bz := MustSortJSON(s.cdc.MustMarshalJSON(dest))
+	return store.Set(key, bz)
+}
+
 // SetWithSubkey set a parameter with a key and subkey
 // Checks parameter type only over the key
 func (s Subspace) SetWithSubkey(ctx Ctx, key []byte, subkey []byte, param interface{}) {
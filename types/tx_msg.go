@@ -1,6 +1,9 @@
 package types
 
-import "github.com/golang/protobuf/proto" // nolint
+import (
+	"github.com/golang/protobuf/proto" // nolint
+	"github.com/pokt-network/pocket-core/crypto"
+)
 
 type Msg interface {
 	// Return the message type.
@@ -74,6 +77,20 @@ type Tx interface {
 	ValidateBasic() Error
 }
 
+// MultiMsgTx is a transaction that may carry an ordered list of messages, executed atomically
+type MultiMsgTx interface {
+	Tx
+
+	// Whether the transaction carries a list of messages
+	IsMultiMsg() bool
+
+	// Gets all the transaction's messages, in order
+	GetMsgs() []Msg
+
+	// Gets the public key of the signer of the message at index i
+	GetMsgSigner(i int) crypto.PublicKey
+}
+
 //__________________________________________________________
 
 // TxDecoder unmarshals transaction bytes
@@ -2,6 +2,7 @@ package pos
 
 import (
 	"fmt"
+	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/apps/keeper"
 	"github.com/pokt-network/pocket-core/x/apps/types"
@@ -46,6 +47,10 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			log.Fatal(fmt.Errorf("%s module account total does not equal the amount in each application account", types.StakedPoolName))
 		}
 	}
+	// set the revoked client public keys
+	for _, revoked := range data.RevokedClients {
+		keeper.SetRevokedClient(ctx, revoked)
+	}
 	// add coins to the total supply
 	keeper.AccountKeeper.SetSupply(ctx, keeper.AccountKeeper.GetSupply(ctx).Inflate(stakedCoins))
 	// set the params set in the keeper
@@ -56,10 +61,16 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 	applications := keeper.GetAllApplications(ctx)
+	var revokedClients []types.RevokedClient
+	keeper.IterateAndExecuteOverRevokedClients(ctx, func(revoked types.RevokedClient) (stop bool) {
+		revokedClients = append(revokedClients, revoked)
+		return false
+	})
 	return types.GenesisState{
-		Params:       params,
-		Applications: applications,
-		Exported:     true,
+		Params:         params,
+		Applications:   applications,
+		RevokedClients: revokedClients,
+		Exported:       true,
 	}
 }
 
@@ -74,6 +85,27 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateRevokedClients(data.RevokedClients)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateGenesisStateRevokedClients(revokedClients []types.RevokedClient) error {
+	counts := make(map[string]int)
+	for _, revoked := range revokedClients {
+		if revoked.Address.Empty() {
+			return fmt.Errorf("revoked client public key without application address in genesis state: %v", revoked)
+		}
+		if _, err := crypto.NewPublicKey(revoked.ClientPubKey); err != nil {
+			return fmt.Errorf("invalid revoked client public key in genesis state: %v", revoked)
+		}
+		counts[revoked.Address.String()]++
+		if counts[revoked.Address.String()] > types.MaxRevokedClients {
+			return fmt.Errorf("application has more than %d revoked client public keys in genesis state: address %v", types.MaxRevokedClients, revoked.Address)
+		}
+	}
 	return nil
 }
 
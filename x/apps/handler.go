@@ -3,6 +3,7 @@ package pos
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -24,6 +25,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginUnstake(ctx, msg, k)
 		case types.MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case types.MsgRevokeClients:
+			return handleMsgRevokeClients(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -51,6 +54,8 @@ func handleStake(
 			"to", msg.PubKey.Address().String(),
 		)
 		k.TransferApplication(ctx, curApp, msg.PubKey)
+	} else if err.Code() == types.CodeVestingTransfer {
+		return err.Result()
 	} else {
 		// otherwise check if the message is to stake an application
 		if err := k.ValidateApplicationStaking(ctx, application, msg.Value); err != nil {
@@ -131,3 +136,25 @@ func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgUnjail, k keeper.Keeper) sdk.Resu
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Applications may revoke client public keys so servicers reject the AATs issued to them
+func handleMsgRevokeClients(ctx sdk.Ctx, msg types.MsgRevokeClients, k keeper.Keeper) sdk.Result {
+	if err := k.ValidateRevokeClients(ctx, msg); err != nil {
+		return err.Result()
+	}
+	k.RevokeClients(ctx, msg)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeClients,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyApplication, msg.AppAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyClientPubKeys, strings.Join(msg.ClientPubKeys, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.AppAddr.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
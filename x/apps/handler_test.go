@@ -0,0 +1,75 @@
+package pos
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/exported"
+	"github.com/pokt-network/pocket-core/x/apps/keeper"
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleMsgUnjail_BelowMinimumStake(t *testing.T) {
+	ctx, k, ak, _ := createTestInput(t, false)
+	ctx = ctx.WithBlockHeight(codec.GetCodecUpgradeHeight())
+	handler := NewHandler(k)
+	stakedAppsCount := func() (count int) {
+		k.IterateAndExecuteOverStakedApps(ctx, func(_ int64, _ exported.ApplicationI) (stop bool) {
+			count++
+			return false
+		})
+		return
+	}
+	pub := crypto.GenerateEd25519PrivKey().PublicKey()
+	addr := sdk.Address(pub.Address())
+	acc := auth.NewBaseAccountWithAddress(addr)
+	acc.Coins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(100000000000)))
+	acc.PubKey = pub
+	ak.(auth.Keeper).SetAccount(ctx, &acc)
+	minimumStake := k.MinimumStake(ctx)
+	res := handler(ctx, types.MsgStake{PubKey: pub, Chains: []string{"0001"}, Value: sdk.NewInt(minimumStake)}, pub)
+	require.True(t, res.IsOK(), res.Log)
+	// the minimum stake is raised
+	params := k.GetParams(ctx)
+	params.AppStakeMin = minimumStake * 2
+	k.SetParams(ctx, params)
+	// before the upgrade the application keeps servicing
+	keeper.EndBlocker(ctx, k)
+	app, _ := k.GetApplication(ctx, addr)
+	assert.False(t, app.IsJailed())
+	codec.UpgradeFeatureMap[codec.AppJailKey] = ctx.BlockHeight()
+	t.Cleanup(func() {
+		delete(codec.UpgradeFeatureMap, codec.AppJailKey)
+	})
+	// after the upgrade the application is jailed at the end of the block
+	keeper.EndBlocker(ctx, k)
+	app, _ = k.GetApplication(ctx, addr)
+	assert.True(t, app.IsJailed())
+	assert.Equal(t, types.AttributeValueBelowMinimumStake, app.JailedReason)
+	assert.Equal(t, ctx.BlockHeight(), app.JailedHeight)
+	assert.Equal(t, 0, stakedAppsCount())
+	// it can't be unjailed before topping up its stake
+	res = handler(ctx, types.MsgUnjail{AppAddr: addr}, pub)
+	assert.Equal(t, types.ErrStakeTooLow(types.DefaultCodespace).Code(), res.Code)
+	res = handler(ctx, types.MsgStake{PubKey: pub, Chains: []string{"0001"}, Value: sdk.NewInt(minimumStake * 2)}, pub)
+	require.True(t, res.IsOK(), res.Log)
+	app, _ = k.GetApplication(ctx, addr)
+	assert.True(t, app.IsJailed())
+	assert.Equal(t, 0, stakedAppsCount())
+	res = handler(ctx, types.MsgUnjail{AppAddr: addr}, pub)
+	require.True(t, res.IsOK(), res.Log)
+	app, _ = k.GetApplication(ctx, addr)
+	assert.False(t, app.IsJailed())
+	assert.Empty(t, app.JailedReason)
+	assert.Zero(t, app.JailedHeight)
+	assert.Equal(t, 1, stakedAppsCount())
+	// the minimum didn't change, so the application isn't checked again
+	keeper.EndBlocker(ctx, k)
+	app, _ = k.GetApplication(ctx, addr)
+	assert.False(t, app.IsJailed())
+}
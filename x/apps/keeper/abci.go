@@ -11,5 +11,7 @@ func BeginBlocker(ctx sdk.Ctx, _ abci.RequestBeginBlock, k Keeper) {}
 func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	// Unstake all mature applications from the unstakeing queue.
 	k.unstakeAllMatureApplications(ctx)
+	// Jail the staked applications below the minimum stake, if it was raised.
+	k.JailApplicationsBelowMinimumStake(ctx)
 	return []abci.ValidatorUpdate{}
 }
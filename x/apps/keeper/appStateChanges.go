@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/exported"
 	"github.com/pokt-network/pocket-core/x/apps/types"
+	authexported "github.com/pokt-network/pocket-core/x/auth/exported"
 	"github.com/tendermint/tendermint/libs/strings"
 )
 
@@ -117,6 +120,12 @@ func (k Keeper) ValidateApplicationTransfer(
 		return types.Application{}, types.ErrApplicationStatus(k.codespace)
 	}
 
+	// The stake is unstaked to the new app, which would unlock the coins of a vesting account
+	if vacc, ok := k.AccountKeeper.GetAccount(ctx, curApp.Address).(authexported.VestingAccount); ok &&
+		!vacc.GetVestingCoins(ctx.BlockHeader().Time).IsZero() {
+		return types.Application{}, types.ErrVestingTransfer(k.codespace)
+	}
+
 	if err := ensurePubKeyTypeSupported(ctx, msg.PubKey, k.Codespace()); err != nil {
 		return types.Application{}, err
 	}
@@ -262,6 +271,8 @@ func (k Keeper) FinishUnstakingApplication(ctx sdk.Ctx, application types.Applic
 	application.UnstakingCompletionTime = time.Time{}
 	// update the application in the main store
 	k.SetApplication(ctx, application)
+	// the revocations only apply to the stake
+	k.DeleteRevokedClients(ctx, application.Address)
 	ctx.Logger().Info("Finished unstaking application " + application.Address.String())
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -298,6 +309,7 @@ func (k Keeper) LegacyForceApplicationUnstake(ctx sdk.Ctx, application types.App
 	application.MaxRelays = sdk.ZeroInt()
 	// set the application in store
 	k.SetApplication(ctx, application)
+	k.DeleteRevokedClients(ctx, application.Address)
 	ctx.Logger().Info("Force Unstaked application " + application.Address.String())
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -346,6 +358,7 @@ func (k Keeper) ForceApplicationUnstake(ctx sdk.Ctx, application types.Applicati
 		// set the validator in store
 		k.SetApplication(ctx, validator)
 	}
+	k.DeleteRevokedClients(ctx, application.Address)
 	ctx.Logger().Info("Force Unstaked validator " + application.Address.String())
 	return nil
 }
@@ -370,10 +383,12 @@ func (k Keeper) TransferApplication(
 	// (See unstakeAllMatureApplications calling DeleteApplication)
 	k.deleteApplicationFromStakingSet(ctx, curApp)
 	k.DeleteApplication(ctx, curApp.Address)
+	// the revocations of the current app don't follow the stake to the new key
+	k.DeleteRevokedClients(ctx, curApp.Address)
 }
 
-// JailApplication - Send a application to jail
-func (k Keeper) JailApplication(ctx sdk.Ctx, addr sdk.Address) {
+// JailApplication - Send a application to jail for the given reason
+func (k Keeper) JailApplication(ctx sdk.Ctx, addr sdk.Address, reason string) {
 	application, found := k.GetApplication(ctx, addr)
 	if !found {
 		k.Logger(ctx).Error(fmt.Errorf("application %s is attempted jailed but not found in all applications store", addr).Error())
@@ -384,10 +399,47 @@ func (k Keeper) JailApplication(ctx sdk.Ctx, addr sdk.Address) {
 		return
 	}
 	application.Jailed = true
+	application.JailedReason = reason
+	application.JailedHeight = ctx.BlockHeight()
 	k.SetApplication(ctx, application)
 	k.deleteApplicationFromStakingSet(ctx, application)
 	logger := k.Logger(ctx)
-	logger.Info(fmt.Sprintf("application %s jailed", addr))
+	logger.Info(fmt.Sprintf("application %s jailed: %s", addr, reason))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJail,
+			sdk.NewAttribute(types.AttributeKeyApplication, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+}
+
+// JailApplicationsBelowMinimumStake - Jail the staked applications left below a raised minimum stake, instead of
+// letting them service relays; they top up their stake and unjail themselves to recover
+func (k Keeper) JailApplicationsBelowMinimumStake(ctx sdk.Ctx) {
+	if !k.Cdc.IsAfterAppJailUpgrade(ctx.BlockHeight()) {
+		return
+	}
+	minimumStake := k.MinimumStake(ctx)
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.MinimumStakeKey)
+	if bz != nil && int64(binary.BigEndian.Uint64(bz)) == minimumStake {
+		// the staked applications were already checked against this minimum
+		return
+	}
+	_ = store.Set(types.MinimumStakeKey, sdk.Uint64ToBigEndian(uint64(minimumStake)))
+	belowMinimum := make([]sdk.Address, 0)
+	k.IterateAndExecuteOverStakedApps(ctx, func(_ int64, application exported.ApplicationI) (stop bool) {
+		if application.GetTokens().LT(sdk.NewInt(minimumStake)) && !application.IsJailed() {
+			belowMinimum = append(belowMinimum, application.GetAddress())
+		}
+		return false
+	})
+	// jailing removes the application from the staking set, so it's not done while iterating over it
+	for _, addr := range belowMinimum {
+		k.JailApplication(ctx, addr, types.AttributeValueBelowMinimumStake)
+	}
 }
 
 func (k Keeper) IncrementJailedApplications(ctx sdk.Ctx) {
@@ -412,7 +464,10 @@ func (k Keeper) ValidateUnjailMessage(ctx sdk.Ctx, msg types.MsgUnjail) (addr sd
 	if !application.IsJailed() {
 		return nil, types.ErrApplicationNotJailed(k.Codespace())
 	}
-	return
+	if !k.Cdc.IsAfterAppJailUpgrade(ctx.BlockHeight()) {
+		return
+	}
+	return application.Address, nil
 }
 
 // UnjailApplication - Remove a application from jail
@@ -427,7 +482,24 @@ func (k Keeper) UnjailApplication(ctx sdk.Ctx, addr sdk.Address) {
 		return
 	}
 	application.Jailed = false
+	if !k.Cdc.IsAfterAppJailUpgrade(ctx.BlockHeight()) {
+		k.SetApplication(ctx, application)
+		k.Logger(ctx).Info(fmt.Sprintf("application %s unjailed", addr))
+		return
+	}
+	application.JailedReason = ""
+	application.JailedHeight = 0
 	k.SetApplication(ctx, application)
+	if application.IsStaked() {
+		// jailing removed the application from the staking set
+		k.SetStakedApplication(ctx, application)
+	}
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("application %s unjailed", addr))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(types.AttributeKeyApplication, addr.String()),
+		),
+	)
 }
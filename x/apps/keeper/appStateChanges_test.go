@@ -3,6 +3,7 @@ package keeper
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/pocket-core/x/auth"
 )
 
 func TestAppStateChange_ValidateApplicaitonBeginUnstaking(t *testing.T) {
@@ -154,9 +156,9 @@ func TestAppStateChange_JailApplication(t *testing.T) {
 
 			switch tt.hasError {
 			case true:
-				keeper.JailApplication(context, tt.application.GetAddress())
+				keeper.JailApplication(context, tt.application.GetAddress(), "test")
 			default:
-				keeper.JailApplication(context, tt.application.GetAddress())
+				keeper.JailApplication(context, tt.application.GetAddress(), "test")
 				if got, _ := keeper.GetApplication(context, tt.application.GetAddress()); got.Jailed != tt.want {
 					t.Errorf("AppStateChange.ValidateApplicationBeginUnstaking() = got %v, want %v", tt.application.Jailed, tt.want)
 				}
@@ -208,6 +210,40 @@ func TestAppStateChange_UnjailApplication(t *testing.T) {
 	}
 }
 
+func TestAppStateChange_JailAndUnjailApplication(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	context = context.WithBlockHeight(10)
+	keeper.JailApplication(context, application.Address, "test")
+	got, _ := keeper.GetApplication(context, application.Address)
+	assert.True(t, got.Jailed)
+	assert.Equal(t, "test", got.JailedReason)
+	assert.Equal(t, int64(10), got.JailedHeight)
+	assert.Equal(t, int64(0), keeper.getStakedApplicationsCount(context))
+	assert.Equal(t, types.EventTypeJail, context.EventManager().Events()[0].Type)
+	// before the upgrade the unjail message doesn't unjail the application
+	addr, err := keeper.ValidateUnjailMessage(context, types.MsgUnjail{AppAddr: application.Address})
+	assert.Nil(t, err)
+	assert.Nil(t, addr)
+	codec.UpgradeFeatureMap[codec.AppJailKey] = 10
+	t.Cleanup(func() {
+		delete(codec.UpgradeFeatureMap, codec.AppJailKey)
+	})
+	addr, err = keeper.ValidateUnjailMessage(context, types.MsgUnjail{AppAddr: application.Address})
+	assert.Nil(t, err)
+	assert.Equal(t, application.Address, addr)
+	keeper.UnjailApplication(context, addr)
+	got, _ = keeper.GetApplication(context, application.Address)
+	assert.False(t, got.Jailed)
+	assert.Empty(t, got.JailedReason)
+	assert.Zero(t, got.JailedHeight)
+	assert.Equal(t, int64(1), keeper.getStakedApplicationsCount(context))
+	_, err = keeper.ValidateUnjailMessage(context, types.MsgUnjail{AppAddr: application.Address})
+	assert.Equal(t, types.CodeApplicationNotJailed, err.Code())
+}
+
 func TestAppStateChange_StakeApplication(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -445,7 +481,7 @@ func TestAppStateChange_Transfer(t *testing.T) {
 	// apps[2]: staked and jailed
 	// apps[3]: not an application (see pubKeys[3]) and will be used for the transfer
 	keeper.BeginUnstakingApplication(ctx, apps[1])
-	keeper.JailApplication(ctx, apps[2].Address)
+	keeper.JailApplication(ctx, apps[2].Address, "test")
 
 	stakedApps := keeper.GetApplications(ctx, uint16(len(apps)*2))
 	assert.Equal(t, len(apps), len(stakedApps))
@@ -512,3 +548,36 @@ func TestAppStateChange_Transfer(t *testing.T) {
 	assert.True(t, stakedApp.IsStaked())
 	assert.True(t, stakedApp.IsJailed())
 }
+
+func TestAppStateChange_TransferVestingApp(t *testing.T) {
+	originalUpgradeHeight := codec.UpgradeHeight
+	originalFeatKey := codec.UpgradeFeatureMap[codec.AppTransferKey]
+	t.Cleanup(func() {
+		codec.UpgradeHeight = originalUpgradeHeight
+		codec.UpgradeFeatureMap[codec.AppTransferKey] = originalFeatKey
+	})
+	codec.UpgradeHeight = -1
+	codec.UpgradeFeatureMap[codec.AppTransferKey] = -1
+
+	ctx, _, keeper := createTestInput(t, true)
+	start := time.Unix(1000, 0)
+	ctx = ctx.WithBlockTime(start.Add(100 * time.Second))
+	// an app staked with coins that are still vesting
+	app := createNewApplication()
+	amount := sdk.NewInt(10000000)
+	baseAccount := auth.NewBaseAccountWithAddress(app.Address)
+	baseAccount.Coins = sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(ctx), amount))
+	ak := keeper.AccountKeeper.(auth.Keeper)
+	ak.SetAccount(ctx, auth.NewContinuousVestingAccount(&baseAccount, start.Unix(), start.Unix()+1000))
+	assert.Nil(t, keeper.StakeApplication(ctx, app, amount))
+
+	// the transfer would unstake the locked coins to the new app
+	err := transferApp(t, &ctx, &keeper, app.PublicKey, getRandomPubKey())
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeVestingTransfer, err.Code())
+
+	// once the coins are vested the app can be transferred
+	ctx = ctx.WithBlockTime(start.Add(1000 * time.Second))
+	err = transferApp(t, &ctx, &keeper, app.PublicKey, getRandomPubKey())
+	assert.Nil(t, err)
+}
@@ -58,6 +58,31 @@ func (k Keeper) getAllUnstakingApplications(ctx sdk.Ctx) (applications []types.A
 	return applications
 }
 
+// GetUnstakingApplicationsInWindow - Retrieve the applications who will finish unstaking between start and end (inclusive)
+func (k Keeper) GetUnstakingApplicationsInWindow(ctx sdk.Ctx, start, end time.Time) (applications []types.Application) {
+	applications = make(types.Applications, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := store.Iterator(types.KeyForUnstakingApps(start), sdk.InclusiveEndBytes(types.KeyForUnstakingApps(end)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var addrs sdk.Addresses
+		err := k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &addrs, ctx.BlockHeight())
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Errorf("could not unmarshal unstakingApplications in GetUnstakingApplicationsInWindow call: %s", string(iterator.Value())).Error())
+			return
+		}
+		for _, addr := range addrs {
+			app, found := k.GetApplication(ctx, addr)
+			if !found {
+				k.Logger(ctx).Error(fmt.Errorf("application %s in unstakingSet but not found in all applications store", addr).Error())
+				continue
+			}
+			applications = append(applications, app)
+		}
+	}
+	return applications
+}
+
 // getUnstakingApplications - Retrieve all of the applications who will be unstaked at exactly this time
 func (k Keeper) getUnstakingApplications(ctx sdk.Ctx, unstakingTime time.Time) (valAddrs sdk.Addresses) {
 	store := ctx.KVStore(k.storeKey)
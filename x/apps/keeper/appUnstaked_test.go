@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/apps/types"
@@ -266,3 +267,19 @@ func TestAppUnstaked_UnstakingApplicationsIterator(t *testing.T) {
 		})
 	}
 }
+
+func TestAppUnstaked_GetUnstakingApplicationsInWindow(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	now := context.BlockTime()
+	early := getUnstakingApplication()
+	early.UnstakingCompletionTime = now.Add(time.Hour)
+	late := getUnstakingApplication()
+	late.UnstakingCompletionTime = now.Add(3 * time.Hour)
+	keeper.SetApplication(context, early)
+	keeper.SetApplication(context, late)
+	applications := keeper.GetUnstakingApplicationsInWindow(context, now, now.Add(2*time.Hour))
+	assert.Len(t, applications, 1)
+	assert.Equal(t, early.Address, applications[0].Address)
+	applications = keeper.GetUnstakingApplicationsInWindow(context, now, now.Add(3*time.Hour))
+	assert.Len(t, applications, 2)
+}
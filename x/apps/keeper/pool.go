@@ -37,7 +37,7 @@ func (k Keeper) GetStakedPool(ctx sdk.Ctx) (stakedPool exported.ModuleAccountI)
 // coinsFromStakedToUnstkaed - Transfer coins from the module account to the application -> used in unstaking
 func (k Keeper) coinsFromStakedToUnstaked(ctx sdk.Ctx, application types.Application) sdk.Error {
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), application.StakedTokens))
-	err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, application.Address, coins)
+	err := k.AccountKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.StakedPoolName, application.Address, coins)
 	if err != nil {
 		return err
 	}
@@ -50,7 +50,7 @@ func (k Keeper) coinsFromUnstakedToStaked(ctx sdk.Ctx, application types.Applica
 		return sdk.ErrInternal("cannot stake a negative amount of coins")
 	}
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
-	err := k.AccountKeeper.SendCoinsFromAccountToModule(ctx, sdk.Address(application.Address), types.StakedPoolName, coins)
+	err := k.AccountKeeper.DelegateCoinsFromAccountToModule(ctx, sdk.Address(application.Address), types.StakedPoolName, coins)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/types"
+)
+
+// SetRevokedClient - Store a revoked client public key of the application
+func (k Keeper) SetRevokedClient(ctx sdk.Ctx, revoked types.RevokedClient) {
+	store := ctx.KVStore(k.storeKey)
+	clientPubKey, _ := hex.DecodeString(revoked.ClientPubKey)
+	_ = store.Set(types.KeyForRevokedClient(revoked.Address, clientPubKey), sdk.Uint64ToBigEndian(uint64(revoked.Height)))
+}
+
+// IsClientRevoked - Returns if the client public key was revoked by the application
+func (k Keeper) IsClientRevoked(ctx sdk.Ctx, address sdk.Address, clientPubKey string) bool {
+	pk, err := crypto.NewPublicKey(clientPubKey)
+	if err != nil {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	found, _ := store.Has(types.KeyForRevokedClient(address, pk.RawBytes()))
+	return found
+}
+
+// DeleteRevokedClients - Remove the revoked client public keys of the application
+func (k Keeper) DeleteRevokedClients(ctx sdk.Ctx, address sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	for _, revoked := range k.GetRevokedClients(ctx, address) {
+		clientPubKey, _ := hex.DecodeString(revoked.ClientPubKey)
+		_ = store.Delete(types.KeyForRevokedClient(address, clientPubKey))
+	}
+}
+
+// GetRevokedClients - Retrieve the revoked client public keys of the application
+func (k Keeper) GetRevokedClients(ctx sdk.Ctx, address sdk.Address) (revoked []types.RevokedClient) {
+	revoked = make([]types.RevokedClient, 0)
+	k.iterateAndExecuteOverRevokedClients(ctx, types.KeyForRevokedClients(address), func(r types.RevokedClient) (stop bool) {
+		revoked = append(revoked, r)
+		return false
+	})
+	return
+}
+
+// IterateAndExecuteOverRevokedClients - Goes over the revoked client public keys of all applications and executes handler
+func (k Keeper) IterateAndExecuteOverRevokedClients(ctx sdk.Ctx, handler func(revoked types.RevokedClient) (stop bool)) {
+	k.iterateAndExecuteOverRevokedClients(ctx, types.RevokedClientsKey, handler)
+}
+
+func (k Keeper) iterateAndExecuteOverRevokedClients(ctx sdk.Ctx, prefix []byte, handler func(revoked types.RevokedClient) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.RevokedClientsKey):]
+		revoked := types.RevokedClient{
+			Address:      sdk.Address(key[:sdk.AddrLen]),
+			ClientPubKey: hex.EncodeToString(key[sdk.AddrLen:]),
+			Height:       int64(binary.BigEndian.Uint64(iter.Value())),
+		}
+		if handler(revoked) {
+			break
+		}
+	}
+}
+
+// ValidateRevokeClients - Check the application can revoke the client public keys of the message
+func (k Keeper) ValidateRevokeClients(ctx sdk.Ctx, msg types.MsgRevokeClients) sdk.Error {
+	if !k.Cdc.IsAfterAppRevocationUpgrade(ctx.BlockHeight()) {
+		return sdk.ErrUnknownRequest("unrecognized application message type: " + msg.Type())
+	}
+	if _, found := k.GetApplication(ctx, msg.AppAddr); !found {
+		return types.ErrNoApplicationFound(k.Codespace())
+	}
+	// count the distinct keys that are not revoked yet
+	count := len(k.GetRevokedClients(ctx, msg.AppAddr))
+	seen := make(map[string]struct{}, len(msg.ClientPubKeys))
+	for _, clientPubKey := range msg.ClientPubKeys {
+		pk, err := crypto.NewPublicKey(clientPubKey)
+		if err != nil {
+			return types.ErrInvalidClientPubKey(k.Codespace(), err)
+		}
+		if _, ok := seen[pk.RawString()]; ok {
+			continue
+		}
+		seen[pk.RawString()] = struct{}{}
+		if !k.IsClientRevoked(ctx, msg.AppAddr, pk.RawString()) {
+			count++
+		}
+	}
+	if count > types.MaxRevokedClients {
+		return types.ErrTooManyRevokedClients(k.Codespace())
+	}
+	return nil
+}
+
+// RevokeClients - Store ops when an application revokes client public keys
+func (k Keeper) RevokeClients(ctx sdk.Ctx, msg types.MsgRevokeClients) {
+	for _, clientPubKey := range msg.ClientPubKeys {
+		pk, err := crypto.NewPublicKey(clientPubKey)
+		if err != nil || k.IsClientRevoked(ctx, msg.AppAddr, pk.RawString()) {
+			continue
+		}
+		k.SetRevokedClient(ctx, types.RevokedClient{
+			Address:      msg.AppAddr,
+			ClientPubKey: pk.RawString(),
+			Height:       ctx.BlockHeight(),
+		})
+	}
+}
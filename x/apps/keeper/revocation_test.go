@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeClients(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	application := getStakedApplication()
+	context, _, keeper := createTestInput(t, true)
+	clientPubKey := getRandomPubKey().RawString()
+	msg := types.MsgRevokeClients{AppAddr: application.Address, ClientPubKeys: []string{clientPubKey}}
+	// the application must exist
+	assert.NotNil(t, keeper.ValidateRevokeClients(context, msg))
+	keeper.SetApplication(context, application)
+	assert.Nil(t, keeper.ValidateRevokeClients(context, msg))
+	keeper.RevokeClients(context, msg)
+	assert.True(t, keeper.IsClientRevoked(context, application.Address, clientPubKey))
+	assert.False(t, keeper.IsClientRevoked(context, application.Address, getRandomPubKey().RawString()))
+	assert.False(t, keeper.IsClientRevoked(context, getRandomApplicationAddress(), clientPubKey))
+	revoked := keeper.GetRevokedClients(context, application.Address)
+	assert.Equal(t, []types.RevokedClient{{Address: application.Address, ClientPubKey: clientPubKey, Height: context.BlockHeight()}}, revoked)
+	// the key is normalised, whichever its case
+	assert.True(t, keeper.IsClientRevoked(context, application.Address, strings.ToUpper(clientPubKey)))
+	// revoking again does not count against the cap
+	keeper.RevokeClients(context, msg)
+	keeper.RevokeClients(context, types.MsgRevokeClients{AppAddr: application.Address, ClientPubKeys: []string{strings.ToUpper(clientPubKey)}})
+	assert.Len(t, keeper.GetRevokedClients(context, application.Address), 1)
+	// an invalid key is rejected
+	invalid := types.MsgRevokeClients{AppAddr: application.Address, ClientPubKeys: []string{getRandomPubKey().RawString(), "zz"}}
+	err := keeper.ValidateRevokeClients(context, invalid)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeInvalidClientPubKey), err.Code())
+	// fill the revocation set up to the cap
+	var clientPubKeys []string
+	for i := 0; i < types.MaxRevokedClients-1; i++ {
+		clientPubKeys = append(clientPubKeys, getRandomPubKey().RawString())
+	}
+	fill := types.MsgRevokeClients{AppAddr: application.Address, ClientPubKeys: clientPubKeys}
+	assert.Nil(t, keeper.ValidateRevokeClients(context, fill))
+	keeper.RevokeClients(context, fill)
+	// the keys already revoked, in any case, don't count against the cap
+	again := types.MsgRevokeClients{AppAddr: application.Address, ClientPubKeys: []string{clientPubKey, strings.ToUpper(clientPubKeys[0])}}
+	assert.Nil(t, keeper.ValidateRevokeClients(context, again))
+	over := types.MsgRevokeClients{AppAddr: application.Address, ClientPubKeys: []string{getRandomPubKey().RawString()}}
+	err = keeper.ValidateRevokeClients(context, over)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeTooManyRevokedClients), err.Code())
+	var all []types.RevokedClient
+	keeper.IterateAndExecuteOverRevokedClients(context, func(r types.RevokedClient) (stop bool) {
+		all = append(all, r)
+		return false
+	})
+	assert.Len(t, all, types.MaxRevokedClients)
+}
+
+func TestDeleteRevokedClients(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	context, _, keeper := createTestInput(t, true)
+	revoke := func(application types.Application) string {
+		clientPubKey := getRandomPubKey().RawString()
+		keeper.RevokeClients(context, types.MsgRevokeClients{AppAddr: application.Address, ClientPubKeys: []string{clientPubKey}})
+		assert.True(t, keeper.IsClientRevoked(context, application.Address, clientPubKey))
+		return clientPubKey
+	}
+	// unstaking clears the revocations
+	application := getUnstakingApplication()
+	keeper.SetApplication(context, application)
+	clientPubKey := revoke(application)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	keeper.FinishUnstakingApplication(context, application)
+	assert.False(t, keeper.IsClientRevoked(context, application.Address, clientPubKey))
+	assert.Empty(t, keeper.GetRevokedClients(context, application.Address))
+	// so does a force unstake
+	application = getStakedApplication()
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	clientPubKey = revoke(application)
+	assert.Nil(t, keeper.ForceApplicationUnstake(context, application))
+	assert.False(t, keeper.IsClientRevoked(context, application.Address, clientPubKey))
+	// and a transfer, for the current and the new app alike
+	application = getStakedApplication()
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	clientPubKey = revoke(application)
+	newAppPubKey := getRandomPubKey()
+	keeper.TransferApplication(context, application, newAppPubKey)
+	assert.False(t, keeper.IsClientRevoked(context, application.Address, clientPubKey))
+	assert.False(t, keeper.IsClientRevoked(context, sdk.Address(newAppPubKey.Address()), clientPubKey))
+}
@@ -23,6 +23,8 @@ type Application struct {
 	StakedTokens            sdk.BigInt       `json:"tokens" yaml:"tokens"`                 // tokens staked in the network
 	MaxRelays               sdk.BigInt       `json:"max_relays" yaml:"max_relays"`         // maximum number of relays allowed
 	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the application to complete unstaking
+	JailedReason            string           `json:"jailed_reason" yaml:"jailed_reason"`   // if jailed, why the application was jailed
+	JailedHeight            int64            `json:"jailed_height" yaml:"jailed_height"`   // if jailed, the height the application was jailed at
 }
 
 // NewApplication - initialize a new instance of an application
@@ -145,6 +147,8 @@ func (a Application) ToProto() ProtoApplication {
 		StakedTokens:            a.StakedTokens,
 		MaxRelays:               a.MaxRelays,
 		UnstakingCompletionTime: a.UnstakingCompletionTime,
+		JailedReason:            a.JailedReason,
+		JailedHeight:            a.JailedHeight,
 	}
 }
 
@@ -162,6 +166,8 @@ func (ae ProtoApplication) FromProto() (Application, error) {
 		StakedTokens:            ae.StakedTokens,
 		MaxRelays:               ae.MaxRelays,
 		UnstakingCompletionTime: ae.UnstakingCompletionTime,
+		JailedReason:            ae.JailedReason,
+		JailedHeight:            ae.JailedHeight,
 	}, nil
 }
 
@@ -177,8 +183,12 @@ func (a Applications) String() (out string) {
 
 // String returns a human readable string representation of a application.
 func (a Application) String() string {
-	return fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%v\nChains:\t\t\t%v\nMaxRelays:\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\nUnstaking Time:\t%v\n----\n",
-		a.Address, a.PublicKey.RawString(), a.Jailed, a.Chains, a.MaxRelays, a.Status, a.StakedTokens, a.UnstakingCompletionTime,
+	jailed := fmt.Sprintf("%v", a.Jailed)
+	if a.Jailed {
+		jailed = fmt.Sprintf("%v (%s at height %d)", a.Jailed, a.JailedReason, a.JailedHeight)
+	}
+	return fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%s\nChains:\t\t\t%v\nMaxRelays:\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\nUnstaking Time:\t%v\n----\n",
+		a.Address, a.PublicKey.RawString(), jailed, a.Chains, a.MaxRelays, a.Status, a.StakedTokens, a.UnstakingCompletionTime,
 	)
 }
 
@@ -192,6 +202,8 @@ type JSONApplication struct {
 	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                 // application status (staked/unstaking/unstaked)
 	StakedTokens            sdk.BigInt      `json:"staked_tokens" yaml:"staked_tokens"`   // how many staked tokens
 	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the application to complete unstaking
+	JailedReason            string          `json:"jailed_reason,omitempty" yaml:"jailed_reason"`
+	JailedHeight            int64           `json:"jailed_height,omitempty" yaml:"jailed_height"`
 }
 
 // marshal structure into JSON encoding
@@ -210,6 +222,8 @@ func (a Application) MarshalJSON() ([]byte, error) {
 		MaxRelays:               a.MaxRelays,
 		StakedTokens:            a.StakedTokens,
 		UnstakingCompletionTime: a.UnstakingCompletionTime,
+		JailedReason:            a.JailedReason,
+		JailedHeight:            a.JailedHeight,
 	})
 }
 
@@ -232,6 +246,8 @@ func (a *Application) UnmarshalJSON(data []byte) error {
 		StakedTokens:            bv.StakedTokens,
 		Status:                  bv.Status,
 		UnstakingCompletionTime: bv.UnstakingCompletionTime,
+		JailedReason:            bv.JailedReason,
+		JailedHeight:            bv.JailedHeight,
 	}
 	return nil
 }
@@ -49,6 +49,8 @@ type ProtoApplication struct {
 	StakedTokens            github_com_pokt_network_pocket_core_types.BigInt      `protobuf:"bytes,6,opt,name=staked_tokens,json=stakedTokens,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"tokens" yaml:"tokens"`
 	MaxRelays               github_com_pokt_network_pocket_core_types.BigInt      `protobuf:"bytes,7,opt,name=max_relays,json=maxRelays,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"max_relays" yaml:"max_relays"`
 	UnstakingCompletionTime time.Time                                             `protobuf:"bytes,8,opt,name=unstaking_completion_time,json=unstakingCompletionTime,proto3,stdtime" json:"unstaking_time" yaml:"unstaking_time"`
+	JailedReason            string                                                `protobuf:"bytes,9,opt,name=jailed_reason,json=jailedReason,proto3" json:"jailed_reason,omitempty" yaml:"jailed_reason"`
+	JailedHeight            int64                                                 `protobuf:"varint,10,opt,name=jailed_height,json=jailedHeight,proto3" json:"jailed_height,omitempty" yaml:"jailed_height"`
 }
 
 func (m *ProtoApplication) Reset()         { *m = ProtoApplication{} }
@@ -130,42 +132,46 @@ func init() {
 func init() { proto.RegisterFile("x/apps/apps.proto", fileDescriptor_5d5a21b1d350fd62) }
 
 var fileDescriptor_5d5a21b1d350fd62 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
+	// 622 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0xd1, 0xd6, 0x4d, 0x8e, 0xb6, 0xa2, 0x16, 0x08, 0x53, 0x24, 0x9f, 0x65, 0x16, 0x2f,
-	0xb5, 0x81, 0x08, 0x09, 0x65, 0xab, 0x99, 0x80, 0x25, 0x72, 0x32, 0xc1, 0x10, 0x5d, 0x9c, 0xc3,
-	0x35, 0xfe, 0x71, 0x56, 0x7c, 0x11, 0x09, 0x7f, 0x01, 0x12, 0x4b, 0xff, 0x84, 0xcc, 0xfc, 0x25,
-	0x1d, 0x3b, 0x22, 0x86, 0x03, 0x25, 0x0b, 0xca, 0x84, 0x32, 0x32, 0xa1, 0xbb, 0x73, 0xea, 0xb2,
-	0x45, 0xb0, 0x44, 0xf7, 0xde, 0x97, 0xf7, 0xde, 0x77, 0x9f, 0x3f, 0x1b, 0x1e, 0x4f, 0x3d, 0x5c,
-	0x14, 0xa5, 0xfc, 0x71, 0x8b, 0x31, 0x65, 0x54, 0xd7, 0xa6, 0xae, 0x40, 0x27, 0x77, 0x23, 0x1a,
-	0x51, 0x49, 0x79, 0xe2, 0xa4, 0xaa, 0x27, 0x28, 0xa2, 0x34, 0x4a, 0x89, 0x27, 0xd1, 0x70, 0xf2,
-	0xce, 0x63, 0x71, 0x46, 0x4a, 0x86, 0xb3, 0x42, 0xfd, 0xc1, 0xfe, 0xa2, 0xc1, 0x3b, 0x5d, 0x71,
-	0x3a, 0x2b, 0x8a, 0x34, 0x0e, 0x31, 0x8b, 0x69, 0xae, 0xa7, 0x70, 0x1f, 0x8f, 0x46, 0x63, 0x52,
-	0x96, 0x06, 0xb0, 0x80, 0x73, 0xe0, 0x07, 0x2b, 0x8e, 0x36, 0xd4, 0x9a, 0xa3, 0xa3, 0x19, 0xce,
-	0xd2, 0x8e, 0x5d, 0x11, 0xf6, 0x6f, 0x8e, 0x9e, 0x44, 0x31, 0x3b, 0x9f, 0x0c, 0xdd, 0x90, 0x66,
-	0x5e, 0x41, 0x13, 0x76, 0x9a, 0x13, 0xf6, 0x81, 0x8e, 0x13, 0xaf, 0xa0, 0x61, 0x42, 0xd8, 0x69,
-	0x48, 0xc7, 0xc4, 0x63, 0xb3, 0x82, 0x94, 0xee, 0x99, 0x52, 0x05, 0x1b, 0x3f, 0xdd, 0x87, 0xb0,
-	0x98, 0x0c, 0xd3, 0x38, 0x1c, 0x24, 0x64, 0x66, 0xdc, 0x92, 0x81, 0x8f, 0x56, 0x1c, 0xdd, 0x60,
-	0xd7, 0x1c, 0x1d, 0xab, 0xcc, 0x9a, 0xb3, 0x83, 0x96, 0x02, 0xaf, 0xc9, 0x4c, 0x6f, 0x43, 0xed,
-	0x3d, 0x8e, 0x53, 0x32, 0x32, 0x76, 0x2c, 0xe0, 0x34, 0xfd, 0x87, 0x2b, 0x8e, 0x2a, 0x66, 0xcd,
-	0xd1, 0xa1, 0xd2, 0x2a, 0x6c, 0x07, 0x55, 0x41, 0x4f, 0xa1, 0x56, 0x32, 0xcc, 0x26, 0xa5, 0xb1,
-	0x6b, 0x01, 0x67, 0xcf, 0xef, 0x0b, 0x91, 0x62, 0x6a, 0x91, 0xc2, 0xe2, 0x8e, 0xcf, 0xb6, 0xbf,
-	0x63, 0x8f, 0xe1, 0x84, 0xf4, 0xa4, 0x32, 0xa8, 0x1c, 0x45, 0x8b, 0xe1, 0x39, 0x8e, 0xf3, 0xd2,
-	0xd8, 0xb3, 0x76, 0x9c, 0x96, 0x6a, 0x51, 0x31, 0x75, 0x9a, 0xc2, 0x76, 0x50, 0x15, 0xf4, 0x29,
-	0x3c, 0x2c, 0x85, 0xd7, 0x68, 0xc0, 0x68, 0x42, 0xf2, 0xd2, 0xd0, 0x2c, 0xe0, 0xb4, 0xfc, 0xde,
-	0x25, 0x47, 0x8d, 0x6f, 0x1c, 0x3d, 0xde, 0xbe, 0x25, 0x3f, 0x8e, 0x5e, 0xe6, 0x4c, 0x64, 0x2a,
-	0xa7, 0x3a, 0x53, 0x61, 0x3b, 0x38, 0x50, 0x49, 0x7d, 0x09, 0xf5, 0x8f, 0x10, 0x66, 0x78, 0x3a,
-	0x18, 0x93, 0x14, 0xcf, 0x4a, 0x63, 0x5f, 0xc6, 0xbe, 0xfd, 0x8f, 0xd8, 0x1b, 0x6e, 0xf5, 0xd3,
-	0xac, 0x39, 0x3b, 0x68, 0x65, 0x78, 0x1a, 0xc8, 0xb3, 0xfe, 0x19, 0xc0, 0x07, 0x93, 0x5c, 0xb4,
-	0x13, 0xe7, 0xd1, 0x20, 0xa4, 0x59, 0x91, 0x12, 0xb1, 0x98, 0x03, 0xb1, 0xbd, 0x46, 0xd3, 0x02,
-	0xce, 0xed, 0xa7, 0x27, 0xae, 0x5a, 0x6d, 0x77, 0xb3, 0xda, 0x6e, 0x7f, 0xb3, 0xda, 0x7e, 0x5b,
-	0xf4, 0xb9, 0xe2, 0xe8, 0xa8, 0x36, 0x11, 0xca, 0x35, 0x47, 0xf7, 0x54, 0xee, 0xdf, 0xbc, 0x7d,
-	0xf1, 0x1d, 0x81, 0xe0, 0xfe, 0x35, 0xf9, 0xe2, 0x3a, 0x50, 0x58, 0x76, 0x9a, 0x9f, 0xe6, 0xa8,
-	0xf1, 0x73, 0x8e, 0x80, 0x3d, 0x84, 0xbb, 0x5d, 0x4a, 0x53, 0xbd, 0x0b, 0xab, 0x21, 0xca, 0xd7,
-	0xa3, 0xe5, 0x3f, 0xff, 0xd7, 0xb9, 0x04, 0x95, 0x4f, 0xa7, 0x29, 0xfc, 0x7f, 0xcd, 0x11, 0xf0,
-	0x5f, 0x5d, 0x2e, 0x4c, 0x70, 0xb5, 0x30, 0xc1, 0x8f, 0x85, 0x09, 0x2e, 0x96, 0x66, 0xe3, 0x6a,
-	0x69, 0x36, 0xbe, 0x2e, 0xcd, 0xc6, 0x9b, 0xad, 0xdc, 0xab, 0x0f, 0x84, 0x0c, 0x19, 0x6a, 0x72,
-	0x36, 0xed, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x38, 0x85, 0x13, 0xd9, 0x37, 0x04, 0x00, 0x00,
+	0x18, 0xcd, 0xd1, 0x36, 0x4d, 0x8e, 0xb6, 0xa2, 0x56, 0x51, 0x4d, 0x91, 0x72, 0x96, 0x59, 0x3c,
+	0x50, 0x1b, 0x88, 0x90, 0x50, 0xb7, 0x9a, 0x85, 0x1f, 0x4b, 0x75, 0xed, 0x80, 0x60, 0x88, 0x2e,
+	0xce, 0xe1, 0x98, 0xd8, 0x3e, 0x2b, 0x77, 0x11, 0x09, 0x7f, 0x01, 0x12, 0x4b, 0xff, 0x84, 0xfe,
+	0x39, 0x1d, 0x3b, 0x22, 0x86, 0x03, 0xb5, 0x0b, 0xca, 0x84, 0x32, 0x32, 0xa1, 0xbb, 0x73, 0xea,
+	0x74, 0x41, 0x11, 0x2c, 0xd1, 0xbd, 0xf7, 0xdd, 0xf7, 0xde, 0x77, 0xf9, 0x5e, 0x02, 0xb7, 0xc7,
+	0x01, 0x29, 0x0a, 0xae, 0x3f, 0xfc, 0x62, 0xc8, 0x04, 0xb3, 0xea, 0x63, 0x5f, 0xa1, 0xbd, 0x9d,
+	0x98, 0xc5, 0x4c, 0x53, 0x81, 0x3a, 0x99, 0xea, 0x1e, 0x8a, 0x19, 0x8b, 0x53, 0x1a, 0x68, 0xd4,
+	0x1d, 0xbd, 0x0f, 0x44, 0x92, 0x51, 0x2e, 0x48, 0x56, 0x98, 0x0b, 0xae, 0x5c, 0x87, 0x77, 0x8e,
+	0xd4, 0xe9, 0xb0, 0x28, 0xd2, 0x24, 0x22, 0x22, 0x61, 0xb9, 0x95, 0xc2, 0x75, 0xd2, 0xeb, 0x0d,
+	0x29, 0xe7, 0x36, 0x70, 0x80, 0xb7, 0x11, 0xe2, 0xa9, 0x44, 0x73, 0x6a, 0x26, 0xd1, 0xd6, 0x84,
+	0x64, 0xe9, 0x81, 0x5b, 0x12, 0xee, 0x6f, 0x89, 0x1e, 0xc7, 0x89, 0xe8, 0x8f, 0xba, 0x7e, 0xc4,
+	0xb2, 0xa0, 0x60, 0x03, 0xb1, 0x9f, 0x53, 0xf1, 0x91, 0x0d, 0x07, 0x41, 0xc1, 0xa2, 0x01, 0x15,
+	0xfb, 0x11, 0x1b, 0xd2, 0x40, 0x4c, 0x0a, 0xca, 0xfd, 0x43, 0xd3, 0x85, 0xe7, 0x7a, 0x56, 0x08,
+	0x61, 0x31, 0xea, 0xa6, 0x49, 0xd4, 0x19, 0xd0, 0x89, 0x7d, 0x4b, 0x1b, 0x3e, 0x98, 0x4a, 0xb4,
+	0xc0, 0xce, 0x24, 0xda, 0x36, 0x9e, 0x15, 0xe7, 0xe2, 0xa6, 0x01, 0xaf, 0xe9, 0xc4, 0x6a, 0xc3,
+	0xfa, 0x07, 0x92, 0xa4, 0xb4, 0x67, 0xaf, 0x38, 0xc0, 0x6b, 0x84, 0xf7, 0xa7, 0x12, 0x95, 0xcc,
+	0x4c, 0xa2, 0x4d, 0xd3, 0x6b, 0xb0, 0x8b, 0xcb, 0x82, 0x95, 0xc2, 0x3a, 0x17, 0x44, 0x8c, 0xb8,
+	0xbd, 0xea, 0x00, 0x6f, 0x2d, 0x3c, 0x51, 0x4d, 0x86, 0xa9, 0x9a, 0x0c, 0x56, 0x6f, 0x7c, 0xba,
+	0xfc, 0x1b, 0x8f, 0x05, 0x19, 0xd0, 0x63, 0xdd, 0x89, 0x4b, 0x45, 0x35, 0x62, 0xd4, 0x27, 0x49,
+	0xce, 0xed, 0x35, 0x67, 0xc5, 0x6b, 0x9a, 0x11, 0x0d, 0x53, 0xb9, 0x19, 0xec, 0xe2, 0xb2, 0x60,
+	0x8d, 0xe1, 0x26, 0x57, 0x5a, 0xbd, 0x8e, 0x60, 0x03, 0x9a, 0x73, 0xbb, 0xee, 0x00, 0xaf, 0x19,
+	0x1e, 0x9f, 0x4b, 0x54, 0xfb, 0x26, 0xd1, 0xa3, 0xe5, 0x47, 0x0a, 0x93, 0xf8, 0x65, 0x2e, 0x94,
+	0xa7, 0x51, 0xaa, 0x3c, 0x0d, 0x76, 0xf1, 0x86, 0x71, 0x3a, 0xd1, 0xd0, 0xfa, 0x04, 0x61, 0x46,
+	0xc6, 0x9d, 0x21, 0x4d, 0xc9, 0x84, 0xdb, 0xeb, 0xda, 0xf6, 0xdd, 0x7f, 0xd8, 0x2e, 0xa8, 0x55,
+	0xdb, 0xac, 0x38, 0x17, 0x37, 0x33, 0x32, 0xc6, 0xfa, 0x6c, 0x7d, 0x01, 0xf0, 0xde, 0x28, 0x57,
+	0xe3, 0x24, 0x79, 0xdc, 0x89, 0x58, 0x56, 0xa4, 0x54, 0x05, 0xb3, 0xa3, 0xd2, 0x6b, 0x37, 0x1c,
+	0xe0, 0xdd, 0x7e, 0xb2, 0xe7, 0x9b, 0x68, 0xfb, 0xf3, 0x68, 0xfb, 0x27, 0xf3, 0x68, 0x87, 0x6d,
+	0x35, 0xe7, 0x54, 0xa2, 0xad, 0x4a, 0x44, 0x75, 0xce, 0x24, 0xba, 0x6b, 0x7c, 0x6f, 0xf2, 0xee,
+	0xe9, 0x77, 0x04, 0xf0, 0xee, 0x35, 0xf9, 0xfc, 0xda, 0x50, 0x49, 0x5a, 0x6f, 0xe0, 0xa6, 0x09,
+	0x4c, 0x67, 0x48, 0x09, 0x67, 0xb9, 0xdd, 0xd4, 0x5f, 0x46, 0x7b, 0x2a, 0xd1, 0xee, 0x8d, 0xc2,
+	0x43, 0x96, 0x25, 0x82, 0x66, 0x85, 0x50, 0x79, 0xdd, 0x59, 0xcc, 0x5c, 0x79, 0xc1, 0xc5, 0x1b,
+	0x06, 0x63, 0x0d, 0x17, 0x94, 0xfb, 0x34, 0x89, 0xfb, 0xc2, 0x86, 0x0e, 0xf0, 0x56, 0x6e, 0x28,
+	0x9b, 0xc2, 0x5f, 0x94, 0xcd, 0x85, 0x6b, 0xe5, 0x17, 0x1a, 0x1e, 0x34, 0x3e, 0x9f, 0xa1, 0xda,
+	0xcf, 0x33, 0x04, 0xdc, 0x2e, 0x5c, 0x3d, 0x62, 0x2c, 0xb5, 0x8e, 0x60, 0xb9, 0x78, 0xfd, 0x93,
+	0x6e, 0x86, 0xcf, 0xfe, 0x75, 0x97, 0xb8, 0xd4, 0x39, 0x68, 0x28, 0xfd, 0x5f, 0x67, 0x08, 0x84,
+	0xaf, 0xce, 0x2f, 0x5b, 0xe0, 0xe2, 0xb2, 0x05, 0x7e, 0x5c, 0xb6, 0xc0, 0xe9, 0x55, 0xab, 0x76,
+	0x71, 0xd5, 0xaa, 0x7d, 0xbd, 0x6a, 0xd5, 0xde, 0x2e, 0xa5, 0x5e, 0xfe, 0xa9, 0x69, 0x93, 0x6e,
+	0x5d, 0xef, 0xb3, 0xfd, 0x67, 0x00, 0x58, 0x24, 0xc7, 0xc0, 0xeb, 0x04, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -174,294 +180,419 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func AppsDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 4590 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x5d, 0x70, 0x1b, 0xd7,
-		0x75, 0xd6, 0x82, 0x00, 0x08, 0x1c, 0x80, 0xe0, 0x72, 0x49, 0x49, 0x10, 0x1d, 0x0b, 0x12, 0xfc,
-		0x23, 0xda, 0x8a, 0x48, 0x57, 0x7f, 0x96, 0xc1, 0xc6, 0x2e, 0x40, 0x42, 0x0c, 0x65, 0xfe, 0xc0,
-		0x0b, 0x32, 0xb6, 0x95, 0xc9, 0xec, 0x2c, 0x17, 0x97, 0xe0, 0x8a, 0x8b, 0xdd, 0xcd, 0xee, 0x42,
-		0x12, 0x35, 0x7d, 0x70, 0xc7, 0x69, 0x9b, 0x8c, 0xa7, 0x8d, 0xd3, 0x76, 0xda, 0xc4, 0x75, 0x5c,
-		0xc5, 0x9d, 0xd6, 0xad, 0xfb, 0x9b, 0xfe, 0x24, 0x4d, 0xfb, 0x92, 0x4e, 0xa7, 0xad, 0x9f, 0x3a,
-		0xf1, 0x5b, 0xa7, 0x0f, 0x6c, 0xc6, 0xf6, 0x4c, 0x53, 0xd5, 0x6d, 0x53, 0x55, 0x99, 0xe9, 0xd4,
-		0x2f, 0x99, 0xfb, 0xb7, 0xd8, 0x05, 0x40, 0x2d, 0xe8, 0x8c, 0xec, 0x17, 0x09, 0x7b, 0xee, 0xf9,
-		0xbe, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0xdd, 0x25, 0xfc, 0xdd, 0x2c, 0x1c, 0x6b, 0x5a,
-		0x56, 0xd3, 0x40, 0x33, 0xb6, 0x63, 0x79, 0xd6, 0x46, 0x7b, 0x73, 0xa6, 0x81, 0x5c, 0xcd, 0xd1,
-		0x6d, 0xcf, 0x72, 0xa6, 0x89, 0x4c, 0x1a, 0xa5, 0x1a, 0xd3, 0x5c, 0xa3, 0xb8, 0x0c, 0x63, 0x17,
-		0x75, 0x03, 0xcd, 0xfb, 0x8a, 0x75, 0xe4, 0x49, 0x17, 0x20, 0xbe, 0xa9, 0x1b, 0x28, 0x2f, 0x1c,
-		0x1b, 0x9a, 0xca, 0x9c, 0x7e, 0x70, 0xba, 0x0b, 0x34, 0x1d, 0x46, 0xd4, 0xb0, 0x58, 0x26, 0x88,
-		0xe2, 0x7b, 0x71, 0x18, 0xef, 0xd3, 0x2a, 0x49, 0x10, 0x37, 0xd5, 0x16, 0x66, 0x14, 0xa6, 0xd2,
-		0x32, 0xf9, 0x2d, 0xe5, 0x61, 0xd8, 0x56, 0xb5, 0x6d, 0xb5, 0x89, 0xf2, 0x31, 0x22, 0xe6, 0x8f,
-		0xd2, 0x51, 0x80, 0x06, 0xb2, 0x91, 0xd9, 0x40, 0xa6, 0xb6, 0x93, 0x1f, 0x3a, 0x36, 0x34, 0x95,
-		0x96, 0x03, 0x12, 0xe9, 0x24, 0x8c, 0xd9, 0xed, 0x0d, 0x43, 0xd7, 0x94, 0x80, 0x1a, 0x1c, 0x1b,
-		0x9a, 0x4a, 0xc8, 0x22, 0x6d, 0x98, 0xef, 0x28, 0x9f, 0x80, 0xd1, 0x6b, 0x48, 0xdd, 0x0e, 0xaa,
-		0x66, 0x88, 0x6a, 0x0e, 0x8b, 0x03, 0x8a, 0x73, 0x90, 0x6d, 0x21, 0xd7, 0x55, 0x9b, 0x48, 0xf1,
-		0x76, 0x6c, 0x94, 0x8f, 0x93, 0xd1, 0x1f, 0xeb, 0x19, 0x7d, 0xf7, 0xc8, 0x33, 0x0c, 0xb5, 0xb6,
-		0x63, 0x23, 0xa9, 0x0c, 0x69, 0x64, 0xb6, 0x5b, 0x94, 0x21, 0xb1, 0x87, 0xff, 0xaa, 0x66, 0xbb,
-		0xd5, 0xcd, 0x92, 0xc2, 0x30, 0x46, 0x31, 0xec, 0x22, 0xe7, 0xaa, 0xae, 0xa1, 0x7c, 0x92, 0x10,
-		0x9c, 0xe8, 0x21, 0xa8, 0xd3, 0xf6, 0x6e, 0x0e, 0x8e, 0x93, 0xe6, 0x20, 0x8d, 0xae, 0x7b, 0xc8,
-		0x74, 0x75, 0xcb, 0xcc, 0x0f, 0x13, 0x92, 0x87, 0xfa, 0xcc, 0x22, 0x32, 0x1a, 0xdd, 0x14, 0x1d,
-		0x9c, 0x74, 0x1e, 0x86, 0x2d, 0xdb, 0xd3, 0x2d, 0xd3, 0xcd, 0xa7, 0x8e, 0x09, 0x53, 0x99, 0xd3,
-		0x9f, 0xe8, 0x1b, 0x08, 0xab, 0x54, 0x47, 0xe6, 0xca, 0xd2, 0x22, 0x88, 0xae, 0xd5, 0x76, 0x34,
-		0xa4, 0x68, 0x56, 0x03, 0x29, 0xba, 0xb9, 0x69, 0xe5, 0xd3, 0x84, 0xa0, 0xd0, 0x3b, 0x10, 0xa2,
-		0x38, 0x67, 0x35, 0xd0, 0xa2, 0xb9, 0x69, 0xc9, 0x39, 0x37, 0xf4, 0x2c, 0x1d, 0x82, 0xa4, 0xbb,
-		0x63, 0x7a, 0xea, 0xf5, 0x7c, 0x96, 0x44, 0x08, 0x7b, 0x2a, 0x7e, 0x27, 0x09, 0xa3, 0x83, 0x84,
-		0xd8, 0x2c, 0x24, 0x36, 0xf1, 0x28, 0xf3, 0xb1, 0xfd, 0xf8, 0x80, 0x62, 0xc2, 0x4e, 0x4c, 0x7e,
-		0x48, 0x27, 0x96, 0x21, 0x63, 0x22, 0xd7, 0x43, 0x0d, 0x1a, 0x11, 0x43, 0x03, 0xc6, 0x14, 0x50,
-		0x50, 0x6f, 0x48, 0xc5, 0x3f, 0x54, 0x48, 0x3d, 0x07, 0xa3, 0xbe, 0x49, 0x8a, 0xa3, 0x9a, 0x4d,
-		0x1e, 0x9b, 0x33, 0x51, 0x96, 0x4c, 0x57, 0x39, 0x4e, 0xc6, 0x30, 0x39, 0x87, 0x42, 0xcf, 0xd2,
-		0x3c, 0x80, 0x65, 0x22, 0x6b, 0x53, 0x69, 0x20, 0xcd, 0xc8, 0xa7, 0xf6, 0xf0, 0xd2, 0x2a, 0x56,
-		0xe9, 0xf1, 0x92, 0x45, 0xa5, 0x9a, 0x21, 0x3d, 0xd1, 0x09, 0xb5, 0xe1, 0x3d, 0x22, 0x65, 0x99,
-		0x2e, 0xb2, 0x9e, 0x68, 0x5b, 0x87, 0x9c, 0x83, 0x70, 0xdc, 0xa3, 0x06, 0x1b, 0x59, 0x9a, 0x18,
-		0x31, 0x1d, 0x39, 0x32, 0x99, 0xc1, 0xe8, 0xc0, 0x46, 0x9c, 0xe0, 0xa3, 0xf4, 0x00, 0xf8, 0x02,
-		0x85, 0x84, 0x15, 0x90, 0x2c, 0x94, 0xe5, 0xc2, 0x15, 0xb5, 0x85, 0x26, 0x6f, 0x40, 0x2e, 0xec,
-		0x1e, 0x69, 0x02, 0x12, 0xae, 0xa7, 0x3a, 0x1e, 0x89, 0xc2, 0x84, 0x4c, 0x1f, 0x24, 0x11, 0x86,
-		0x90, 0xd9, 0x20, 0x59, 0x2e, 0x21, 0xe3, 0x9f, 0xd2, 0xcf, 0x74, 0x06, 0x3c, 0x44, 0x06, 0xfc,
-		0x70, 0xef, 0x8c, 0x86, 0x98, 0xbb, 0xc7, 0x3d, 0xf9, 0x38, 0x8c, 0x84, 0x06, 0x30, 0x68, 0xd7,
-		0xc5, 0x9f, 0x85, 0x83, 0x7d, 0xa9, 0xa5, 0xe7, 0x60, 0xa2, 0x6d, 0xea, 0xa6, 0x87, 0x1c, 0xdb,
-		0x41, 0x38, 0x62, 0x69, 0x57, 0xf9, 0x7f, 0x1b, 0xde, 0x23, 0xe6, 0xd6, 0x83, 0xda, 0x94, 0x45,
-		0x1e, 0x6f, 0xf7, 0x0a, 0x1f, 0x4d, 0xa7, 0x7e, 0x30, 0x2c, 0xbe, 0xf0, 0xc2, 0x0b, 0x2f, 0xc4,
-		0x8a, 0x7f, 0x9b, 0x84, 0x89, 0x7e, 0x6b, 0xa6, 0xef, 0xf2, 0x3d, 0x04, 0x49, 0xb3, 0xdd, 0xda,
-		0x40, 0x0e, 0x71, 0x52, 0x42, 0x66, 0x4f, 0x52, 0x19, 0x12, 0x86, 0xba, 0x81, 0x8c, 0x7c, 0xfc,
-		0x98, 0x30, 0x95, 0x3b, 0x7d, 0x72, 0xa0, 0x55, 0x39, 0xbd, 0x84, 0x21, 0x32, 0x45, 0x4a, 0x4f,
-		0x42, 0x9c, 0xa5, 0x68, 0xcc, 0xf0, 0xe8, 0x60, 0x0c, 0x78, 0x2d, 0xc9, 0x04, 0x27, 0xdd, 0x07,
-		0x69, 0xfc, 0x3f, 0x8d, 0x8d, 0x24, 0xb1, 0x39, 0x85, 0x05, 0x38, 0x2e, 0xa4, 0x49, 0x48, 0x91,
-		0x65, 0xd2, 0x40, 0x7c, 0x6b, 0xf3, 0x9f, 0x71, 0x60, 0x35, 0xd0, 0xa6, 0xda, 0x36, 0x3c, 0xe5,
-		0xaa, 0x6a, 0xb4, 0x11, 0x09, 0xf8, 0xb4, 0x9c, 0x65, 0xc2, 0xcf, 0x60, 0x99, 0x54, 0x80, 0x0c,
-		0x5d, 0x55, 0xba, 0xd9, 0x40, 0xd7, 0x49, 0xf6, 0x4c, 0xc8, 0x74, 0xa1, 0x2d, 0x62, 0x09, 0xee,
-		0xfe, 0x8a, 0x6b, 0x99, 0x3c, 0x34, 0x49, 0x17, 0x58, 0x40, 0xba, 0x7f, 0xbc, 0x3b, 0x71, 0xdf,
-		0xdf, 0x7f, 0x78, 0x3d, 0x6b, 0xe9, 0x04, 0x8c, 0x12, 0x8d, 0x33, 0x6c, 0xea, 0x55, 0x23, 0x3f,
-		0x76, 0x4c, 0x98, 0x4a, 0xc9, 0x39, 0x2a, 0x5e, 0x65, 0xd2, 0xe2, 0xb7, 0x62, 0x10, 0x27, 0x89,
-		0x65, 0x14, 0x32, 0x6b, 0xcf, 0xd7, 0xaa, 0xca, 0xfc, 0xea, 0x7a, 0x65, 0xa9, 0x2a, 0x0a, 0x52,
-		0x0e, 0x80, 0x08, 0x2e, 0x2e, 0xad, 0x96, 0xd7, 0xc4, 0x98, 0xff, 0xbc, 0xb8, 0xb2, 0x76, 0xfe,
-		0xac, 0x38, 0xe4, 0x03, 0xd6, 0xa9, 0x20, 0x1e, 0x54, 0x38, 0x73, 0x5a, 0x4c, 0x48, 0x22, 0x64,
-		0x29, 0xc1, 0xe2, 0x73, 0xd5, 0xf9, 0xf3, 0x67, 0xc5, 0x64, 0x58, 0x72, 0xe6, 0xb4, 0x38, 0x2c,
-		0x8d, 0x40, 0x9a, 0x48, 0x2a, 0xab, 0xab, 0x4b, 0x62, 0xca, 0xe7, 0xac, 0xaf, 0xc9, 0x8b, 0x2b,
-		0x0b, 0x62, 0xda, 0xe7, 0x5c, 0x90, 0x57, 0xd7, 0x6b, 0x22, 0xf8, 0x0c, 0xcb, 0xd5, 0x7a, 0xbd,
-		0xbc, 0x50, 0x15, 0x33, 0xbe, 0x46, 0xe5, 0xf9, 0xb5, 0x6a, 0x5d, 0xcc, 0x86, 0xcc, 0x3a, 0x73,
-		0x5a, 0x1c, 0xf1, 0xbb, 0xa8, 0xae, 0xac, 0x2f, 0x8b, 0x39, 0x69, 0x0c, 0x46, 0x68, 0x17, 0xdc,
-		0x88, 0xd1, 0x2e, 0xd1, 0xf9, 0xb3, 0xa2, 0xd8, 0x31, 0x84, 0xb2, 0x8c, 0x85, 0x04, 0xe7, 0xcf,
-		0x8a, 0x52, 0x71, 0x0e, 0x12, 0x24, 0x0c, 0x25, 0x09, 0x72, 0x4b, 0xe5, 0x4a, 0x75, 0x49, 0x59,
-		0xad, 0xad, 0x2d, 0xae, 0xae, 0x94, 0x97, 0x44, 0xa1, 0x23, 0x93, 0xab, 0xcf, 0xac, 0x2f, 0xca,
-		0xd5, 0x79, 0x31, 0x16, 0x94, 0xd5, 0xaa, 0xe5, 0xb5, 0xea, 0xbc, 0x38, 0x54, 0xd4, 0x60, 0xa2,
-		0x5f, 0x42, 0xed, 0xbb, 0x84, 0x02, 0xb1, 0x10, 0xdb, 0x23, 0x16, 0x08, 0x57, 0x77, 0x2c, 0x14,
-		0xdf, 0x8d, 0xc1, 0x78, 0x9f, 0x4d, 0xa5, 0x6f, 0x27, 0x4f, 0x41, 0x82, 0xc6, 0x32, 0xdd, 0x66,
-		0x1f, 0xe9, 0xbb, 0x3b, 0x91, 0xc8, 0xee, 0xd9, 0x6a, 0x09, 0x2e, 0x58, 0x6a, 0x0c, 0xed, 0x51,
-		0x6a, 0x60, 0x8a, 0x9e, 0x80, 0xfd, 0x5c, 0x4f, 0xf2, 0xa7, 0xfb, 0xe3, 0xf9, 0x41, 0xf6, 0x47,
-		0x22, 0xdb, 0xdf, 0x26, 0x90, 0xe8, 0xb3, 0x09, 0xcc, 0xc2, 0x58, 0x0f, 0xd1, 0xc0, 0xc9, 0xf8,
-		0x45, 0x01, 0xf2, 0x7b, 0x39, 0x27, 0x22, 0x25, 0xc6, 0x42, 0x29, 0x71, 0xb6, 0xdb, 0x83, 0xc7,
-		0xf7, 0x9e, 0x84, 0x9e, 0xb9, 0x7e, 0x43, 0x80, 0x43, 0xfd, 0x4b, 0xca, 0xbe, 0x36, 0x3c, 0x09,
-		0xc9, 0x16, 0xf2, 0xb6, 0x2c, 0x5e, 0x56, 0x3d, 0xdc, 0x67, 0xb3, 0xc6, 0xcd, 0xdd, 0x93, 0xcd,
-		0x50, 0xc1, 0xdd, 0x7e, 0x68, 0xaf, 0xba, 0x90, 0x5a, 0xd3, 0x63, 0xe9, 0x97, 0x62, 0x70, 0xb0,
-		0x2f, 0x79, 0x5f, 0x43, 0xef, 0x07, 0xd0, 0x4d, 0xbb, 0xed, 0xd1, 0xd2, 0x89, 0x66, 0xe2, 0x34,
-		0x91, 0x90, 0xe4, 0x85, 0xb3, 0x6c, 0xdb, 0xf3, 0xdb, 0x87, 0x48, 0x3b, 0x50, 0x11, 0x51, 0xb8,
-		0xd0, 0x31, 0x34, 0x4e, 0x0c, 0x3d, 0xba, 0xc7, 0x48, 0x7b, 0x02, 0xf3, 0x31, 0x10, 0x35, 0x43,
-		0x47, 0xa6, 0xa7, 0xb8, 0x9e, 0x83, 0xd4, 0x96, 0x6e, 0x36, 0xc9, 0x56, 0x93, 0x2a, 0x25, 0x36,
-		0x55, 0xc3, 0x45, 0xf2, 0x28, 0x6d, 0xae, 0xf3, 0x56, 0x8c, 0x20, 0x01, 0xe4, 0x04, 0x10, 0xc9,
-		0x10, 0x82, 0x36, 0xfb, 0x88, 0xe2, 0x57, 0xd2, 0x90, 0x09, 0x14, 0xe0, 0xd2, 0x71, 0xc8, 0x5e,
-		0x51, 0xaf, 0xaa, 0x0a, 0x3f, 0x54, 0x51, 0x4f, 0x64, 0xb0, 0xac, 0xc6, 0x0e, 0x56, 0x8f, 0xc1,
-		0x04, 0x51, 0xb1, 0xda, 0x1e, 0x72, 0x14, 0xcd, 0x50, 0x5d, 0x97, 0x38, 0x2d, 0x45, 0x54, 0x25,
-		0xdc, 0xb6, 0x8a, 0x9b, 0xe6, 0x78, 0x8b, 0x74, 0x0e, 0xc6, 0x09, 0xa2, 0xd5, 0x36, 0x3c, 0xdd,
-		0x36, 0x90, 0x82, 0x8f, 0x79, 0x2e, 0xd9, 0x72, 0x7c, 0xcb, 0xc6, 0xb0, 0xc6, 0x32, 0x53, 0xc0,
-		0x16, 0xb9, 0xd2, 0x3c, 0xdc, 0x4f, 0x60, 0x4d, 0x64, 0x22, 0x47, 0xf5, 0x90, 0x82, 0x3e, 0xdf,
-		0x56, 0x0d, 0x57, 0x51, 0xcd, 0x86, 0xb2, 0xa5, 0xba, 0x5b, 0xf9, 0x09, 0x4c, 0x50, 0x89, 0xe5,
-		0x05, 0xf9, 0x08, 0x56, 0x5c, 0x60, 0x7a, 0x55, 0xa2, 0x56, 0x36, 0x1b, 0x9f, 0x56, 0xdd, 0x2d,
-		0xa9, 0x04, 0x87, 0x08, 0x8b, 0xeb, 0x39, 0xba, 0xd9, 0x54, 0xb4, 0x2d, 0xa4, 0x6d, 0x2b, 0x6d,
-		0x6f, 0xf3, 0x42, 0xfe, 0xbe, 0x60, 0xff, 0xc4, 0xc2, 0x3a, 0xd1, 0x99, 0xc3, 0x2a, 0xeb, 0xde,
-		0xe6, 0x05, 0xa9, 0x0e, 0x59, 0x3c, 0x19, 0x2d, 0xfd, 0x06, 0x52, 0x36, 0x2d, 0x87, 0xec, 0xa1,
-		0xb9, 0x3e, 0xa9, 0x29, 0xe0, 0xc1, 0xe9, 0x55, 0x06, 0x58, 0xb6, 0x1a, 0xa8, 0x94, 0xa8, 0xd7,
-		0xaa, 0xd5, 0x79, 0x39, 0xc3, 0x59, 0x2e, 0x5a, 0x0e, 0x0e, 0xa8, 0xa6, 0xe5, 0x3b, 0x38, 0x43,
-		0x03, 0xaa, 0x69, 0x71, 0xf7, 0x9e, 0x83, 0x71, 0x4d, 0xa3, 0x63, 0xd6, 0x35, 0x85, 0x1d, 0xc6,
-		0xdc, 0xbc, 0x18, 0x72, 0x96, 0xa6, 0x2d, 0x50, 0x05, 0x16, 0xe3, 0xae, 0xf4, 0x04, 0x1c, 0xec,
-		0x38, 0x2b, 0x08, 0x1c, 0xeb, 0x19, 0x65, 0x37, 0xf4, 0x1c, 0x8c, 0xdb, 0x3b, 0xbd, 0x40, 0x29,
-		0xd4, 0xa3, 0xbd, 0xd3, 0x0d, 0x7b, 0x1c, 0x26, 0xec, 0x2d, 0xbb, 0x17, 0xf7, 0x68, 0x10, 0x27,
-		0xd9, 0x5b, 0x76, 0x37, 0xf0, 0x21, 0x72, 0x32, 0x77, 0x90, 0xa6, 0x7a, 0xa8, 0x91, 0x3f, 0x1c,
-		0x54, 0x0f, 0x34, 0x48, 0xd3, 0x20, 0x6a, 0x9a, 0x82, 0x4c, 0x75, 0xc3, 0x40, 0x8a, 0xea, 0x20,
-		0x53, 0x75, 0xf3, 0x05, 0xa2, 0x1c, 0xf7, 0x9c, 0x36, 0x92, 0x73, 0x9a, 0x56, 0x25, 0x8d, 0x65,
-		0xd2, 0x26, 0x3d, 0x0a, 0x63, 0xd6, 0xc6, 0x15, 0x8d, 0x46, 0xa4, 0x62, 0x3b, 0x68, 0x53, 0xbf,
-		0x9e, 0x7f, 0x90, 0xb8, 0x77, 0x14, 0x37, 0x90, 0x78, 0xac, 0x11, 0xb1, 0xf4, 0x08, 0x88, 0x9a,
-		0xbb, 0xa5, 0x3a, 0x36, 0x49, 0xc9, 0xae, 0xad, 0x6a, 0x28, 0xff, 0x10, 0x55, 0xa5, 0xf2, 0x15,
-		0x2e, 0xc6, 0x2b, 0xc2, 0xbd, 0xa6, 0x6f, 0x7a, 0x9c, 0xf1, 0x04, 0x5d, 0x11, 0x44, 0xc6, 0xd8,
-		0xa6, 0x40, 0xc4, 0x9e, 0x08, 0x75, 0x3c, 0x45, 0xd4, 0x72, 0xf6, 0x96, 0x1d, 0xec, 0xf7, 0x01,
-		0x18, 0xc1, 0x9a, 0x9d, 0x4e, 0x1f, 0xa1, 0x85, 0x9b, 0xbd, 0x15, 0xe8, 0xf1, 0x2c, 0x1c, 0xc2,
-		0x4a, 0x2d, 0xe4, 0xa9, 0x0d, 0xd5, 0x53, 0x03, 0xda, 0x9f, 0x24, 0xda, 0xd8, 0xed, 0xcb, 0xac,
-		0x31, 0x64, 0xa7, 0xd3, 0xde, 0xd8, 0xf1, 0x03, 0xeb, 0x14, 0xb5, 0x13, 0xcb, 0x78, 0x68, 0xdd,
-		0xb3, 0xe2, 0xbc, 0x58, 0x82, 0x6c, 0x30, 0xee, 0xa5, 0x34, 0xd0, 0xc8, 0x17, 0x05, 0x5c, 0x04,
-		0xcd, 0xad, 0xce, 0xe3, 0xf2, 0xe5, 0x72, 0x55, 0x8c, 0xe1, 0x32, 0x6a, 0x69, 0x71, 0xad, 0xaa,
-		0xc8, 0xeb, 0x2b, 0x6b, 0x8b, 0xcb, 0x55, 0x71, 0x28, 0x50, 0xd8, 0x5f, 0x8a, 0xa7, 0x1e, 0x16,
-		0x4f, 0x14, 0xdf, 0x8e, 0x41, 0x2e, 0x7c, 0x52, 0x93, 0x7e, 0x1a, 0x0e, 0xf3, 0x6b, 0x15, 0x17,
-		0x79, 0xca, 0x35, 0xdd, 0x21, 0x0b, 0xb2, 0xa5, 0xd2, 0xcd, 0xd1, 0x8f, 0x9f, 0x09, 0xa6, 0x55,
-		0x47, 0xde, 0xb3, 0xba, 0x83, 0x97, 0x5b, 0x4b, 0xf5, 0xa4, 0x25, 0x28, 0x98, 0x96, 0xe2, 0x7a,
-		0xaa, 0xd9, 0x50, 0x9d, 0x86, 0xd2, 0xb9, 0xd0, 0x52, 0x54, 0x4d, 0x43, 0xae, 0x6b, 0xd1, 0x8d,
-		0xd0, 0x67, 0xf9, 0x84, 0x69, 0xd5, 0x99, 0x72, 0x67, 0x87, 0x28, 0x33, 0xd5, 0xae, 0xf0, 0x1d,
-		0xda, 0x2b, 0x7c, 0xef, 0x83, 0x74, 0x4b, 0xb5, 0x15, 0x64, 0x7a, 0xce, 0x0e, 0xa9, 0xcf, 0x53,
-		0x72, 0xaa, 0xa5, 0xda, 0x55, 0xfc, 0xfc, 0x91, 0x1c, 0x93, 0x2e, 0xc5, 0x53, 0x29, 0x31, 0x7d,
-		0x29, 0x9e, 0x4a, 0x8b, 0x50, 0x7c, 0x67, 0x08, 0xb2, 0xc1, 0x7a, 0x1d, 0x1f, 0x7f, 0x34, 0xb2,
-		0x63, 0x09, 0x24, 0xa7, 0x3d, 0x70, 0xd7, 0xea, 0x7e, 0x7a, 0x0e, 0x6f, 0x65, 0xa5, 0x24, 0x2d,
-		0x8e, 0x65, 0x8a, 0xc4, 0x65, 0x04, 0x0e, 0x36, 0x44, 0x8b, 0x91, 0x94, 0xcc, 0x9e, 0xa4, 0x05,
-		0x48, 0x5e, 0x71, 0x09, 0x77, 0x92, 0x70, 0x3f, 0x78, 0x77, 0xee, 0x4b, 0x75, 0x42, 0x9e, 0xbe,
-		0x54, 0x57, 0x56, 0x56, 0xe5, 0xe5, 0xf2, 0x92, 0xcc, 0xe0, 0xd2, 0x11, 0x88, 0x1b, 0xea, 0x8d,
-		0x9d, 0xf0, 0xa6, 0x47, 0x44, 0x83, 0x4e, 0xc2, 0x11, 0x88, 0x5f, 0x43, 0xea, 0x76, 0x78, 0xab,
-		0x21, 0xa2, 0x7b, 0xb8, 0x18, 0x66, 0x20, 0x41, 0xfc, 0x25, 0x01, 0x30, 0x8f, 0x89, 0x07, 0xa4,
-		0x14, 0xc4, 0xe7, 0x56, 0x65, 0xbc, 0x20, 0x44, 0xc8, 0x52, 0xa9, 0x52, 0x5b, 0xac, 0xce, 0x55,
-		0xc5, 0x58, 0xf1, 0x1c, 0x24, 0xa9, 0x13, 0xf0, 0x62, 0xf1, 0xdd, 0x20, 0x1e, 0x60, 0x8f, 0x8c,
-		0x43, 0xe0, 0xad, 0xeb, 0xcb, 0x95, 0xaa, 0x2c, 0xc6, 0xc2, 0x53, 0x1d, 0x17, 0x13, 0x45, 0x17,
-		0xb2, 0xc1, 0x3a, 0xfc, 0xa3, 0x39, 0x8c, 0x7f, 0x57, 0x80, 0x4c, 0xa0, 0xae, 0xc6, 0x05, 0x91,
-		0x6a, 0x18, 0xd6, 0x35, 0x45, 0x35, 0x74, 0xd5, 0x65, 0xa1, 0x01, 0x44, 0x54, 0xc6, 0x92, 0x41,
-		0xa7, 0xee, 0x23, 0x5a, 0x22, 0x09, 0x31, 0x59, 0x7c, 0x4d, 0x00, 0xb1, 0xbb, 0xb0, 0xed, 0x32,
-		0x53, 0xf8, 0x38, 0xcd, 0x2c, 0xbe, 0x2a, 0x40, 0x2e, 0x5c, 0xcd, 0x76, 0x99, 0x77, 0xfc, 0x63,
-		0x35, 0xef, 0xfb, 0x31, 0x18, 0x09, 0xd5, 0xb0, 0x83, 0x5a, 0xf7, 0x79, 0x18, 0xd3, 0x1b, 0xa8,
-		0x65, 0x5b, 0x1e, 0x32, 0xb5, 0x1d, 0xc5, 0x40, 0x57, 0x91, 0x91, 0x2f, 0x92, 0xa4, 0x31, 0x73,
-		0xf7, 0x2a, 0x79, 0x7a, 0xb1, 0x83, 0x5b, 0xc2, 0xb0, 0xd2, 0xf8, 0xe2, 0x7c, 0x75, 0xb9, 0xb6,
-		0xba, 0x56, 0x5d, 0x99, 0x7b, 0x5e, 0x59, 0x5f, 0x79, 0x7a, 0x65, 0xf5, 0xd9, 0x15, 0x59, 0xd4,
-		0xbb, 0xd4, 0xee, 0xe1, 0xb2, 0xaf, 0x81, 0xd8, 0x6d, 0x94, 0x74, 0x18, 0xfa, 0x99, 0x25, 0x1e,
-		0x90, 0xc6, 0x61, 0x74, 0x65, 0x55, 0xa9, 0x2f, 0xce, 0x57, 0x95, 0xea, 0xc5, 0x8b, 0xd5, 0xb9,
-		0xb5, 0x3a, 0xbd, 0xf7, 0xf0, 0xb5, 0xd7, 0x42, 0x0b, 0xbc, 0xf8, 0xca, 0x10, 0x8c, 0xf7, 0xb1,
-		0x44, 0x2a, 0xb3, 0x13, 0x0b, 0x3d, 0x44, 0x9d, 0x1a, 0xc4, 0xfa, 0x69, 0x5c, 0x33, 0xd4, 0x54,
-		0xc7, 0x63, 0x07, 0x9c, 0x47, 0x00, 0x7b, 0xc9, 0xf4, 0xf4, 0x4d, 0x1d, 0x39, 0xec, 0x3e, 0x89,
-		0x1e, 0x63, 0x46, 0x3b, 0x72, 0x7a, 0xa5, 0xf4, 0x49, 0x90, 0x6c, 0xcb, 0xd5, 0x3d, 0xfd, 0x2a,
-		0x52, 0x74, 0x93, 0x5f, 0x3e, 0xe1, 0x63, 0x4d, 0x5c, 0x16, 0x79, 0xcb, 0xa2, 0xe9, 0xf9, 0xda,
-		0x26, 0x6a, 0xaa, 0x5d, 0xda, 0x38, 0x99, 0x0f, 0xc9, 0x22, 0x6f, 0xf1, 0xb5, 0x8f, 0x43, 0xb6,
-		0x61, 0xb5, 0x71, 0xad, 0x47, 0xf5, 0xf0, 0xde, 0x21, 0xc8, 0x19, 0x2a, 0xf3, 0x55, 0x58, 0x15,
-		0xdf, 0xb9, 0xf5, 0xca, 0xca, 0x19, 0x2a, 0xa3, 0x2a, 0x27, 0x60, 0x54, 0x6d, 0x36, 0x1d, 0x4c,
-		0xce, 0x89, 0xe8, 0xb9, 0x24, 0xe7, 0x8b, 0x89, 0xe2, 0xe4, 0x25, 0x48, 0x71, 0x3f, 0xe0, 0xad,
-		0x1a, 0x7b, 0x42, 0xb1, 0xe9, 0x61, 0x3b, 0x36, 0x95, 0x96, 0x53, 0x26, 0x6f, 0x3c, 0x0e, 0x59,
-		0xdd, 0x55, 0x3a, 0x97, 0xf8, 0xb1, 0x63, 0xb1, 0xa9, 0x94, 0x9c, 0xd1, 0x5d, 0xff, 0x02, 0xb4,
-		0xf8, 0x46, 0x0c, 0x72, 0xe1, 0x97, 0x10, 0xd2, 0x3c, 0xa4, 0x0c, 0x4b, 0x53, 0x49, 0x68, 0xd1,
-		0x37, 0x60, 0x53, 0x11, 0xef, 0x2d, 0xa6, 0x97, 0x98, 0xbe, 0xec, 0x23, 0x27, 0xff, 0x49, 0x80,
-		0x14, 0x17, 0x4b, 0x87, 0x20, 0x6e, 0xab, 0xde, 0x16, 0xa1, 0x4b, 0x54, 0x62, 0xa2, 0x20, 0x93,
-		0x67, 0x2c, 0x77, 0x6d, 0xd5, 0x24, 0x21, 0xc0, 0xe4, 0xf8, 0x19, 0xcf, 0xab, 0x81, 0xd4, 0x06,
-		0x39, 0xf4, 0x58, 0xad, 0x16, 0x32, 0x3d, 0x97, 0xcf, 0x2b, 0x93, 0xcf, 0x31, 0xb1, 0x74, 0x12,
-		0xc6, 0x3c, 0x47, 0xd5, 0x8d, 0x90, 0x6e, 0x9c, 0xe8, 0x8a, 0xbc, 0xc1, 0x57, 0x2e, 0xc1, 0x11,
-		0xce, 0xdb, 0x40, 0x9e, 0xaa, 0x6d, 0xa1, 0x46, 0x07, 0x94, 0x24, 0x97, 0x1b, 0x87, 0x99, 0xc2,
-		0x3c, 0x6b, 0xe7, 0xd8, 0xe2, 0xdb, 0x02, 0x8c, 0xf1, 0x63, 0x5a, 0xc3, 0x77, 0xd6, 0x32, 0x80,
-		0x6a, 0x9a, 0x96, 0x17, 0x74, 0x57, 0x6f, 0x28, 0xf7, 0xe0, 0xa6, 0xcb, 0x3e, 0x48, 0x0e, 0x10,
-		0x4c, 0xb6, 0x00, 0x3a, 0x2d, 0x7b, 0xba, 0xad, 0x00, 0x19, 0xf6, 0x86, 0x89, 0xbc, 0xa6, 0xa4,
-		0x07, 0x7b, 0xa0, 0x22, 0x7c, 0x9e, 0x93, 0x26, 0x20, 0xb1, 0x81, 0x9a, 0xba, 0xc9, 0xee, 0x8d,
-		0xe9, 0x03, 0xbf, 0x7e, 0x89, 0xfb, 0xd7, 0x2f, 0x95, 0x2f, 0x0b, 0x30, 0xae, 0x59, 0xad, 0x6e,
-		0x7b, 0x2b, 0x62, 0xd7, 0xed, 0x82, 0xfb, 0x69, 0xe1, 0xf2, 0x93, 0x4d, 0xdd, 0xdb, 0x6a, 0x6f,
-		0x4c, 0x6b, 0x56, 0x6b, 0xa6, 0x69, 0x19, 0xaa, 0xd9, 0xec, 0xbc, 0x67, 0x25, 0x3f, 0xb4, 0x53,
-		0x4d, 0x64, 0x9e, 0x6a, 0x5a, 0x81, 0xb7, 0xae, 0xb3, 0x9d, 0x9f, 0xff, 0x27, 0x08, 0xaf, 0xc7,
-		0x86, 0x16, 0x6a, 0x95, 0x37, 0x63, 0x93, 0x0b, 0xb4, 0xbb, 0x1a, 0x77, 0x8f, 0x8c, 0x36, 0x0d,
-		0xa4, 0xe1, 0x21, 0xc3, 0xad, 0x93, 0x30, 0xd1, 0xb4, 0x9a, 0x16, 0x61, 0x9c, 0xc1, 0xbf, 0xd8,
-		0x9b, 0xdb, 0xb4, 0x2f, 0x9d, 0x8c, 0x7c, 0xcd, 0x5b, 0x5a, 0x81, 0x71, 0xa6, 0xac, 0x90, 0x57,
-		0x47, 0xf4, 0x60, 0x23, 0xdd, 0xf5, 0x56, 0x2d, 0xff, 0xcd, 0xf7, 0xc8, 0x86, 0x2e, 0x8f, 0x31,
-		0x28, 0x6e, 0xa3, 0x67, 0x9f, 0x92, 0x0c, 0x07, 0x43, 0x7c, 0x74, 0xd9, 0x22, 0x27, 0x82, 0xf1,
-		0xef, 0x19, 0xe3, 0x78, 0x80, 0xb1, 0xce, 0xa0, 0xa5, 0x39, 0x18, 0xd9, 0x0f, 0xd7, 0x3f, 0x30,
-		0xae, 0x2c, 0x0a, 0x92, 0x2c, 0xc0, 0x28, 0x21, 0xd1, 0xda, 0xae, 0x67, 0xb5, 0x48, 0x4e, 0xbc,
-		0x3b, 0xcd, 0x3f, 0xbe, 0x47, 0xd7, 0x51, 0x0e, 0xc3, 0xe6, 0x7c, 0x54, 0xa9, 0x04, 0xe4, 0x6d,
-		0x59, 0x03, 0x69, 0x46, 0x04, 0xc3, 0x5b, 0xcc, 0x10, 0x5f, 0xbf, 0xf4, 0x19, 0x98, 0xc0, 0xbf,
-		0x49, 0xca, 0x0a, 0x5a, 0x12, 0x7d, 0x05, 0x97, 0x7f, 0xfb, 0x45, 0xba, 0x54, 0xc7, 0x7d, 0x82,
-		0x80, 0x4d, 0x81, 0x59, 0x6c, 0x22, 0xcf, 0x43, 0x8e, 0xab, 0xa8, 0x46, 0x3f, 0xf3, 0x02, 0x77,
-		0x18, 0xf9, 0xaf, 0xbd, 0x1f, 0x9e, 0xc5, 0x05, 0x8a, 0x2c, 0x1b, 0x46, 0x69, 0x1d, 0x0e, 0xf7,
-		0x89, 0x8a, 0x01, 0x38, 0x5f, 0x61, 0x9c, 0x13, 0x3d, 0x91, 0x81, 0x69, 0x6b, 0xc0, 0xe5, 0xfe,
-		0x5c, 0x0e, 0xc0, 0xf9, 0x9b, 0x8c, 0x53, 0x62, 0x58, 0x3e, 0xa5, 0x98, 0xf1, 0x12, 0x8c, 0x5d,
-		0x45, 0xce, 0x86, 0xe5, 0xb2, 0x7b, 0xa3, 0x01, 0xe8, 0x5e, 0x65, 0x74, 0xa3, 0x0c, 0x48, 0x2e,
-		0x92, 0x30, 0xd7, 0x13, 0x90, 0xda, 0x54, 0x35, 0x34, 0x00, 0xc5, 0xd7, 0x19, 0xc5, 0x30, 0xd6,
-		0xc7, 0xd0, 0x32, 0x64, 0x9b, 0x16, 0xdb, 0xb5, 0xa2, 0xe1, 0xaf, 0x31, 0x78, 0x86, 0x63, 0x18,
-		0x85, 0x6d, 0xd9, 0x6d, 0x03, 0x6f, 0x69, 0xd1, 0x14, 0xbf, 0xc5, 0x29, 0x38, 0x86, 0x51, 0xec,
-		0xc3, 0xad, 0x37, 0x39, 0x85, 0x1b, 0xf0, 0xe7, 0x53, 0x90, 0xb1, 0x4c, 0x63, 0xc7, 0x32, 0x07,
-		0x31, 0xe2, 0x1b, 0x8c, 0x01, 0x18, 0x04, 0x13, 0xcc, 0x42, 0x7a, 0xd0, 0x89, 0xf8, 0x9d, 0xf7,
-		0xf9, 0xf2, 0xe0, 0x33, 0xb0, 0x00, 0xa3, 0x3c, 0x41, 0xe9, 0x96, 0x39, 0x00, 0xc5, 0xef, 0x32,
-		0x8a, 0x5c, 0x00, 0xc6, 0x86, 0xe1, 0x21, 0xd7, 0x6b, 0xa2, 0x41, 0x48, 0xde, 0xe0, 0xc3, 0x60,
-		0x10, 0xe6, 0xca, 0x0d, 0x64, 0x6a, 0x5b, 0x83, 0x31, 0xfc, 0x1e, 0x77, 0x25, 0xc7, 0x60, 0x8a,
-		0x39, 0x18, 0x69, 0xa9, 0x8e, 0xbb, 0xa5, 0x1a, 0x03, 0x4d, 0xc7, 0xef, 0x33, 0x8e, 0xac, 0x0f,
-		0x62, 0x1e, 0x69, 0x9b, 0xfb, 0xa1, 0x79, 0x93, 0x7b, 0x24, 0x00, 0x63, 0x4b, 0xcf, 0xf5, 0xc8,
-		0x25, 0xdb, 0x7e, 0xd8, 0xfe, 0x80, 0x2f, 0x3d, 0x8a, 0x5d, 0x0e, 0x32, 0xce, 0x42, 0xda, 0xd5,
-		0x6f, 0x0c, 0x44, 0xf3, 0x87, 0x7c, 0xa6, 0x09, 0x00, 0x83, 0x9f, 0x87, 0x23, 0x7d, 0xb7, 0x89,
-		0x01, 0xc8, 0xfe, 0x88, 0x91, 0x1d, 0xea, 0xb3, 0x55, 0xb0, 0x94, 0xb0, 0x5f, 0xca, 0x3f, 0xe6,
-		0x29, 0x01, 0x75, 0x71, 0xd5, 0xf0, 0x39, 0xc2, 0x55, 0x37, 0xf7, 0xe7, 0xb5, 0x3f, 0xe1, 0x5e,
-		0xa3, 0xd8, 0x90, 0xd7, 0xd6, 0xe0, 0x10, 0x63, 0xdc, 0xdf, 0xbc, 0xfe, 0x29, 0x4f, 0xac, 0x14,
-		0xbd, 0x1e, 0x9e, 0xdd, 0xcf, 0xc2, 0xa4, 0xef, 0x4e, 0x5e, 0xb0, 0xba, 0x4a, 0x4b, 0xb5, 0x07,
-		0x60, 0xfe, 0x26, 0x63, 0xe6, 0x19, 0xdf, 0xaf, 0x78, 0xdd, 0x65, 0xd5, 0xc6, 0xe4, 0xcf, 0x41,
-		0x9e, 0x93, 0xb7, 0x4d, 0x07, 0x69, 0x56, 0xd3, 0xd4, 0x6f, 0xa0, 0xc6, 0x00, 0xd4, 0x7f, 0xd6,
-		0x35, 0x55, 0xeb, 0x01, 0x38, 0x66, 0x5e, 0x04, 0xd1, 0xaf, 0x55, 0x14, 0xbd, 0x65, 0x5b, 0x8e,
-		0x17, 0xc1, 0xf8, 0xe7, 0x7c, 0xa6, 0x7c, 0xdc, 0x22, 0x81, 0x95, 0xaa, 0x40, 0xdf, 0x3c, 0x0f,
-		0x1a, 0x92, 0x7f, 0xc1, 0x88, 0x46, 0x3a, 0x28, 0x96, 0x38, 0x34, 0xab, 0x65, 0xab, 0xce, 0x20,
-		0xf9, 0xef, 0x2f, 0x79, 0xe2, 0x60, 0x10, 0x96, 0x38, 0xbc, 0x1d, 0x1b, 0xe1, 0xdd, 0x7e, 0x00,
-		0x86, 0x6f, 0xf1, 0xc4, 0xc1, 0x31, 0x8c, 0x82, 0x17, 0x0c, 0x03, 0x50, 0x7c, 0x9b, 0x53, 0x70,
-		0x0c, 0xa6, 0x78, 0xa6, 0xb3, 0xd1, 0x3a, 0xa8, 0xa9, 0xbb, 0x9e, 0x43, 0xcb, 0xe4, 0xbb, 0x53,
-		0xfd, 0xd5, 0xfb, 0xe1, 0x22, 0x4c, 0x0e, 0x40, 0x71, 0x26, 0x62, 0xd7, 0xae, 0xe4, 0x14, 0x15,
-		0x6d, 0xd8, 0x77, 0x78, 0x26, 0x0a, 0xc0, 0xb0, 0x6d, 0x81, 0x0a, 0x11, 0xbb, 0x5d, 0xc3, 0x67,
-		0x87, 0x01, 0xe8, 0xfe, 0xba, 0xcb, 0xb8, 0x3a, 0xc7, 0x62, 0xce, 0x40, 0xfd, 0xd3, 0x36, 0xb7,
-		0xd1, 0xce, 0x40, 0xd1, 0xf9, 0x37, 0x5d, 0xf5, 0xcf, 0x3a, 0x45, 0xd2, 0x1c, 0x32, 0xda, 0x55,
-		0x4f, 0x49, 0x51, 0xdf, 0x19, 0xe5, 0x7f, 0xee, 0x0e, 0x1b, 0x6f, 0xb8, 0x9c, 0x2a, 0x2d, 0xe1,
-		0x20, 0x0f, 0x17, 0x3d, 0xd1, 0x64, 0x2f, 0xde, 0xf1, 0xe3, 0x3c, 0x54, 0xf3, 0x94, 0x2e, 0xc2,
-		0x48, 0xa8, 0xe0, 0x89, 0xa6, 0xfa, 0x02, 0xa3, 0xca, 0x06, 0xeb, 0x9d, 0xd2, 0x39, 0x88, 0xe3,
-		0xe2, 0x25, 0x1a, 0xfe, 0xf3, 0x0c, 0x4e, 0xd4, 0x4b, 0x9f, 0x82, 0x14, 0x2f, 0x5a, 0xa2, 0xa1,
-		0xbf, 0xc0, 0xa0, 0x3e, 0x04, 0xc3, 0x79, 0xc1, 0x12, 0x0d, 0xff, 0x45, 0x0e, 0xe7, 0x10, 0x0c,
-		0x1f, 0xdc, 0x85, 0xdf, 0x7d, 0x29, 0xce, 0x36, 0x1d, 0xee, 0xbb, 0x59, 0x18, 0x66, 0x95, 0x4a,
-		0x34, 0xfa, 0x4b, 0xac, 0x73, 0x8e, 0x28, 0x3d, 0x0e, 0x89, 0x01, 0x1d, 0xfe, 0x4b, 0x0c, 0x4a,
-		0xf5, 0x4b, 0x73, 0x90, 0x09, 0x54, 0x27, 0xd1, 0xf0, 0x5f, 0x66, 0xf0, 0x20, 0x0a, 0x9b, 0xce,
-		0xaa, 0x93, 0x68, 0x82, 0x2f, 0x73, 0xd3, 0x19, 0x02, 0xbb, 0x8d, 0x17, 0x26, 0xd1, 0xe8, 0x97,
-		0xb9, 0xd7, 0x39, 0xa4, 0xf4, 0x14, 0xa4, 0xfd, 0xcd, 0x26, 0x1a, 0xff, 0x15, 0x86, 0xef, 0x60,
-		0xb0, 0x07, 0x02, 0x9b, 0x5d, 0x34, 0xc5, 0xaf, 0x70, 0x0f, 0x04, 0x50, 0x78, 0x19, 0x75, 0x17,
-		0x30, 0xd1, 0x4c, 0xbf, 0xca, 0x97, 0x51, 0x57, 0xfd, 0x82, 0x67, 0x93, 0xe4, 0xfc, 0x68, 0x8a,
-		0x5f, 0xe3, 0xb3, 0x49, 0xf4, 0xb1, 0x19, 0xdd, 0x15, 0x41, 0x34, 0xc7, 0x6f, 0x70, 0x33, 0xba,
-		0x0a, 0x82, 0x52, 0x0d, 0xa4, 0xde, 0x6a, 0x20, 0x9a, 0xef, 0xab, 0x8c, 0x6f, 0xac, 0xa7, 0x18,
-		0x28, 0x3d, 0x0b, 0x87, 0xfa, 0x57, 0x02, 0xd1, 0xac, 0x5f, 0xbb, 0xd3, 0x75, 0x76, 0x0b, 0x16,
-		0x02, 0xa5, 0xb5, 0xce, 0x96, 0x12, 0xac, 0x02, 0xa2, 0x69, 0x5f, 0xb9, 0x13, 0x4e, 0xdc, 0xc1,
-		0x22, 0xa0, 0x54, 0x06, 0xe8, 0x6c, 0xc0, 0xd1, 0x5c, 0xaf, 0x32, 0xae, 0x00, 0x08, 0x2f, 0x0d,
-		0xb6, 0xff, 0x46, 0xe3, 0xbf, 0xce, 0x97, 0x06, 0x43, 0xe0, 0xa5, 0xc1, 0xb7, 0xde, 0x68, 0xf4,
-		0x6b, 0x7c, 0x69, 0x70, 0x08, 0x8e, 0xec, 0xc0, 0xee, 0x16, 0xcd, 0xf0, 0x0d, 0x1e, 0xd9, 0x01,
-		0x54, 0x69, 0x05, 0xc6, 0x7a, 0x36, 0xc4, 0x68, 0xaa, 0xd7, 0x19, 0x95, 0xd8, 0xbd, 0x1f, 0x06,
-		0x37, 0x2f, 0xb6, 0x19, 0x46, 0xb3, 0xfd, 0x76, 0xd7, 0xe6, 0xc5, 0xf6, 0xc2, 0xd2, 0x2c, 0xa4,
-		0xcc, 0xb6, 0x61, 0xe0, 0xc5, 0x23, 0xdd, 0xfd, 0xdb, 0xc0, 0xfc, 0xbf, 0x7f, 0xc0, 0xbc, 0xc3,
-		0x01, 0xa5, 0x73, 0x90, 0x40, 0xad, 0x0d, 0xd4, 0x88, 0x42, 0xde, 0xfa, 0x80, 0x27, 0x4c, 0xac,
-		0x5d, 0x7a, 0x0a, 0x80, 0x5e, 0x8d, 0x90, 0xd7, 0x83, 0x11, 0xd8, 0xff, 0xf8, 0x80, 0x7d, 0x8c,
-		0xd3, 0x81, 0x74, 0x08, 0xe8, 0xa7, 0x3d, 0x77, 0x27, 0x78, 0x3f, 0x4c, 0x40, 0x66, 0xe4, 0x09,
-		0x18, 0xbe, 0xe2, 0x5a, 0xa6, 0xa7, 0x36, 0xa3, 0xd0, 0xff, 0xc9, 0xd0, 0x5c, 0x1f, 0x3b, 0xac,
-		0x65, 0x39, 0xc8, 0x53, 0x9b, 0x6e, 0x14, 0xf6, 0xbf, 0x18, 0xd6, 0x07, 0x60, 0xb0, 0xa6, 0xba,
-		0xde, 0x20, 0xe3, 0xfe, 0x6f, 0x0e, 0xe6, 0x00, 0x6c, 0x34, 0xfe, 0xbd, 0x8d, 0x76, 0xa2, 0xb0,
-		0x3f, 0xe4, 0x46, 0x33, 0xfd, 0xd2, 0xa7, 0x20, 0x8d, 0x7f, 0xd2, 0x2f, 0xec, 0x22, 0xc0, 0xff,
-		0xc3, 0xc0, 0x1d, 0x04, 0xee, 0xd9, 0xf5, 0x1a, 0x9e, 0x1e, 0xed, 0xec, 0xdb, 0x6c, 0xa6, 0xb9,
-		0x7e, 0xa9, 0x0c, 0x19, 0xd7, 0x6b, 0x34, 0xda, 0xac, 0x3e, 0x8d, 0x80, 0xff, 0xef, 0x07, 0xfe,
-		0x95, 0x85, 0x8f, 0xc1, 0xb3, 0x7d, 0x6d, 0xdb, 0xb3, 0x2d, 0xf2, 0x0a, 0x24, 0x8a, 0xe1, 0x0e,
-		0x63, 0x08, 0x40, 0x4a, 0x73, 0x90, 0xc5, 0x63, 0x71, 0x90, 0x8d, 0xc8, 0xfb, 0xaa, 0x08, 0x8a,
-		0x1f, 0x31, 0x07, 0x84, 0x40, 0x95, 0xcf, 0xbd, 0xf5, 0xce, 0x51, 0xe1, 0x7b, 0xef, 0x1c, 0x15,
-		0xbe, 0xff, 0xce, 0x51, 0xe1, 0xe5, 0x77, 0x8f, 0x1e, 0xf8, 0xde, 0xbb, 0x47, 0x0f, 0xfc, 0xf3,
-		0xbb, 0x47, 0x0f, 0xf4, 0xbf, 0x36, 0x86, 0x05, 0x6b, 0xc1, 0xa2, 0x17, 0xc6, 0x97, 0x8b, 0xa1,
-		0xeb, 0xe2, 0xa6, 0xd5, 0xb9, 0xad, 0xf5, 0x0f, 0x39, 0xf0, 0x72, 0x0c, 0x0a, 0xdd, 0x77, 0xb9,
-		0xd8, 0x81, 0xae, 0xa7, 0xb6, 0xec, 0xbd, 0xfe, 0x62, 0x67, 0x16, 0xd2, 0x6b, 0x5c, 0x47, 0xca,
-		0xc3, 0xb0, 0x8b, 0x34, 0xcb, 0x6c, 0xb8, 0xe4, 0x35, 0xe7, 0x90, 0xcc, 0x1f, 0xa5, 0x09, 0x48,
-		0x98, 0xaa, 0x69, 0xb9, 0xec, 0x3b, 0x41, 0xfa, 0x50, 0xf9, 0x75, 0x61, 0x7f, 0x23, 0xca, 0xf9,
-		0x5d, 0x91, 0x61, 0xd5, 0x84, 0xcb, 0x27, 0xef, 0x76, 0x0d, 0x8e, 0x43, 0xd6, 0xed, 0x0c, 0x21,
-		0x70, 0xe7, 0x7d, 0xb4, 0xfb, 0xce, 0xfb, 0x59, 0x64, 0x18, 0x4f, 0x9b, 0xd6, 0x35, 0x73, 0x0d,
-		0x63, 0x36, 0x92, 0xf4, 0x0b, 0x63, 0xf8, 0x76, 0x0a, 0xc6, 0xae, 0xcf, 0xa8, 0xb6, 0xed, 0x92,
-		0x7f, 0x98, 0x13, 0x92, 0xd7, 0xa7, 0xf1, 0xd3, 0x64, 0xdf, 0xab, 0xf1, 0xc9, 0x28, 0x1f, 0x16,
-		0xdf, 0x4c, 0x82, 0x48, 0x3a, 0x2e, 0xdb, 0xb6, 0xa1, 0xb3, 0x77, 0x32, 0x06, 0x0c, 0xab, 0x8d,
-		0x86, 0x83, 0x5c, 0xea, 0xba, 0x6c, 0x45, 0xbe, 0xb5, 0x5b, 0xe0, 0xa2, 0xdb, 0xbb, 0x85, 0xdc,
-		0x8e, 0xda, 0x32, 0x4a, 0x45, 0x26, 0x28, 0xfe, 0xff, 0x6e, 0xe1, 0xa7, 0x02, 0x43, 0xb7, 0xad,
-		0x6d, 0xef, 0x94, 0x89, 0xbc, 0x6b, 0x96, 0xb3, 0x3d, 0x63, 0x5b, 0xda, 0x36, 0xf2, 0x4e, 0x69,
-		0x96, 0x83, 0x66, 0x88, 0x0b, 0xa6, 0xcb, 0x14, 0x25, 0x73, 0x3e, 0xa9, 0x02, 0xc0, 0xfe, 0x64,
-		0x69, 0x1b, 0xed, 0x90, 0x39, 0xc9, 0x56, 0x1e, 0xb8, 0xb5, 0x5b, 0x08, 0x48, 0x6f, 0xef, 0x16,
-		0xc6, 0x68, 0x9f, 0x1d, 0x59, 0x51, 0x4e, 0xd3, 0x87, 0xa7, 0xd1, 0x8e, 0x74, 0x06, 0x92, 0x57,
-		0x54, 0xdd, 0xe0, 0x6f, 0xde, 0x2b, 0xf7, 0xdd, 0xda, 0x2d, 0x30, 0xc9, 0xed, 0xdd, 0xc2, 0x08,
-		0xc5, 0xd2, 0xe7, 0xa2, 0xcc, 0x1a, 0x24, 0x03, 0x92, 0xae, 0xa7, 0x7a, 0x6d, 0xfa, 0x52, 0x28,
-		0x51, 0x59, 0xc3, 0x20, 0x2a, 0xe9, 0x80, 0xe8, 0x33, 0x1e, 0xe3, 0xb9, 0xc1, 0xc7, 0x58, 0xf7,
-		0xd4, 0x6d, 0x54, 0x27, 0x48, 0x99, 0x31, 0x62, 0x13, 0xb5, 0x2d, 0x55, 0x37, 0x5d, 0xfa, 0xa9,
-		0x2c, 0x35, 0x91, 0x4a, 0x3a, 0xbd, 0xd1, 0xe7, 0xa2, 0xcc, 0x1a, 0xa4, 0xeb, 0x30, 0xe2, 0x62,
-		0xae, 0x86, 0xe2, 0x59, 0xdb, 0xc8, 0x74, 0xe9, 0xf7, 0xf4, 0x95, 0xfa, 0x5b, 0xbb, 0x85, 0x03,
-		0xff, 0xb2, 0x5b, 0x78, 0x6c, 0x70, 0x93, 0x2a, 0x7a, 0x73, 0xd1, 0xf4, 0x70, 0x9f, 0x94, 0xa9,
-		0xd3, 0x27, 0x7d, 0x2e, 0xca, 0x59, 0xda, 0xd3, 0x1a, 0x79, 0x94, 0x6e, 0x00, 0xb4, 0xd4, 0xeb,
-		0x8a, 0x83, 0x0c, 0x75, 0x87, 0xfe, 0xe9, 0x49, 0xba, 0xf2, 0xd9, 0x9f, 0xa0, 0xdb, 0x00, 0x5b,
-		0x67, 0x36, 0x3b, 0xb2, 0x22, 0xae, 0x9f, 0xaf, 0xcb, 0xe4, 0xb7, 0xf4, 0x92, 0x00, 0x47, 0xda,
-		0x26, 0x36, 0x87, 0xbd, 0xba, 0xb3, 0x0d, 0x44, 0x2e, 0x48, 0x71, 0xf4, 0xb2, 0x0f, 0xf7, 0x27,
-		0x7b, 0x92, 0x96, 0xbf, 0x1e, 0x2b, 0x67, 0xb0, 0x9d, 0xb7, 0x76, 0x0b, 0xb9, 0x0e, 0x09, 0x46,
-		0xde, 0xde, 0x2d, 0x1c, 0xa4, 0xfd, 0x86, 0xe5, 0xc5, 0x97, 0xff, 0xb5, 0x20, 0xc8, 0x87, 0x7d,
-		0xe1, 0x9c, 0xdf, 0x21, 0xa6, 0x2c, 0xa5, 0xbe, 0x78, 0xb3, 0x70, 0xe0, 0x07, 0x37, 0x0b, 0x42,
-		0x71, 0x03, 0xe2, 0x35, 0xcb, 0x32, 0xa4, 0x1a, 0x30, 0x27, 0xd2, 0x0f, 0x49, 0x2b, 0x17, 0x3e,
-		0xac, 0x5f, 0x64, 0xc6, 0x53, 0x4a, 0x61, 0xfe, 0x1f, 0xde, 0x2c, 0x08, 0x95, 0x4b, 0x7b, 0x65,
-		0xa1, 0xcb, 0x03, 0xb1, 0xb3, 0x04, 0xe1, 0x85, 0x33, 0x47, 0x02, 0x44, 0xd6, 0xd0, 0x72, 0x9b,
-		0x83, 0x24, 0x8e, 0xe2, 0x8f, 0x04, 0x18, 0x59, 0x76, 0x9b, 0x35, 0x7a, 0x16, 0x57, 0xb7, 0x91,
-		0x74, 0x16, 0x86, 0xed, 0xf6, 0x06, 0x59, 0xa3, 0x34, 0x29, 0x90, 0x00, 0xb6, 0xdb, 0x1b, 0x74,
-		0x7d, 0x8e, 0xf8, 0xeb, 0x93, 0xac, 0x4d, 0xdc, 0xc0, 0x16, 0x26, 0x8b, 0xfa, 0xd8, 0xe0, 0x51,
-		0xdf, 0xe4, 0x1f, 0xcd, 0x93, 0x8d, 0xa7, 0xf2, 0xcc, 0x4f, 0x10, 0x76, 0x94, 0xe8, 0xf6, 0x6e,
-		0x21, 0x4b, 0xbb, 0x22, 0x8f, 0x45, 0xf6, 0x71, 0x7d, 0x49, 0xc4, 0x53, 0xfb, 0xd5, 0x9b, 0x05,
-		0x01, 0xbb, 0xff, 0x8b, 0xaf, 0x17, 0x84, 0xe2, 0x9b, 0x02, 0x8c, 0x2e, 0xbb, 0xcd, 0x0a, 0x6a,
-		0xea, 0xe6, 0x3a, 0x09, 0x08, 0x24, 0x7d, 0x41, 0x80, 0xe1, 0x72, 0x28, 0x1f, 0x5e, 0xb9, 0xb5,
-		0x5b, 0x18, 0x57, 0x3b, 0x19, 0x53, 0xe9, 0xe4, 0xc6, 0x49, 0x96, 0x1b, 0x7b, 0x1b, 0x3f, 0x6c,
-		0x9e, 0x64, 0x3f, 0xfa, 0x18, 0xfb, 0x92, 0x00, 0xe9, 0x65, 0xb7, 0xb9, 0x6e, 0xe2, 0x84, 0x86,
-		0xb3, 0x76, 0xd9, 0xb6, 0xb1, 0xf6, 0xbd, 0xcc, 0xda, 0xac, 0x8b, 0x5e, 0x6b, 0xee, 0x45, 0xe4,
-		0xfe, 0x38, 0x00, 0x00, 0xff, 0xff, 0x18, 0x05, 0x79, 0x57, 0xba, 0x3b, 0x00, 0x00,
+		// 6591 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7c, 0x6f, 0x90, 0x23, 0x57,
+		0xb5, 0xdf, 0xb6, 0xfe, 0xeb, 0xcc, 0x8c, 0xa6, 0xe7, 0xce, 0xec, 0xae, 0x3c, 0x6b, 0xef, 0xc8,
+		0x32, 0xb6, 0x77, 0xd7, 0xf6, 0xac, 0x99, 0x5d, 0xaf, 0x77, 0x65, 0xc0, 0x91, 0x66, 0x7a, 0xc7,
+		0x1a, 0x6b, 0xa4, 0xa1, 0xa5, 0x59, 0xef, 0x9a, 0x50, 0x4d, 0x8f, 0x74, 0x47, 0xd3, 0x5e, 0xa9,
+		0x5b, 0x74, 0xb7, 0x76, 0x77, 0xfc, 0x81, 0x32, 0xc1, 0x09, 0xa6, 0x1c, 0x82, 0x49, 0xa8, 0x62,
+		0x21, 0x18, 0x6c, 0xa7, 0x42, 0x12, 0x92, 0x90, 0x40, 0x80, 0x84, 0x90, 0x54, 0x85, 0x0f, 0x24,
+		0x54, 0x3e, 0x50, 0x50, 0x95, 0x0f, 0x49, 0xaa, 0xb2, 0xa1, 0x80, 0xc2, 0xf6, 0xe2, 0x04, 0x9e,
+		0x9f, 0x5f, 0x15, 0x0f, 0x53, 0xe5, 0x57, 0xf7, 0x5f, 0xab, 0x5b, 0xd2, 0x8c, 0xb4, 0x7e, 0xc5,
+		0x2b, 0x7f, 0xb1, 0xa7, 0xcf, 0x3d, 0xbf, 0xdf, 0x3d, 0x7d, 0xfa, 0xdc, 0x73, 0xcf, 0xfd, 0xa3,
+		0x85, 0x4f, 0x3f, 0x05, 0x99, 0xa6, 0x65, 0x35, 0x5b, 0xf8, 0x64, 0xc7, 0xb6, 0x5c, 0x6b, 0xab,
+		0xbb, 0x7d, 0xb2, 0x81, 0x9d, 0xba, 0x6d, 0x74, 0x5c, 0xcb, 0x5e, 0xa4, 0x32, 0x34, 0xcd, 0x34,
+		0x16, 0x85, 0x46, 0xf6, 0x23, 0x30, 0x73, 0xde, 0x68, 0xe1, 0x15, 0x4f, 0xb1, 0x8a, 0x5d, 0x74,
+		0x16, 0x22, 0xdb, 0x46, 0x0b, 0xa7, 0xa5, 0x4c, 0xf8, 0xd8, 0xc4, 0xd2, 0xfb, 0x16, 0xfb, 0x40,
+		0x8b, 0x41, 0xc4, 0x06, 0x11, 0xab, 0x14, 0x71, 0x62, 0x32, 0xf1, 0xcc, 0x1b, 0xff, 0xfd, 0x1d,
+		0x49, 0xfe, 0x24, 0xf9, 0x6f, 0xf6, 0x47, 0x51, 0x98, 0x1d, 0xa2, 0x8b, 0x10, 0x44, 0x4c, 0xbd,
+		0x4d, 0xf8, 0xa5, 0x63, 0x49, 0x95, 0xfe, 0x8d, 0xd2, 0x10, 0xef, 0xe8, 0xf5, 0xcb, 0x7a, 0x13,
+		0xa7, 0x43, 0x54, 0x2c, 0x1e, 0xd1, 0x51, 0x80, 0x06, 0xee, 0x60, 0xb3, 0x81, 0xcd, 0xfa, 0x6e,
+		0x3a, 0x9c, 0x09, 0x1f, 0x4b, 0xaa, 0x3e, 0x09, 0xba, 0x0f, 0x66, 0x3a, 0xdd, 0xad, 0x96, 0x51,
+		0xd7, 0x7c, 0x6a, 0x90, 0x09, 0x1f, 0x8b, 0xaa, 0x32, 0x6b, 0x58, 0xe9, 0x29, 0xdf, 0x0b, 0xd3,
+		0x57, 0xb1, 0x7e, 0xd9, 0xaf, 0x3a, 0x41, 0x55, 0x53, 0x44, 0xbc, 0x12, 0x60, 0xb5, 0x3a, 0xae,
+		0x61, 0x99, 0x7e, 0xd5, 0x69, 0xda, 0xb9, 0xcc, 0x1a, 0x7c, 0xca, 0xcb, 0x30, 0xd9, 0xc6, 0x8e,
+		0xa3, 0x37, 0xb1, 0xe6, 0xee, 0x76, 0x70, 0x3a, 0x42, 0x1d, 0x97, 0x19, 0x70, 0x5c, 0xbf, 0xd3,
+		0x26, 0x38, 0xaa, 0xb6, 0xdb, 0xc1, 0x28, 0x0f, 0x49, 0x6c, 0x76, 0xdb, 0x8c, 0x21, 0xba, 0x87,
+		0xeb, 0x15, 0xb3, 0xdb, 0xee, 0x67, 0x49, 0x10, 0x18, 0xa7, 0x88, 0x3b, 0xd8, 0xbe, 0x62, 0xd4,
+		0x71, 0x3a, 0x46, 0x09, 0xee, 0x1d, 0x20, 0xa8, 0xb2, 0xf6, 0x7e, 0x0e, 0x81, 0x43, 0xcb, 0x90,
+		0xc4, 0xd7, 0x5c, 0x6c, 0x3a, 0x86, 0x65, 0xa6, 0xe3, 0x94, 0xe4, 0xee, 0x21, 0x01, 0x80, 0x5b,
+		0x8d, 0x7e, 0x8a, 0x1e, 0x0e, 0x9d, 0x81, 0x38, 0xf3, 0x91, 0x93, 0x4e, 0x64, 0xa4, 0x63, 0x13,
+		0x4b, 0xb7, 0x0f, 0x8d, 0xa1, 0x0a, 0xd3, 0x51, 0x85, 0x32, 0x2a, 0x82, 0xec, 0x58, 0x5d, 0xbb,
+		0x8e, 0xb5, 0xba, 0xd5, 0xc0, 0x9a, 0x61, 0x6e, 0x5b, 0xe9, 0x24, 0x25, 0x58, 0x18, 0x7c, 0x11,
+		0xaa, 0xb8, 0x6c, 0x35, 0x70, 0xd1, 0xdc, 0xb6, 0xd4, 0x94, 0x13, 0x78, 0x46, 0x87, 0x20, 0xe6,
+		0xec, 0x9a, 0xae, 0x7e, 0x2d, 0x3d, 0x49, 0xc3, 0x89, 0x3f, 0xa1, 0x25, 0x88, 0xe3, 0x86, 0x41,
+		0xba, 0x4b, 0xa7, 0x32, 0xd2, 0xb1, 0xd4, 0x52, 0x7a, 0xd0, 0xc7, 0xac, 0x5d, 0x15, 0x8a, 0xd9,
+		0x3f, 0xc6, 0x60, 0x7a, 0x9c, 0x18, 0x7e, 0x04, 0xa2, 0xdb, 0xc4, 0x33, 0xe9, 0xd0, 0xad, 0xf8,
+		0x8d, 0x61, 0x82, 0x8e, 0x8f, 0xbd, 0x4b, 0xc7, 0xe7, 0x61, 0xc2, 0xc4, 0x8e, 0x8b, 0x1b, 0x2c,
+		0x8a, 0xc2, 0x63, 0xc6, 0x21, 0x30, 0xd0, 0x60, 0x18, 0x46, 0xde, 0x55, 0x18, 0x5e, 0x84, 0x69,
+		0xcf, 0x24, 0xcd, 0xd6, 0xcd, 0xa6, 0x88, 0xe7, 0x93, 0xa3, 0x2c, 0x59, 0x54, 0x04, 0x4e, 0x25,
+		0x30, 0x35, 0x85, 0x03, 0xcf, 0x68, 0x05, 0xc0, 0x32, 0xb1, 0xb5, 0xad, 0x35, 0x70, 0xbd, 0x95,
+		0x4e, 0xec, 0xe1, 0xa5, 0x0a, 0x51, 0x19, 0xf0, 0x92, 0xc5, 0xa4, 0xf5, 0x16, 0x3a, 0xd7, 0x0b,
+		0xcf, 0xf8, 0x1e, 0xd1, 0xb5, 0xce, 0x06, 0xe6, 0x40, 0x84, 0x6e, 0x42, 0xca, 0xc6, 0x64, 0xac,
+		0xe0, 0x06, 0x7f, 0xb3, 0x24, 0x35, 0x62, 0x71, 0xe4, 0x9b, 0xa9, 0x1c, 0xc6, 0x5e, 0x6c, 0xca,
+		0xf6, 0x3f, 0xa2, 0xbb, 0xc0, 0x13, 0x68, 0x34, 0xac, 0x80, 0x66, 0x9a, 0x49, 0x21, 0x2c, 0x93,
+		0xf0, 0xca, 0x03, 0x5c, 0x31, 0x1c, 0x63, 0xcb, 0x68, 0x19, 0x2e, 0x49, 0x5b, 0x24, 0x7a, 0xef,
+		0x1c, 0x1c, 0x17, 0xbb, 0xed, 0x2d, 0xab, 0x75, 0xc1, 0x53, 0x54, 0x7d, 0xa0, 0xf9, 0xa7, 0x21,
+		0x15, 0xf4, 0x30, 0x9a, 0x83, 0xa8, 0xe3, 0xea, 0xb6, 0x4b, 0x03, 0x39, 0xaa, 0xb2, 0x07, 0x24,
+		0x43, 0x18, 0x9b, 0x0d, 0x9a, 0x89, 0xa3, 0x2a, 0xf9, 0x13, 0xfd, 0xad, 0x9e, 0xcf, 0xc2, 0xd4,
+		0x67, 0xf7, 0x0c, 0x06, 0x45, 0x80, 0xb9, 0xdf, 0x75, 0xf3, 0x0f, 0xc3, 0x54, 0xc0, 0x07, 0xe3,
+		0x76, 0x9d, 0xfd, 0x1f, 0x11, 0x38, 0x38, 0x94, 0x1b, 0x5d, 0x84, 0xb9, 0xae, 0x69, 0x98, 0x2e,
+		0xb6, 0x3b, 0x36, 0x26, 0x51, 0xcf, 0xfa, 0x4a, 0xbf, 0x1a, 0xdf, 0x23, 0x6e, 0x37, 0xfd, 0xda,
+		0x8c, 0x45, 0x9d, 0xed, 0x0e, 0x0a, 0xd1, 0x25, 0x98, 0x20, 0x21, 0xa6, 0xdb, 0x3a, 0x25, 0x64,
+		0x03, 0x7a, 0x69, 0xbc, 0x57, 0x5e, 0x5c, 0xe9, 0x21, 0x0b, 0xe1, 0xe7, 0xa4, 0x90, 0xea, 0xe7,
+		0x42, 0x0f, 0x43, 0x62, 0x1b, 0xeb, 0x6e, 0xd7, 0xc6, 0x4e, 0x7a, 0x89, 0xba, 0xf2, 0xc8, 0xe0,
+		0x38, 0x67, 0x0a, 0x55, 0xec, 0xaa, 0x9e, 0x32, 0x6a, 0xc3, 0xe4, 0x15, 0x6c, 0x1b, 0xdb, 0x46,
+		0x9d, 0x19, 0x15, 0xa6, 0x11, 0x70, 0x76, 0x4c, 0xa3, 0x2e, 0xf8, 0xa0, 0x55, 0x57, 0x77, 0x71,
+		0x0e, 0x36, 0xcb, 0x17, 0x14, 0xb5, 0x78, 0xbe, 0xa8, 0xac, 0x30, 0x33, 0x03, 0xf4, 0xf3, 0x5f,
+		0x90, 0x60, 0xc2, 0xf7, 0x26, 0x24, 0xa3, 0x9a, 0xdd, 0xf6, 0x16, 0xb6, 0xf9, 0xf7, 0xe2, 0x4f,
+		0xe8, 0x08, 0x24, 0xb7, 0xbb, 0xad, 0x16, 0x8b, 0x5b, 0x36, 0x77, 0x27, 0x88, 0x80, 0xc6, 0x2c,
+		0x82, 0x08, 0xcf, 0x44, 0x34, 0x4d, 0x92, 0xbf, 0xd1, 0x3c, 0x24, 0x44, 0x5c, 0xa7, 0xa3, 0x19,
+		0xe9, 0x58, 0x42, 0xf5, 0x9e, 0x59, 0x5b, 0x07, 0xeb, 0x2e, 0x6e, 0xa4, 0x63, 0xa2, 0x8d, 0x3d,
+		0xaf, 0x45, 0x12, 0x11, 0x39, 0x9a, 0x3d, 0x0d, 0x33, 0x03, 0xaf, 0x82, 0xa6, 0x61, 0x62, 0x45,
+		0x59, 0x2e, 0xe5, 0xd5, 0x7c, 0xad, 0x58, 0x29, 0xcb, 0x07, 0x50, 0x0a, 0x7c, 0x6f, 0x27, 0x4b,
+		0x27, 0x62, 0x89, 0x1b, 0x71, 0xf9, 0xd5, 0xf8, 0x89, 0x64, 0xe2, 0xb5, 0xb8, 0xfc, 0xcc, 0x33,
+		0xcf, 0x3c, 0x13, 0xca, 0xfe, 0x30, 0x06, 0x73, 0xc3, 0xf2, 0xe9, 0xd0, 0xd4, 0xde, 0x7b, 0xf9,
+		0x70, 0xe0, 0xe5, 0xf3, 0x10, 0x6d, 0xe9, 0x5b, 0xb8, 0x95, 0x8e, 0xd0, 0x8f, 0x71, 0xdf, 0x58,
+		0x19, 0x7b, 0xb1, 0x44, 0x20, 0x2a, 0x43, 0xa2, 0x0f, 0x71, 0x17, 0x45, 0x29, 0xc3, 0x89, 0xf1,
+		0x18, 0x48, 0x9e, 0xe5, 0xee, 0x3c, 0x02, 0x49, 0xf2, 0x7f, 0xe6, 0xff, 0x18, 0xf3, 0x3f, 0x11,
+		0x50, 0xff, 0xcf, 0x43, 0x82, 0xa6, 0xd0, 0x06, 0xf6, 0xbe, 0x8d, 0x78, 0x26, 0x49, 0xa7, 0x81,
+		0xb7, 0xf5, 0x6e, 0xcb, 0xd5, 0xae, 0xe8, 0xad, 0x2e, 0xa6, 0xc9, 0x30, 0xa9, 0x4e, 0x72, 0xe1,
+		0x05, 0x22, 0x43, 0x0b, 0x30, 0xc1, 0x32, 0xae, 0x61, 0x36, 0xf0, 0x35, 0x3a, 0x1b, 0x47, 0x55,
+		0x96, 0x84, 0x8b, 0x44, 0x42, 0xba, 0x7f, 0xca, 0xb1, 0x4c, 0x91, 0xb6, 0x68, 0x17, 0x44, 0x40,
+		0xbb, 0x7f, 0xb8, 0xbf, 0x10, 0xb8, 0x63, 0xf8, 0xeb, 0x0d, 0xe4, 0xd9, 0x7b, 0x61, 0x9a, 0x6a,
+		0x9c, 0xe2, 0x43, 0x5a, 0x6f, 0xa5, 0x67, 0x68, 0x38, 0xa4, 0x98, 0xb8, 0xc2, 0xa5, 0xd9, 0xef,
+		0x85, 0x20, 0x42, 0x27, 0x9d, 0x69, 0x98, 0xa8, 0x5d, 0xda, 0x50, 0xb4, 0x95, 0xca, 0x66, 0xa1,
+		0xa4, 0xc8, 0x12, 0x09, 0x01, 0x2a, 0x38, 0x5f, 0xaa, 0xe4, 0x6b, 0x72, 0xc8, 0x7b, 0x2e, 0x96,
+		0x6b, 0x67, 0x4e, 0xcb, 0x61, 0x0f, 0xb0, 0xc9, 0x04, 0x11, 0xbf, 0xc2, 0xa9, 0x25, 0x39, 0x8a,
+		0x64, 0x98, 0x64, 0x04, 0xc5, 0x8b, 0xca, 0xca, 0x99, 0xd3, 0x72, 0x2c, 0x28, 0x39, 0xb5, 0x24,
+		0xc7, 0xd1, 0x14, 0x24, 0xa9, 0xa4, 0x50, 0xa9, 0x94, 0xe4, 0x84, 0xc7, 0x59, 0xad, 0xa9, 0xc5,
+		0xf2, 0xaa, 0x9c, 0xf4, 0x38, 0x57, 0xd5, 0xca, 0xe6, 0x86, 0x0c, 0x1e, 0xc3, 0xba, 0x52, 0xad,
+		0xe6, 0x57, 0x15, 0x79, 0xc2, 0xd3, 0x28, 0x5c, 0xaa, 0x29, 0x55, 0x79, 0x32, 0x60, 0xd6, 0xa9,
+		0x25, 0x79, 0xca, 0xeb, 0x42, 0x29, 0x6f, 0xae, 0xcb, 0x29, 0x34, 0x03, 0x53, 0xac, 0x0b, 0x61,
+		0xc4, 0x74, 0x9f, 0xe8, 0xcc, 0x69, 0x59, 0xee, 0x19, 0xc2, 0x58, 0x66, 0x02, 0x82, 0x33, 0xa7,
+		0x65, 0x94, 0x5d, 0x86, 0x28, 0x0d, 0x43, 0x84, 0x20, 0x55, 0xca, 0x17, 0x94, 0x92, 0x56, 0xd9,
+		0x20, 0x83, 0x27, 0x5f, 0x92, 0xa5, 0x9e, 0x4c, 0x55, 0x36, 0x94, 0x7c, 0x4d, 0x59, 0x91, 0xc3,
+		0x7e, 0xd9, 0x87, 0x37, 0x8b, 0xaa, 0xb2, 0x22, 0x87, 0xb2, 0x75, 0x98, 0x1b, 0x36, 0xd9, 0x0e,
+		0x1d, 0x42, 0xbe, 0x58, 0x08, 0xed, 0x11, 0x0b, 0x94, 0xab, 0x3f, 0x16, 0xb2, 0x5f, 0x0f, 0xc3,
+		0xec, 0x90, 0x82, 0x63, 0x68, 0x27, 0x8f, 0x42, 0x94, 0xc5, 0x32, 0xcb, 0xd8, 0xc7, 0x87, 0x56,
+		0x2e, 0x34, 0xb2, 0x07, 0xca, 0x30, 0x8a, 0xf3, 0x97, 0xae, 0xe1, 0x3d, 0x4a, 0x57, 0x42, 0x31,
+		0x10, 0xb0, 0x1f, 0x1d, 0x28, 0x0c, 0x58, 0xed, 0x74, 0x66, 0x9c, 0xda, 0x89, 0xca, 0x6e, 0xad,
+		0x40, 0x88, 0x8e, 0x2c, 0x10, 0x62, 0xef, 0xa6, 0x40, 0x78, 0x04, 0x66, 0x06, 0x6c, 0x19, 0x7b,
+		0xa2, 0xfe, 0x94, 0x04, 0xe9, 0xbd, 0xfc, 0x3b, 0x22, 0xab, 0x86, 0x02, 0x59, 0xf5, 0x91, 0xfe,
+		0x8f, 0x70, 0xe7, 0xde, 0xdf, 0x71, 0x20, 0x5c, 0xbe, 0x2b, 0xc1, 0xa1, 0xe1, 0xab, 0x9c, 0xa1,
+		0x36, 0x7c, 0x08, 0x62, 0x6d, 0xec, 0xee, 0x58, 0xa2, 0x6a, 0xbf, 0x67, 0x48, 0x2d, 0x48, 0x9a,
+		0xfb, 0xe3, 0x85, 0xa3, 0xd0, 0xb9, 0x7e, 0x5b, 0x17, 0xf6, 0x5a, 0x73, 0xf5, 0x5b, 0xca, 0x26,
+		0x34, 0x35, 0xe6, 0xb8, 0x36, 0xd6, 0xdb, 0xd9, 0xcf, 0x84, 0xe0, 0xe0, 0xd0, 0xae, 0x86, 0x9a,
+		0x7d, 0x07, 0x80, 0x61, 0x76, 0xba, 0x2e, 0xab, 0xd3, 0x59, 0x6a, 0x4f, 0x52, 0x09, 0xcd, 0x86,
+		0x24, 0x6d, 0x77, 0x5d, 0xaf, 0x9d, 0x4d, 0xbf, 0xc0, 0x44, 0x54, 0xe1, 0x6c, 0xcf, 0xec, 0x08,
+		0x35, 0xfb, 0xe8, 0x1e, 0xef, 0x3d, 0x10, 0xe9, 0x0f, 0x82, 0x5c, 0x6f, 0x19, 0xd8, 0x74, 0x35,
+		0x66, 0xb8, 0x61, 0x36, 0xd9, 0x34, 0x9e, 0x8b, 0x6e, 0xeb, 0x2d, 0x07, 0xab, 0xd3, 0xac, 0xb9,
+		0x2a, 0x5a, 0x09, 0x82, 0x86, 0x93, 0xed, 0x43, 0xc4, 0x02, 0x08, 0xd6, 0xec, 0x21, 0xb2, 0x7f,
+		0x00, 0x98, 0xf0, 0xad, 0x10, 0xd1, 0x9d, 0x30, 0xf9, 0x94, 0x7e, 0x45, 0xd7, 0xc4, 0x16, 0x01,
+		0xf3, 0xc4, 0x04, 0x91, 0x6d, 0x30, 0x11, 0x7a, 0x10, 0xe6, 0xa8, 0x8a, 0xd5, 0x75, 0xb1, 0xad,
+		0xd5, 0x5b, 0xba, 0xe3, 0x50, 0xa7, 0x25, 0xa8, 0x2a, 0x22, 0x6d, 0x15, 0xd2, 0xb4, 0x2c, 0x5a,
+		0xd0, 0x5f, 0x4a, 0x30, 0x4b, 0x21, 0xed, 0x6e, 0xcb, 0x35, 0x3a, 0x2d, 0xac, 0x91, 0x3d, 0x0c,
+		0x27, 0x0d, 0x3e, 0xd3, 0x0a, 0x3f, 0x94, 0xbe, 0x2d, 0xfd, 0x67, 0x29, 0xf1, 0xeb, 0x78, 0xe6,
+		0xf5, 0xf8, 0x89, 0xef, 0x48, 0xb5, 0x1d, 0xc3, 0xc9, 0x6c, 0xe1, 0x1d, 0xfd, 0x8a, 0x61, 0xd9,
+		0x19, 0xc3, 0xc9, 0x60, 0x53, 0xdf, 0x6a, 0xe1, 0x46, 0x66, 0x6b, 0x37, 0xc3, 0xe7, 0xcd, 0x8c,
+		0x61, 0x66, 0xf8, 0xca, 0xd1, 0xc9, 0x2c, 0x3d, 0xb8, 0x74, 0x3a, 0xa3, 0x9b, 0x8d, 0x8c, 0xbe,
+		0x65, 0x5d, 0xc1, 0x8b, 0x99, 0x9a, 0x95, 0x69, 0x18, 0x0e, 0x81, 0x64, 0x0c, 0xf7, 0xfe, 0xcc,
+		0xae, 0xd5, 0xcd, 0xd4, 0x75, 0x33, 0xe3, 0x60, 0x37, 0xf3, 0x31, 0x51, 0xe8, 0x2d, 0x1e, 0xeb,
+		0x6c, 0x2d, 0x12, 0xc3, 0x8e, 0x2f, 0x92, 0x75, 0x99, 0x66, 0x98, 0xd4, 0x30, 0xf6, 0x4e, 0x99,
+		0x0f, 0x66, 0x2e, 0x29, 0xd5, 0x8f, 0x65, 0x2c, 0x33, 0x63, 0x98, 0x0d, 0xe3, 0x8a, 0xd1, 0xe8,
+		0xea, 0xad, 0x0c, 0xdf, 0x47, 0x70, 0xee, 0xcf, 0x90, 0x45, 0x98, 0x73, 0x7f, 0xc6, 0xb2, 0x33,
+		0x7c, 0x45, 0xef, 0x2c, 0xaa, 0x33, 0x84, 0x6c, 0x9d, 0xbf, 0x24, 0x71, 0xab, 0x83, 0x56, 0xe0,
+		0x0e, 0xfa, 0xea, 0x4d, 0x6c, 0x62, 0x5b, 0x77, 0xb1, 0x86, 0x3f, 0xde, 0xd5, 0x5b, 0x8e, 0xa6,
+		0x9b, 0x0d, 0x6d, 0x47, 0x77, 0x76, 0xd2, 0x73, 0xc4, 0x09, 0x85, 0x50, 0x5a, 0x52, 0x6f, 0x23,
+		0x8a, 0xab, 0x5c, 0x4f, 0xa1, 0x6a, 0x79, 0xb3, 0xf1, 0x98, 0xee, 0xec, 0xa0, 0x1c, 0x1c, 0xa2,
+		0x2c, 0x8e, 0x6b, 0x1b, 0x66, 0x53, 0xab, 0xef, 0xe0, 0xfa, 0x65, 0xad, 0xeb, 0x6e, 0x9f, 0x4d,
+		0x1f, 0xf1, 0x7f, 0x5e, 0xea, 0xe5, 0x2a, 0xd5, 0x59, 0x26, 0x2a, 0x9b, 0xee, 0xf6, 0x59, 0x54,
+		0x85, 0x49, 0x12, 0x51, 0x6d, 0xe3, 0x69, 0xac, 0x6d, 0x5b, 0x36, 0xad, 0x2c, 0x52, 0x43, 0x12,
+		0xb6, 0x2f, 0x0c, 0x16, 0x2b, 0x1c, 0xb0, 0x6e, 0x35, 0x70, 0x2e, 0x5a, 0xdd, 0x50, 0x94, 0x15,
+		0x75, 0x42, 0xb0, 0x9c, 0xb7, 0x6c, 0x32, 0x2a, 0x9a, 0x96, 0x17, 0x25, 0x13, 0x6c, 0x54, 0x34,
+		0x2d, 0x11, 0x23, 0x0f, 0xc1, 0x6c, 0xbd, 0xce, 0xde, 0xd9, 0xa8, 0x6b, 0xc2, 0x41, 0x69, 0xd9,
+		0x6f, 0xec, 0x4c, 0xbd, 0xbe, 0xca, 0x14, 0xf8, 0xb0, 0x75, 0xd0, 0x39, 0x38, 0xd8, 0x73, 0x96,
+		0x1f, 0x38, 0x33, 0xf0, 0x96, 0xfd, 0xd0, 0x87, 0x60, 0xb6, 0xb3, 0x3b, 0x08, 0x44, 0x81, 0x1e,
+		0x3b, 0xbb, 0xfd, 0xb0, 0xbb, 0xe9, 0x9e, 0x97, 0x8d, 0xeb, 0xb4, 0x10, 0x3e, 0xec, 0xd7, 0xf6,
+		0x35, 0xa0, 0x45, 0x90, 0xeb, 0x75, 0x8d, 0x85, 0xa0, 0xa6, 0xdb, 0xd8, 0xd4, 0x9d, 0xf4, 0x02,
+		0x55, 0x8e, 0xb8, 0x76, 0x17, 0xab, 0xa9, 0x7a, 0x5d, 0xa1, 0x8d, 0x79, 0xda, 0x86, 0x4e, 0xc0,
+		0x8c, 0xb5, 0xf5, 0x54, 0x9d, 0x45, 0x92, 0xd6, 0xb1, 0xf1, 0xb6, 0x71, 0x2d, 0xfd, 0x3e, 0xea,
+		0xa5, 0x69, 0xd2, 0x40, 0xc7, 0xc6, 0x06, 0x15, 0xa3, 0xe3, 0x20, 0xd7, 0x9d, 0x1d, 0xdd, 0xee,
+		0xd0, 0xf9, 0xc6, 0xe9, 0xe8, 0x75, 0x9c, 0xbe, 0x9b, 0xa9, 0x32, 0x79, 0x59, 0x88, 0xc9, 0xe8,
+		0x74, 0xae, 0x1a, 0xdb, 0xae, 0x60, 0xbc, 0x97, 0x8d, 0x4e, 0x2a, 0xe3, 0x6c, 0xc7, 0x40, 0xee,
+		0xec, 0x74, 0x82, 0x1d, 0x1f, 0xa3, 0x6a, 0xa9, 0xce, 0x4e, 0xc7, 0xdf, 0xef, 0x5d, 0x30, 0xd5,
+		0xd9, 0xf1, 0x77, 0x7a, 0x9c, 0x55, 0xa5, 0x9d, 0x1d, 0x5f, 0x8f, 0xa7, 0xe1, 0x10, 0x51, 0x6a,
+		0x63, 0x57, 0x6f, 0xe8, 0xae, 0xee, 0xd3, 0xbe, 0x9f, 0x6a, 0xcf, 0x75, 0x76, 0x3a, 0xeb, 0xbc,
+		0x31, 0x60, 0xa7, 0xdd, 0xdd, 0xda, 0xf5, 0xe2, 0xe3, 0x01, 0x66, 0x27, 0x91, 0x89, 0x08, 0x79,
+		0xd7, 0x8b, 0xb3, 0x3f, 0xd9, 0x52, 0x34, 0x9b, 0x83, 0x49, 0x7f, 0xdc, 0xa3, 0x24, 0xb0, 0xc8,
+		0x97, 0x25, 0x52, 0x1a, 0x2e, 0x57, 0x56, 0x48, 0x51, 0xf7, 0xa4, 0x22, 0x87, 0x48, 0x71, 0x59,
+		0x2a, 0xd6, 0x14, 0x4d, 0xdd, 0x2c, 0xd7, 0x8a, 0xeb, 0x8a, 0x1c, 0x1e, 0xb2, 0xec, 0x59, 0x8b,
+		0x24, 0x4e, 0xc8, 0xf7, 0xad, 0x45, 0x12, 0xf7, 0xc8, 0xf7, 0x52, 0x37, 0x0d, 0x04, 0x67, 0xf6,
+		0x8f, 0x61, 0x48, 0x05, 0xf7, 0x3f, 0xd0, 0x07, 0xe0, 0xb0, 0xd8, 0xe0, 0x74, 0xb0, 0xab, 0x5d,
+		0x35, 0x6c, 0x3a, 0x68, 0xdb, 0x3a, 0xab, 0x09, 0xbc, 0xe0, 0x9c, 0xe3, 0x5a, 0x55, 0xec, 0x3e,
+		0x61, 0xd8, 0x64, 0x48, 0xb6, 0x75, 0x17, 0x95, 0x60, 0xc1, 0xb4, 0x34, 0xc7, 0xd5, 0xcd, 0x86,
+		0x6e, 0x37, 0xb4, 0xde, 0xae, 0xb4, 0xa6, 0xd7, 0xeb, 0xd8, 0x71, 0x2c, 0x36, 0xff, 0x7b, 0x2c,
+		0xb7, 0x9b, 0x56, 0x95, 0x2b, 0xf7, 0xa6, 0xc2, 0x3c, 0x57, 0xed, 0x1b, 0x1b, 0xe1, 0xbd, 0xc6,
+		0xc6, 0x11, 0x48, 0xb6, 0xf5, 0x8e, 0x86, 0x4d, 0xd7, 0xde, 0xa5, 0x2b, 0x9b, 0x84, 0x9a, 0x68,
+		0xeb, 0x1d, 0x85, 0x3c, 0xa3, 0x0b, 0x70, 0x4f, 0x4f, 0x55, 0x6b, 0xe1, 0xa6, 0x5e, 0xdf, 0xd5,
+		0xe8, 0x32, 0x86, 0x6e, 0xc6, 0x69, 0x75, 0xcb, 0xdc, 0x6e, 0x19, 0x75, 0xd7, 0x49, 0x4f, 0x78,
+		0x79, 0x30, 0xdb, 0x43, 0x94, 0x28, 0x60, 0xcd, 0xb1, 0x4c, 0xba, 0x7a, 0x59, 0x16, 0xda, 0x81,
+		0xf0, 0x99, 0x7c, 0x4f, 0x84, 0xcf, 0xf0, 0x10, 0x88, 0xc8, 0xd1, 0xb5, 0x48, 0x22, 0x2a, 0xc7,
+		0xd6, 0x22, 0x89, 0x98, 0x1c, 0x5f, 0x8b, 0x24, 0x12, 0x72, 0x72, 0x2d, 0x92, 0x48, 0xca, 0x90,
+		0xfd, 0xc9, 0x14, 0x4c, 0xfa, 0x17, 0x65, 0x64, 0x8d, 0x5b, 0xa7, 0x55, 0x84, 0x44, 0x53, 0xf4,
+		0x5d, 0xfb, 0x2e, 0xe1, 0x16, 0x97, 0x49, 0x79, 0x91, 0x8b, 0xb1, 0x15, 0x90, 0xca, 0x90, 0xa4,
+		0xd0, 0x23, 0x83, 0x0e, 0xb3, 0x72, 0x31, 0xa1, 0xf2, 0x27, 0xb4, 0x0a, 0xb1, 0xa7, 0x1c, 0xca,
+		0xcd, 0xaa, 0xd5, 0xf7, 0xed, 0xcf, 0xbd, 0x56, 0xa5, 0xe4, 0xc9, 0xb5, 0xaa, 0x56, 0xae, 0xa8,
+		0xeb, 0xf9, 0x92, 0xca, 0xe1, 0xe8, 0x36, 0x88, 0xb4, 0xf4, 0xa7, 0x77, 0x83, 0x85, 0x08, 0x15,
+		0xa1, 0x45, 0x98, 0xee, 0x9a, 0x6c, 0x67, 0x83, 0x7c, 0x6b, 0xa2, 0x35, 0xed, 0xd7, 0x4a, 0xf5,
+		0x5a, 0x4b, 0x44, 0x7f, 0xcc, 0xf8, 0xba, 0x03, 0x22, 0xe4, 0xc8, 0x20, 0x58, 0x2d, 0x90, 0x38,
+		0xa1, 0x62, 0x74, 0x0c, 0x26, 0x1b, 0x78, 0xab, 0xdb, 0xd4, 0x6c, 0xdc, 0xd0, 0xeb, 0x6e, 0x70,
+		0x8e, 0x99, 0xa0, 0x4d, 0x2a, 0x6d, 0x41, 0x8f, 0x43, 0x92, 0x7c, 0x2f, 0x93, 0x7e, 0xef, 0x19,
+		0xea, 0x86, 0x07, 0xf6, 0x77, 0x03, 0xff, 0xdc, 0x02, 0xa4, 0xf6, 0xf0, 0xe8, 0x31, 0x88, 0xbb,
+		0xba, 0xdd, 0xc4, 0xae, 0x93, 0x9e, 0xcd, 0x84, 0x8f, 0xa5, 0x96, 0x16, 0xc7, 0xa1, 0xaa, 0x51,
+		0x08, 0xdd, 0x53, 0x10, 0x70, 0xf4, 0x04, 0xc8, 0xbc, 0x8a, 0xd1, 0x78, 0x61, 0xe3, 0xa4, 0xe7,
+		0x68, 0x30, 0xde, 0xbf, 0x3f, 0x25, 0xdf, 0x3e, 0x5f, 0x61, 0x20, 0x75, 0x1a, 0x07, 0x9e, 0x83,
+		0x63, 0xe4, 0xe0, 0xad, 0x8c, 0x91, 0x4d, 0x98, 0xe6, 0x7f, 0x6b, 0x4e, 0xb7, 0xd3, 0xb1, 0x6c,
+		0x37, 0x7d, 0x28, 0x23, 0x8d, 0x36, 0x48, 0x90, 0x31, 0x8c, 0x9a, 0xda, 0x0e, 0x3c, 0xff, 0xe9,
+		0x86, 0xde, 0xfc, 0x93, 0x90, 0x0a, 0x3a, 0xc3, 0x7f, 0xfa, 0x10, 0x1e, 0xf3, 0xf4, 0x81, 0xac,
+		0xbe, 0xc4, 0x92, 0x96, 0x4c, 0x57, 0xec, 0x61, 0xfe, 0x07, 0x21, 0x48, 0x05, 0x5f, 0x0c, 0xad,
+		0x02, 0x12, 0x5f, 0xcc, 0x30, 0x5d, 0xdb, 0x6a, 0x74, 0xeb, 0xb8, 0x91, 0x96, 0x46, 0xf4, 0x33,
+		0xc3, 0x31, 0x45, 0x0f, 0xe2, 0x27, 0xf2, 0x8d, 0x84, 0xd0, 0x98, 0x44, 0x2b, 0xbd, 0x31, 0x72,
+		0x12, 0x66, 0x05, 0x01, 0x21, 0xbb, 0xaa, 0xdb, 0x26, 0xa9, 0xfd, 0xd9, 0x6a, 0x04, 0xf9, 0x9a,
+		0x9e, 0x60, 0x2d, 0x28, 0x0f, 0x22, 0x5c, 0x34, 0x1b, 0xb7, 0x2d, 0xb2, 0x43, 0x18, 0x19, 0xd1,
+		0x6d, 0x8a, 0x03, 0x54, 0xa6, 0xcf, 0x56, 0xca, 0x6d, 0xeb, 0x8a, 0xde, 0xd2, 0xb0, 0x6d, 0x5b,
+		0x36, 0x4d, 0x09, 0x74, 0xa5, 0x4c, 0x85, 0x0a, 0x91, 0x65, 0x4f, 0x42, 0x94, 0xe6, 0x29, 0x04,
+		0xc0, 0x33, 0x95, 0x7c, 0x00, 0x25, 0x20, 0xb2, 0x5c, 0x51, 0xc9, 0xbc, 0x2a, 0xc3, 0x24, 0x93,
+		0x6a, 0x1b, 0x45, 0x65, 0x59, 0x91, 0x43, 0xd9, 0x87, 0x20, 0xc6, 0x92, 0x0f, 0x99, 0x73, 0xbd,
+		0xf4, 0x23, 0x1f, 0xe0, 0x8f, 0x9c, 0x43, 0x12, 0xad, 0x9b, 0xeb, 0x05, 0x45, 0x95, 0x43, 0xd9,
+		0x4d, 0x98, 0xee, 0x1b, 0xac, 0xe8, 0x20, 0xcc, 0xa8, 0x4a, 0x4d, 0x29, 0x93, 0xbd, 0x16, 0x6d,
+		0xb3, 0xfc, 0x78, 0xb9, 0xf2, 0x04, 0xd9, 0xb0, 0x0c, 0x88, 0xc5, 0x04, 0x2e, 0xa1, 0x39, 0x90,
+		0x7b, 0xe2, 0x6a, 0x65, 0x53, 0xa5, 0xd6, 0xfc, 0xfd, 0x10, 0xc8, 0xfd, 0x23, 0x17, 0x1d, 0x86,
+		0xd9, 0x5a, 0x5e, 0x5d, 0x55, 0x6a, 0x1a, 0xdb, 0x3f, 0xf2, 0xa8, 0xe7, 0x40, 0xf6, 0x37, 0x9c,
+		0x2f, 0xd2, 0xed, 0xb1, 0x05, 0x38, 0xe2, 0x97, 0x2a, 0x17, 0x6b, 0x4a, 0xb9, 0x4a, 0x3b, 0xcf,
+		0x97, 0x57, 0x49, 0x35, 0xd1, 0xc7, 0x27, 0x76, 0xac, 0xc2, 0xc4, 0xd4, 0x20, 0x9f, 0x52, 0x5a,
+		0x91, 0x23, 0xfd, 0xe2, 0x4a, 0x59, 0xa9, 0x9c, 0x97, 0xa3, 0xfd, 0xbd, 0xd3, 0x5d, 0xac, 0x18,
+		0x9a, 0x87, 0x43, 0xfd, 0x52, 0x4d, 0x29, 0xd7, 0xd4, 0x4b, 0x72, 0xbc, 0xbf, 0xe3, 0xaa, 0xa2,
+		0x5e, 0x28, 0x2e, 0x2b, 0x72, 0x02, 0x1d, 0x02, 0x14, 0xb4, 0xa8, 0xf6, 0x58, 0x65, 0x45, 0x4e,
+		0xee, 0x37, 0xc5, 0x21, 0x79, 0x36, 0xfb, 0x1d, 0x09, 0x26, 0xfd, 0x3b, 0x4b, 0x81, 0x0c, 0x24,
+		0xbd, 0x57, 0x67, 0xe9, 0xec, 0xff, 0x0a, 0xc1, 0x84, 0x6f, 0xab, 0x89, 0x2c, 0xe9, 0xf5, 0x56,
+		0xcb, 0xba, 0xaa, 0xe9, 0x2d, 0x43, 0x77, 0xf8, 0x44, 0x0a, 0x54, 0x94, 0x27, 0x92, 0x71, 0x27,
+		0xae, 0xf1, 0x6b, 0x9f, 0xd8, 0xbb, 0xae, 0x7d, 0xe2, 0xef, 0xe1, 0xda, 0x27, 0x2a, 0xc7, 0xb2,
+		0x3f, 0x0f, 0x81, 0xdc, 0xbf, 0x83, 0xd4, 0xe7, 0x3f, 0x69, 0x2f, 0xff, 0xf9, 0xdf, 0x33, 0x74,
+		0x2b, 0xef, 0xd9, 0x5f, 0x12, 0x84, 0xf7, 0x2c, 0x09, 0x86, 0xcc, 0x74, 0x91, 0xf7, 0xf2, 0x4c,
+		0x37, 0x2c, 0x7c, 0xff, 0x8f, 0x04, 0xa9, 0xe0, 0xc6, 0x57, 0xc0, 0x73, 0xd9, 0x5b, 0xf1, 0x5c,
+		0xf0, 0xcb, 0xdc, 0xb9, 0xd7, 0x97, 0xf9, 0x1b, 0x7d, 0xbf, 0x97, 0xc3, 0x30, 0x15, 0xd8, 0x21,
+		0x1b, 0xd7, 0xca, 0x8f, 0xc3, 0x8c, 0xd1, 0xc0, 0xed, 0x8e, 0xe5, 0x92, 0xbb, 0x23, 0x5a, 0x0b,
+		0x5f, 0xc1, 0x2d, 0xea, 0x8e, 0xd4, 0x90, 0xf3, 0xf1, 0x40, 0x0f, 0x8b, 0xc5, 0x1e, 0xae, 0x44,
+		0x60, 0xb9, 0xd9, 0xe2, 0x8a, 0xb2, 0xbe, 0x51, 0xa9, 0x29, 0xe5, 0xe5, 0x4b, 0x22, 0xe3, 0xab,
+		0xb2, 0xd1, 0xa7, 0x16, 0x70, 0xfc, 0x5d, 0xef, 0x8d, 0x55, 0xed, 0x06, 0xc8, 0xfd, 0x6f, 0x43,
+		0x12, 0xff, 0x90, 0xf7, 0x91, 0x0f, 0xa0, 0x59, 0x98, 0x2e, 0x57, 0xb4, 0x6a, 0x71, 0x45, 0xd1,
+		0x94, 0xf3, 0xe7, 0x95, 0xe5, 0x5a, 0x95, 0x9d, 0xef, 0x78, 0xda, 0x35, 0x39, 0x34, 0xec, 0x1b,
+		0x7d, 0x39, 0x0c, 0xb3, 0x43, 0x2c, 0x42, 0x79, 0xbe, 0xa1, 0xca, 0x76, 0x7c, 0x1f, 0x18, 0xe7,
+		0x2d, 0x16, 0xc9, 0x36, 0xc2, 0x86, 0x6e, 0xbb, 0x7c, 0xff, 0xf5, 0x38, 0x10, 0x37, 0x9b, 0x2e,
+		0x59, 0x37, 0xd8, 0xfc, 0xfc, 0x8c, 0xd5, 0x35, 0xd3, 0x3d, 0x39, 0x3b, 0x42, 0xbb, 0x1f, 0x50,
+		0xc7, 0x72, 0x0c, 0xd7, 0xb8, 0x42, 0xae, 0xb4, 0x88, 0xc3, 0x36, 0x32, 0xa0, 0x23, 0xaa, 0x2c,
+		0x5a, 0x8a, 0xa6, 0xeb, 0x69, 0x9b, 0xb8, 0xa9, 0xf7, 0x69, 0x93, 0x22, 0x26, 0xac, 0xca, 0xa2,
+		0xc5, 0xd3, 0xbe, 0x13, 0x26, 0x1b, 0x56, 0x97, 0x6c, 0xff, 0x30, 0x3d, 0x92, 0xb2, 0x25, 0x75,
+		0x82, 0xc9, 0x3c, 0x15, 0xbe, 0x3f, 0xd7, 0x3b, 0xe5, 0x9b, 0x54, 0x27, 0x98, 0x8c, 0xa9, 0xdc,
+		0x0b, 0xd3, 0x7a, 0xb3, 0x69, 0x13, 0x72, 0x41, 0xc4, 0xb6, 0x4d, 0x53, 0x9e, 0x98, 0x2a, 0xce,
+		0xaf, 0x41, 0x42, 0xf8, 0x81, 0x2c, 0xb0, 0x89, 0x27, 0xb4, 0x0e, 0x3b, 0x19, 0x08, 0x91, 0x83,
+		0x3f, 0x53, 0x34, 0xde, 0x09, 0x93, 0x86, 0xa3, 0xf5, 0x2e, 0xb4, 0x84, 0x32, 0xa1, 0x63, 0x09,
+		0x75, 0xc2, 0x70, 0xbc, 0xc3, 0xe9, 0xec, 0x37, 0xa7, 0x01, 0x7a, 0x41, 0x87, 0x3e, 0x2f, 0x41,
+		0x8a, 0x4d, 0x40, 0x1d, 0x1b, 0x3b, 0xd8, 0xac, 0x8b, 0xf5, 0xe6, 0xf1, 0x7d, 0x42, 0x95, 0xa5,
+		0xbf, 0x0d, 0x0e, 0x28, 0x3c, 0xfa, 0x9c, 0x24, 0x5d, 0x97, 0x22, 0xd7, 0x25, 0xe9, 0x15, 0x69,
+		0x0a, 0x25, 0x94, 0x8b, 0x1b, 0xa5, 0xe2, 0x72, 0xb1, 0x96, 0x7e, 0x36, 0x4e, 0x9f, 0x8b, 0xeb,
+		0xfc, 0xf9, 0xd5, 0x78, 0xb0, 0xfd, 0xb5, 0xf8, 0xb7, 0xa5, 0x70, 0xe2, 0xb5, 0xb8, 0x3a, 0xb5,
+		0xed, 0xe7, 0x43, 0x2d, 0xff, 0x5d, 0x98, 0xd0, 0x5e, 0x2b, 0xd4, 0x9e, 0x35, 0x0a, 0xbf, 0x01,
+		0x53, 0x38, 0x4e, 0x0d, 0x89, 0x51, 0x43, 0x26, 0x50, 0x6c, 0xb9, 0x54, 0xa9, 0x2a, 0x2b, 0xd4,
+		0x8c, 0x24, 0x8a, 0x54, 0x36, 0x94, 0x72, 0xfa, 0x55, 0xd1, 0x65, 0xef, 0xda, 0xcc, 0x75, 0x09,
+		0x0e, 0x8b, 0xc3, 0x6e, 0x3e, 0x17, 0x63, 0xb3, 0x6e, 0x35, 0x44, 0xc9, 0x9c, 0x5a, 0x7a, 0xff,
+		0x7e, 0x9d, 0xab, 0x1c, 0x4a, 0x5d, 0xa2, 0x70, 0x60, 0xe1, 0x81, 0x01, 0x97, 0xe4, 0xcb, 0x2b,
+		0xdc, 0x96, 0x09, 0x14, 0xdb, 0xc8, 0x2f, 0x3f, 0xae, 0xac, 0xf4, 0xac, 0x39, 0x68, 0x0f, 0x63,
+		0x41, 0x9f, 0x80, 0x69, 0xb2, 0xad, 0x4b, 0x62, 0xc3, 0x68, 0xb0, 0xdb, 0x07, 0x91, 0xbd, 0x8e,
+		0xab, 0x7b, 0x16, 0x91, 0x7d, 0xde, 0x0b, 0x1e, 0xa2, 0x70, 0xdc, 0x67, 0x4a, 0x12, 0x45, 0xca,
+		0x95, 0xb2, 0x22, 0xcc, 0xa0, 0x27, 0xf5, 0x97, 0x7a, 0x66, 0xa4, 0xba, 0x01, 0x28, 0xfa, 0x04,
+		0xc8, 0x62, 0xff, 0xc9, 0x73, 0x49, 0x74, 0xaf, 0x13, 0xf7, 0x9e, 0x01, 0x7c, 0x17, 0xcb, 0x73,
+		0xc6, 0x3d, 0x3e, 0x0b, 0xe6, 0xd0, 0x74, 0x49, 0x29, 0xaf, 0xd6, 0x1e, 0xd3, 0x36, 0x54, 0x85,
+		0x1e, 0x9c, 0xa6, 0x9f, 0x15, 0xdd, 0x4f, 0xb7, 0x83, 0x40, 0xf4, 0x77, 0x24, 0x98, 0x60, 0x25,
+		0x12, 0xdb, 0xf4, 0x62, 0xbb, 0x15, 0xf7, 0xec, 0xd7, 0x37, 0xad, 0x90, 0xa8, 0x76, 0xe1, 0x1c,
+		0xed, 0x36, 0x2c, 0x02, 0xe2, 0x30, 0x42, 0x25, 0x65, 0x35, 0xbf, 0x7c, 0x49, 0x2b, 0x28, 0xd5,
+		0x1a, 0xc9, 0x68, 0x15, 0x95, 0xc5, 0x28, 0xa0, 0x68, 0xbe, 0x54, 0xaa, 0x3c, 0xd1, 0x73, 0x04,
+		0x3c, 0xe5, 0xd1, 0xa0, 0xff, 0x24, 0xc1, 0x1c, 0x36, 0xb7, 0x2d, 0x72, 0x3f, 0xce, 0xa4, 0xe7,
+		0x24, 0x9a, 0xe3, 0xee, 0xb6, 0xd8, 0x88, 0x1e, 0xba, 0xd2, 0xf7, 0x47, 0x26, 0xc5, 0x95, 0x29,
+		0xac, 0x4a, 0x50, 0x85, 0x27, 0x9f, 0x93, 0x42, 0xd7, 0x89, 0x61, 0x21, 0x6a, 0x5b, 0xe4, 0xba,
+		0x14, 0xa5, 0x16, 0xc6, 0xaf, 0x4b, 0x89, 0xeb, 0x52, 0xf2, 0x15, 0x69, 0x06, 0x4d, 0x56, 0x6b,
+		0x97, 0x4a, 0x8a, 0xc6, 0xac, 0xa5, 0x16, 0xa6, 0x50, 0x92, 0xca, 0xc8, 0xb9, 0x46, 0xfa, 0xf5,
+		0xa0, 0xe0, 0x4c, 0xfa, 0x73, 0x65, 0x62, 0xf6, 0xeb, 0x71, 0x15, 0xe1, 0x81, 0xfe, 0xd0, 0xf7,
+		0x25, 0xb8, 0x4d, 0xdc, 0x37, 0x70, 0xe8, 0x19, 0xa4, 0xe6, 0x3b, 0xad, 0x4c, 0xd0, 0x77, 0x50,
+		0xf6, 0x7b, 0x87, 0xde, 0x91, 0x25, 0x17, 0x2e, 0xf2, 0x65, 0x75, 0xff, 0x89, 0x66, 0xe1, 0x0c,
+		0x7b, 0xb5, 0x57, 0xa4, 0x69, 0x04, 0xca, 0xc5, 0x8d, 0x8a, 0x5a, 0xd3, 0xf2, 0xa5, 0x12, 0x7d,
+		0x81, 0x83, 0x48, 0xe6, 0x92, 0x5a, 0x65, 0x43, 0x2b, 0x29, 0x17, 0x94, 0x52, 0xfa, 0xf5, 0x38,
+		0x37, 0xfb, 0x70, 0x63, 0x38, 0xe1, 0xfc, 0xcb, 0x12, 0xcc, 0x0c, 0x74, 0x9f, 0xfd, 0xa4, 0x04,
+		0x87, 0xf7, 0x30, 0x01, 0xdd, 0x0d, 0x77, 0xae, 0x28, 0xe7, 0xf3, 0x9b, 0xa5, 0x9a, 0x56, 0xbd,
+		0xb4, 0x5e, 0xa8, 0x94, 0xb4, 0x0b, 0xc5, 0x6a, 0xb1, 0x50, 0x2c, 0x15, 0x6b, 0xfe, 0x99, 0x2d,
+		0x05, 0x3e, 0x03, 0xd9, 0x7a, 0xaf, 0xdf, 0x3c, 0x39, 0x44, 0x56, 0x95, 0xa5, 0xca, 0x72, 0xbe,
+		0x44, 0x95, 0xc2, 0x62, 0xd1, 0xba, 0x5c, 0x93, 0x23, 0x6b, 0x89, 0x84, 0xc4, 0x27, 0xbb, 0xbf,
+		0x0d, 0x53, 0x81, 0x6c, 0x48, 0xd6, 0x58, 0x74, 0x6d, 0x46, 0x02, 0xbc, 0xaa, 0x94, 0x97, 0xfd,
+		0x6b, 0xc2, 0x49, 0xf0, 0xb2, 0x9f, 0x2c, 0x91, 0x27, 0x91, 0x1b, 0xe5, 0x10, 0x99, 0x6d, 0x79,
+		0x7c, 0x7a, 0x27, 0xfd, 0xe1, 0xec, 0xc3, 0x90, 0x10, 0xd9, 0x8d, 0xac, 0xf4, 0xe8, 0x82, 0xad,
+		0x6f, 0x9d, 0x99, 0x00, 0x9a, 0xda, 0x64, 0x89, 0x18, 0xc8, 0x52, 0x9e, 0x1c, 0xca, 0x5e, 0x80,
+		0x83, 0x43, 0x33, 0x13, 0xba, 0x0b, 0x16, 0xc4, 0xed, 0x02, 0xb6, 0x86, 0xd4, 0x94, 0xf2, 0x72,
+		0x65, 0x85, 0xac, 0xba, 0x7b, 0x9c, 0x00, 0x3c, 0x45, 0x31, 0x2b, 0x45, 0xfa, 0x92, 0x43, 0xd9,
+		0x22, 0xa4, 0x82, 0xf9, 0x05, 0x1d, 0x81, 0xc3, 0x9b, 0xb5, 0xf3, 0x67, 0xb5, 0x0b, 0xf9, 0x52,
+		0x71, 0x25, 0xdf, 0xb7, 0xbe, 0x06, 0xe0, 0x49, 0x46, 0x0e, 0x11, 0x43, 0x49, 0xf2, 0x91, 0xc3,
+		0xd9, 0x48, 0x42, 0x92, 0xa5, 0x6c, 0x15, 0xa6, 0xfb, 0x32, 0x05, 0xba, 0x1d, 0xd2, 0x7c, 0xc1,
+		0x3b, 0xcc, 0xaa, 0x59, 0xe8, 0xcf, 0x1d, 0x6c, 0xe9, 0xbf, 0xa2, 0x94, 0x8a, 0xeb, 0xc5, 0x1a,
+		0xb5, 0xef, 0x31, 0x80, 0x5e, 0x0a, 0x20, 0xa5, 0xcd, 0x5a, 0xb5, 0x52, 0xd6, 0xce, 0x93, 0x7d,
+		0x83, 0x9a, 0x8f, 0x2a, 0x09, 0x6c, 0xc8, 0xcb, 0x12, 0x59, 0xde, 0x0e, 0xe6, 0x05, 0x39, 0x94,
+		0xdd, 0x06, 0x34, 0x38, 0x7c, 0x51, 0x06, 0x6e, 0x57, 0xca, 0xe7, 0x2b, 0xea, 0xb2, 0xa2, 0x95,
+		0xf3, 0xeb, 0xc4, 0x3e, 0x36, 0x58, 0x7b, 0xd4, 0x53, 0xd0, 0x1b, 0xab, 0x62, 0x53, 0xa3, 0x37,
+		0x9c, 0x59, 0x58, 0x79, 0x63, 0x97, 0x1e, 0x16, 0xbc, 0x16, 0x97, 0x9f, 0x2f, 0x9f, 0x88, 0x25,
+		0x9e, 0x2f, 0xcb, 0x2f, 0x90, 0xff, 0xbf, 0x50, 0x96, 0x3f, 0x5f, 0x5e, 0x8b, 0x25, 0x5e, 0x8d,
+		0xcb, 0xaf, 0xc5, 0xb3, 0xbf, 0x0d, 0x03, 0xea, 0x0d, 0x47, 0x6f, 0x3f, 0xef, 0x22, 0x24, 0xbc,
+		0x0d, 0x42, 0x76, 0x63, 0xfc, 0x03, 0xfb, 0x8c, 0x62, 0x01, 0xf3, 0x89, 0xfa, 0x36, 0x0c, 0x3d,
+		0x36, 0xb2, 0x1b, 0xd4, 0x36, 0x4c, 0xa3, 0xdd, 0x6d, 0x6b, 0x62, 0xd7, 0x6c, 0xe4, 0x6e, 0x10,
+		0x07, 0xf0, 0x67, 0x4a, 0xa1, 0x5f, 0x0b, 0x50, 0x44, 0x47, 0x52, 0x30, 0x00, 0x7f, 0x9e, 0xff,
+		0xbd, 0x04, 0xe9, 0xbd, 0x8c, 0x7d, 0x57, 0x1b, 0x7a, 0x65, 0x98, 0xb3, 0xae, 0x60, 0xdb, 0x36,
+		0x1a, 0xf4, 0xdc, 0xce, 0xab, 0xcc, 0x23, 0xa3, 0x2b, 0xf3, 0x59, 0x1f, 0x90, 0x8b, 0x1d, 0x54,
+		0x20, 0x85, 0xd3, 0x35, 0x52, 0x33, 0x08, 0xa6, 0xe8, 0x68, 0xa6, 0x29, 0x0a, 0x11, 0x1c, 0x6b,
+		0x64, 0x20, 0x90, 0xc5, 0x71, 0x48, 0x0e, 0xf7, 0xca, 0xff, 0xec, 0x77, 0x43, 0x90, 0x0a, 0xde,
+		0xb3, 0x46, 0x2b, 0x90, 0x68, 0x59, 0xfc, 0x02, 0x22, 0xfb, 0xda, 0xc7, 0x46, 0x5c, 0xcd, 0x5e,
+		0x2c, 0x71, 0x7d, 0xd5, 0x43, 0xce, 0xff, 0x44, 0x82, 0x84, 0x10, 0xa3, 0x43, 0x10, 0xe9, 0xe8,
+		0xee, 0x0e, 0xa5, 0x8b, 0x16, 0x42, 0xb2, 0xa4, 0xd2, 0x67, 0x22, 0x77, 0x3a, 0x3a, 0xbb, 0x7c,
+		0xc9, 0xe5, 0xe4, 0x99, 0x94, 0xde, 0x2d, 0xac, 0x37, 0xe8, 0x89, 0xb3, 0xd5, 0x6e, 0x63, 0xd3,
+		0x75, 0x44, 0xe9, 0xcd, 0xe5, 0xcb, 0x5c, 0x4c, 0x6e, 0xf1, 0xbb, 0xb6, 0x6e, 0xb4, 0x02, 0xba,
+		0x11, 0xaa, 0x2b, 0x8b, 0x06, 0x4f, 0x39, 0x07, 0xb7, 0x09, 0xde, 0x06, 0x76, 0xf5, 0xfa, 0x0e,
+		0x6e, 0xf4, 0x40, 0x31, 0x7a, 0xdf, 0xe6, 0x30, 0x57, 0x58, 0xe1, 0xed, 0x02, 0xdb, 0xf7, 0xc3,
+		0x87, 0x9f, 0x86, 0x60, 0x46, 0x9c, 0x98, 0x37, 0x3c, 0xd7, 0xad, 0x03, 0xe8, 0xa6, 0x69, 0xb9,
+		0x7e, 0xe7, 0x0d, 0xae, 0x3d, 0x06, 0x70, 0x8b, 0x79, 0x0f, 0xa4, 0xfa, 0x08, 0xe6, 0x7f, 0x23,
+		0x01, 0xf4, 0x9a, 0xf6, 0xf4, 0xe2, 0x02, 0x4c, 0xf0, 0x3b, 0xf5, 0xf4, 0x37, 0x1d, 0x6c, 0x13,
+		0x19, 0x98, 0x88, 0x9c, 0xad, 0x93, 0xfd, 0xe5, 0x2d, 0xdc, 0x34, 0x4c, 0x7e, 0xb3, 0x91, 0x3d,
+		0x88, 0xdb, 0x3d, 0x91, 0xde, 0x0d, 0x60, 0x15, 0x12, 0x0e, 0x6e, 0xeb, 0xa6, 0x6b, 0xd4, 0xf9,
+		0x18, 0x3a, 0x73, 0x4b, 0xc6, 0x2f, 0x56, 0x39, 0x5a, 0xf5, 0x78, 0xb2, 0xc7, 0x20, 0x21, 0xa4,
+		0x5e, 0x56, 0x3e, 0x80, 0xe2, 0x10, 0xae, 0x2a, 0x64, 0x5e, 0xa2, 0xc9, 0xb1, 0x98, 0xaf, 0xca,
+		0xa1, 0x13, 0x3f, 0x0b, 0x41, 0x5c, 0x0c, 0xea, 0x59, 0x98, 0x56, 0x56, 0x8a, 0x7d, 0x09, 0x7e,
+		0x16, 0x52, 0x42, 0xc8, 0x13, 0xdc, 0xb3, 0x71, 0xbf, 0x70, 0x43, 0xad, 0xd4, 0x2a, 0x4b, 0xf2,
+		0xaf, 0x07, 0x85, 0xa7, 0xe4, 0x57, 0xe3, 0x68, 0x06, 0x26, 0x85, 0x70, 0xe9, 0xc1, 0xa5, 0x53,
+		0xf2, 0x6b, 0xfd, 0xa2, 0xd3, 0xf2, 0xeb, 0xfd, 0xa2, 0x33, 0xf2, 0xcd, 0x38, 0x3a, 0x08, 0x72,
+		0xcf, 0x98, 0x6a, 0x2d, 0x4f, 0x2e, 0x1f, 0x7e, 0xae, 0x4c, 0x12, 0xbe, 0x10, 0xbf, 0x5f, 0xab,
+		0x91, 0x7c, 0x5e, 0x29, 0x97, 0x2e, 0xc9, 0x92, 0xbf, 0x61, 0xc9, 0xd7, 0x10, 0x42, 0x77, 0xc0,
+		0x61, 0xd1, 0x70, 0xee, 0xdc, 0xb9, 0x73, 0x0f, 0xfb, 0x1a, 0x5f, 0xfc, 0x6c, 0xac, 0xbf, 0xf9,
+		0xac, 0xaf, 0xf9, 0xab, 0x83, 0xcd, 0xe7, 0x7c, 0xcd, 0x5f, 0xfb, 0x6c, 0x0c, 0xcd, 0xc2, 0x84,
+		0x68, 0x5e, 0xcf, 0x5f, 0x94, 0xdf, 0x79, 0xe7, 0x9d, 0x77, 0xe2, 0x27, 0x36, 0x41, 0x1e, 0xa8,
+		0x5b, 0xe6, 0x40, 0x0e, 0x14, 0x2a, 0xe4, 0x43, 0x1c, 0xe8, 0x93, 0xd2, 0x5a, 0x44, 0x96, 0x48,
+		0x1d, 0xe0, 0x93, 0xb2, 0xba, 0x45, 0x0e, 0x15, 0x3e, 0x01, 0xb3, 0x75, 0xab, 0xdd, 0x1f, 0x1b,
+		0x05, 0xb9, 0xef, 0x5a, 0x93, 0xf3, 0x98, 0xf4, 0xe4, 0x03, 0x5c, 0xa9, 0x69, 0xb5, 0x74, 0xb3,
+		0xb9, 0x68, 0xd9, 0xcd, 0xde, 0x8f, 0x97, 0xc8, 0xa2, 0xcb, 0xf1, 0xfd, 0x84, 0xa9, 0xb3, 0xf5,
+		0x7b, 0x49, 0x7a, 0x25, 0x14, 0x5e, 0xdd, 0x28, 0x7c, 0x23, 0x34, 0xbf, 0xca, 0x80, 0x1b, 0x22,
+		0xf2, 0x54, 0xbc, 0xdd, 0xc2, 0x75, 0x12, 0x1e, 0x70, 0xf3, 0x3e, 0x98, 0x6b, 0x5a, 0x4d, 0x8b,
+		0x32, 0x9d, 0x24, 0x7f, 0x31, 0x23, 0x50, 0xd2, 0x93, 0xce, 0x8f, 0xfc, 0xa9, 0x54, 0xae, 0x0c,
+		0xb3, 0x5c, 0x59, 0xa3, 0x6b, 0x40, 0x76, 0x69, 0x01, 0xed, 0x7b, 0x1d, 0x30, 0xfd, 0xad, 0x5f,
+		0xd1, 0x4d, 0x3d, 0x75, 0x86, 0x43, 0x49, 0x1b, 0xbb, 0xd7, 0x90, 0x53, 0xe1, 0x60, 0x80, 0x8f,
+		0xad, 0xbf, 0xb1, 0x3d, 0x82, 0xf1, 0x47, 0x9c, 0x71, 0xd6, 0xc7, 0x58, 0xe5, 0xd0, 0xdc, 0x32,
+		0x4c, 0xdd, 0x0a, 0xd7, 0x7f, 0xe5, 0x5c, 0x93, 0xd8, 0x4f, 0xb2, 0x0a, 0xd3, 0x94, 0xa4, 0xde,
+		0x75, 0x5c, 0xab, 0x4d, 0x37, 0x37, 0xf6, 0xa7, 0xf9, 0x6f, 0xbf, 0x62, 0xd9, 0x36, 0x45, 0x60,
+		0xcb, 0x1e, 0x2a, 0x97, 0x03, 0xba, 0x96, 0x25, 0x77, 0xdd, 0x47, 0x30, 0xfc, 0x98, 0x1b, 0xe2,
+		0xe9, 0xe7, 0x2e, 0x90, 0xa5, 0x4d, 0xb7, 0x4d, 0xf7, 0x1e, 0xfc, 0x96, 0x8c, 0xbe, 0xf8, 0x97,
+		0xfe, 0xd9, 0xa7, 0x58, 0x42, 0x9f, 0xf5, 0x08, 0x7c, 0x36, 0xf9, 0xbe, 0x62, 0x13, 0xbb, 0x2e,
+		0xb6, 0x1d, 0x4d, 0x6f, 0x0d, 0x33, 0xcf, 0x77, 0xcd, 0x28, 0xfd, 0xa5, 0x37, 0x82, 0x5f, 0x71,
+		0x95, 0x21, 0xf3, 0xad, 0x56, 0x6e, 0x13, 0x0e, 0x0f, 0x89, 0x8a, 0x31, 0x38, 0xbf, 0xcc, 0x39,
+		0xe7, 0x06, 0x22, 0x83, 0xd0, 0x6e, 0x80, 0x90, 0x7b, 0xdf, 0x72, 0x0c, 0xce, 0x7f, 0xcc, 0x39,
+		0x11, 0xc7, 0x8a, 0x4f, 0x4a, 0x18, 0xd7, 0x60, 0xe6, 0x0a, 0xb6, 0xb7, 0x2c, 0x87, 0x5f, 0xed,
+		0x1a, 0x83, 0xee, 0x2b, 0x9c, 0x6e, 0x9a, 0x03, 0xe9, 0x5d, 0x2f, 0xc2, 0x75, 0x0e, 0x12, 0xdb,
+		0x7a, 0x1d, 0x8f, 0x41, 0xf1, 0x22, 0xa7, 0x88, 0x13, 0x7d, 0x02, 0xcd, 0xc3, 0x64, 0xd3, 0xe2,
+		0xdb, 0x4f, 0xa3, 0xe1, 0x5f, 0xe5, 0xf0, 0x09, 0x81, 0xe1, 0x14, 0x1d, 0xab, 0xd3, 0x6d, 0x91,
+		0xbd, 0xa9, 0xd1, 0x14, 0x5f, 0x13, 0x14, 0x02, 0xc3, 0x29, 0x6e, 0xc1, 0xad, 0x2f, 0x09, 0x0a,
+		0xc7, 0xe7, 0xcf, 0x47, 0xc9, 0x3d, 0xf8, 0xd6, 0xae, 0x65, 0x8e, 0x63, 0xc4, 0xcb, 0x9c, 0x01,
+		0x38, 0x84, 0x10, 0x3c, 0x02, 0xc9, 0x71, 0x3f, 0xc4, 0x3f, 0x7d, 0x43, 0x0c, 0x0f, 0xf1, 0x05,
+		0x56, 0x61, 0x5a, 0x24, 0x28, 0x72, 0x36, 0x3a, 0x9a, 0xe2, 0xeb, 0x9c, 0x22, 0xe5, 0x83, 0xf1,
+		0xd7, 0x70, 0xb1, 0xe3, 0x36, 0xf1, 0x38, 0x24, 0xff, 0x4c, 0xbc, 0x06, 0x87, 0x70, 0x57, 0x6e,
+		0x61, 0xb3, 0xbe, 0x33, 0x1e, 0xc3, 0x3f, 0x17, 0xae, 0x14, 0x18, 0x42, 0xb1, 0x0c, 0x53, 0x6d,
+		0xdd, 0x76, 0x76, 0xf4, 0xd6, 0x58, 0x9f, 0xe3, 0x5f, 0x70, 0x8e, 0x49, 0x0f, 0xc4, 0x3d, 0xd2,
+		0x35, 0x6f, 0x85, 0xe6, 0x1b, 0xc2, 0x23, 0x5d, 0x33, 0x40, 0xb4, 0x01, 0x73, 0x8e, 0x4b, 0x0b,
+		0xf1, 0x5b, 0x61, 0xfb, 0x97, 0x62, 0xe8, 0x31, 0xec, 0xba, 0x9f, 0xf1, 0x11, 0x48, 0x3a, 0xc6,
+		0xd3, 0x63, 0xd1, 0xfc, 0x2b, 0xf1, 0xa5, 0x29, 0x80, 0x80, 0x2f, 0xc1, 0x6d, 0x43, 0xa7, 0x89,
+		0x31, 0xc8, 0xfe, 0x35, 0x27, 0x3b, 0x34, 0x64, 0xaa, 0xe0, 0x29, 0xe1, 0x56, 0x29, 0xbf, 0x29,
+		0x52, 0x02, 0xee, 0xe3, 0xda, 0x20, 0x07, 0x03, 0x8e, 0xbe, 0x7d, 0x6b, 0x5e, 0xfb, 0x37, 0xc2,
+		0x6b, 0x0c, 0x1b, 0xf0, 0x5a, 0x0d, 0x0e, 0x71, 0xc6, 0x5b, 0xfb, 0xae, 0xff, 0x56, 0x24, 0x56,
+		0x86, 0xde, 0x0c, 0x7e, 0xdd, 0x8f, 0xc0, 0xbc, 0xe7, 0x4e, 0xb1, 0xf3, 0xec, 0x68, 0xe4, 0x62,
+		0xd8, 0x68, 0xe6, 0x6f, 0x71, 0x66, 0x91, 0xf1, 0xbd, 0xad, 0x6b, 0x67, 0x5d, 0xef, 0x10, 0xf2,
+		0x8b, 0x90, 0x16, 0xe4, 0x5d, 0xd3, 0xc6, 0x75, 0xab, 0x69, 0x1a, 0x4f, 0xe3, 0xc6, 0x18, 0xd4,
+		0xdf, 0xee, 0xfb, 0x54, 0x9b, 0x3e, 0x38, 0x61, 0x2e, 0x82, 0xec, 0xd5, 0x2a, 0x9a, 0xd1, 0xa6,
+		0xa7, 0x77, 0xfb, 0x33, 0xfe, 0x3b, 0xf1, 0xa5, 0x3c, 0x5c, 0x91, 0xc2, 0x72, 0x0a, 0xb0, 0x9f,
+		0xcc, 0x8c, 0x1b, 0x92, 0xdf, 0xe1, 0x44, 0x53, 0x3d, 0x14, 0x4f, 0x1c, 0x75, 0xab, 0xdd, 0xd1,
+		0xed, 0x71, 0xf2, 0xdf, 0x77, 0x45, 0xe2, 0xe0, 0x10, 0x9e, 0x38, 0x48, 0x45, 0x47, 0x66, 0xfb,
+		0x31, 0x18, 0xbe, 0x27, 0x12, 0x87, 0xc0, 0x70, 0x0a, 0x51, 0x30, 0x8c, 0x41, 0xf1, 0xef, 0x05,
+		0x85, 0xc0, 0x10, 0x8a, 0x0f, 0xf7, 0x26, 0x5a, 0x1b, 0x37, 0x0d, 0xc7, 0xe5, 0x3f, 0x6e, 0xdb,
+		0x9f, 0xea, 0x3f, 0xbc, 0x11, 0x2c, 0xc2, 0x54, 0x1f, 0x94, 0x64, 0x22, 0xbe, 0x5d, 0x4c, 0x8f,
+		0x43, 0x46, 0x1b, 0xf6, 0x7d, 0x91, 0x89, 0x7c, 0x30, 0x62, 0x9b, 0xaf, 0x42, 0x24, 0x6e, 0xaf,
+		0x93, 0x15, 0xe6, 0x18, 0x74, 0xff, 0xb1, 0xcf, 0xb8, 0xaa, 0xc0, 0x12, 0x4e, 0x5f, 0xfd, 0xd3,
+		0x35, 0x2f, 0xe3, 0xdd, 0xb1, 0xa2, 0xf3, 0x07, 0x7d, 0xf5, 0xcf, 0x26, 0x43, 0xb2, 0x1c, 0x32,
+		0xdd, 0x57, 0x4f, 0xa1, 0x51, 0x3f, 0x9e, 0x4d, 0x7f, 0xf2, 0x2d, 0xfe, 0xbe, 0xc1, 0x72, 0x2a,
+		0x57, 0x02, 0x99, 0x4b, 0x7a, 0x05, 0xec, 0x48, 0xb2, 0x4f, 0xbd, 0xe5, 0xc5, 0x79, 0xa0, 0xe6,
+		0xc9, 0x9d, 0x87, 0xa9, 0x40, 0xc1, 0x33, 0x9a, 0xea, 0x59, 0x4e, 0x35, 0xe9, 0xaf, 0x77, 0x72,
+		0x0f, 0x41, 0x84, 0x14, 0x2f, 0xa3, 0xe1, 0x7f, 0x97, 0xc3, 0xa9, 0x7a, 0xee, 0x83, 0x90, 0x10,
+		0x45, 0xcb, 0x68, 0xe8, 0xdf, 0xe3, 0x50, 0x0f, 0x42, 0xe0, 0xa2, 0x60, 0x19, 0x0d, 0xff, 0xb4,
+		0x80, 0x0b, 0x08, 0x81, 0x8f, 0xef, 0xc2, 0xff, 0xf2, 0x7c, 0x84, 0xc1, 0x05, 0x24, 0x47, 0x7e,
+		0x6f, 0xc3, 0x2a, 0x95, 0xd1, 0xe8, 0xcf, 0xf0, 0xce, 0x05, 0x22, 0xf7, 0x30, 0x44, 0xc7, 0x74,
+		0xf8, 0x67, 0x39, 0x94, 0xe9, 0xe7, 0x96, 0x61, 0xc2, 0x57, 0x9d, 0x8c, 0x86, 0xff, 0x03, 0x0e,
+		0xf7, 0xa3, 0x88, 0xe9, 0xbc, 0x3a, 0x19, 0x4d, 0xf0, 0x39, 0x61, 0x3a, 0x47, 0x10, 0xb7, 0x89,
+		0xc2, 0x64, 0x34, 0xfa, 0x05, 0xe1, 0x75, 0x01, 0xc9, 0x3d, 0x0a, 0x49, 0x6f, 0xb2, 0x19, 0x8d,
+		0xff, 0x3c, 0xc7, 0xf7, 0x30, 0xc4, 0x03, 0x5d, 0xf3, 0x16, 0x28, 0xfe, 0xa1, 0xf0, 0x80, 0x0f,
+		0x45, 0x86, 0x51, 0x7f, 0x01, 0x33, 0x9a, 0xe9, 0x1f, 0x89, 0x61, 0xd4, 0x57, 0xbf, 0x90, 0xaf,
+		0x49, 0x73, 0xfe, 0x68, 0x8a, 0x2f, 0x88, 0xaf, 0x49, 0xf5, 0x89, 0x19, 0xfd, 0x15, 0xc1, 0x68,
+		0x8e, 0x2f, 0x0a, 0x33, 0xfa, 0x0a, 0x82, 0xdc, 0x06, 0xa0, 0xc1, 0x6a, 0x60, 0x34, 0xdf, 0x75,
+		0xce, 0x37, 0x33, 0x50, 0x0c, 0xe4, 0x9e, 0x80, 0x43, 0xc3, 0x2b, 0x81, 0xd1, 0xac, 0x5f, 0x7a,
+		0xab, 0x6f, 0xed, 0xe6, 0x2f, 0x04, 0x72, 0x35, 0x98, 0x1b, 0x56, 0x05, 0x8c, 0xa6, 0xfd, 0xf2,
+		0x5b, 0xc1, 0xc4, 0xed, 0x2f, 0x02, 0x72, 0x79, 0x80, 0xde, 0x04, 0x3c, 0x9a, 0xeb, 0x2b, 0x9c,
+		0xcb, 0x07, 0x22, 0x43, 0x83, 0xcf, 0xbf, 0xa3, 0xf1, 0x2f, 0x8a, 0xa1, 0xc1, 0x11, 0x64, 0x68,
+		0x88, 0xa9, 0x77, 0x34, 0xfa, 0xab, 0x62, 0x68, 0x08, 0x08, 0x89, 0x6c, 0xdf, 0xec, 0x36, 0x9a,
+		0xe1, 0x65, 0x11, 0xd9, 0x3e, 0x54, 0xae, 0x0c, 0x33, 0x03, 0x13, 0xe2, 0x68, 0xaa, 0x57, 0x38,
+		0x95, 0xdc, 0x3f, 0x1f, 0xfa, 0x27, 0x2f, 0x3e, 0x19, 0x8e, 0x66, 0xfb, 0x27, 0x7d, 0x93, 0x17,
+		0x9f, 0x0b, 0x73, 0x8f, 0x40, 0xc2, 0xec, 0xb6, 0x5a, 0x64, 0xf0, 0xa0, 0xfd, 0x7f, 0xd4, 0x9c,
+		0x7e, 0xfd, 0x6d, 0xee, 0x1d, 0x01, 0xc8, 0x3d, 0x04, 0x51, 0xdc, 0xde, 0xc2, 0x8d, 0x51, 0xc8,
+		0x9b, 0x6f, 0x8b, 0x84, 0x49, 0xb4, 0x73, 0x8f, 0x02, 0xb0, 0xad, 0x11, 0x7a, 0xe5, 0x7d, 0x04,
+		0xf6, 0x37, 0x6f, 0xf3, 0x1f, 0xfd, 0xf5, 0x20, 0x3d, 0x02, 0xf6, 0x13, 0xc2, 0xfd, 0x09, 0xde,
+		0x08, 0x12, 0xd0, 0x2f, 0x72, 0x0e, 0xe2, 0xe4, 0x40, 0xda, 0xd5, 0x9b, 0xa3, 0xd0, 0xff, 0x8f,
+		0xa3, 0x85, 0x3e, 0x71, 0x58, 0xdb, 0xb2, 0xb1, 0xab, 0x37, 0x9d, 0x51, 0xd8, 0xff, 0xcf, 0xb1,
+		0x1e, 0x80, 0x80, 0xeb, 0xba, 0xe3, 0x8e, 0xf3, 0xde, 0xbf, 0x15, 0x60, 0x01, 0x20, 0x46, 0x93,
+		0xbf, 0x2f, 0xe3, 0xdd, 0x51, 0xd8, 0xdf, 0x09, 0xa3, 0xb9, 0x7e, 0xee, 0x83, 0x90, 0x24, 0x7f,
+		0xb2, 0x9f, 0x06, 0x8f, 0x00, 0xff, 0x19, 0x07, 0xf7, 0x10, 0xa4, 0x67, 0xc7, 0x6d, 0xb8, 0xc6,
+		0x68, 0x67, 0xbf, 0xc9, 0xbf, 0xb4, 0xd0, 0xcf, 0xe5, 0x61, 0xc2, 0x71, 0x1b, 0x8d, 0x2e, 0xaf,
+		0x4f, 0x47, 0xc0, 0xff, 0xfc, 0x6d, 0x6f, 0xcb, 0xc2, 0xc3, 0x90, 0xaf, 0x7d, 0xf5, 0xb2, 0xdb,
+		0xb1, 0xe8, 0x5d, 0xa6, 0x51, 0x0c, 0x6f, 0x71, 0x06, 0x1f, 0x24, 0xb7, 0x0c, 0x93, 0xe4, 0x5d,
+		0xc4, 0x95, 0x90, 0x51, 0x14, 0x7f, 0xc1, 0x1d, 0x10, 0x00, 0x15, 0x3e, 0xfa, 0xe3, 0x5f, 0x1c,
+		0x95, 0x7e, 0xfa, 0x8b, 0xa3, 0xd2, 0xcf, 0x7f, 0x71, 0x54, 0x7a, 0xe1, 0x97, 0x47, 0x0f, 0xfc,
+		0xf4, 0x97, 0x47, 0x0f, 0xfc, 0xcf, 0x5f, 0x1e, 0x3d, 0x30, 0x7c, 0x97, 0x18, 0x56, 0xad, 0x55,
+		0x8b, 0xed, 0x0f, 0x3f, 0x99, 0x6d, 0x1a, 0xee, 0x4e, 0x77, 0x6b, 0xb1, 0x6e, 0xb5, 0xe9, 0x36,
+		0x6e, 0x6f, 0xb7, 0xd6, 0x5b, 0xe4, 0xc0, 0x17, 0x43, 0xb0, 0xd0, 0xbf, 0x97, 0x4b, 0x1c, 0xe8,
+		0xb8, 0x7a, 0xbb, 0xb3, 0xd7, 0xbf, 0x7a, 0xf5, 0x08, 0x24, 0x6b, 0x42, 0x87, 0xfc, 0xcb, 0x53,
+		0x0e, 0xae, 0x5b, 0x66, 0x83, 0x5d, 0x06, 0x0e, 0xab, 0xe2, 0x91, 0x9c, 0x8c, 0x98, 0xba, 0x69,
+		0x39, 0xfc, 0xd7, 0xc9, 0xec, 0xa1, 0xf0, 0xa2, 0x74, 0x6b, 0x6f, 0x94, 0xf2, 0xba, 0xa2, 0xaf,
+		0xb5, 0x21, 0x3d, 0xb9, 0x34, 0x72, 0xd7, 0xfb, 0xb2, 0x69, 0x5d, 0x35, 0x7b, 0xef, 0x11, 0xd8,
+		0xfa, 0x3e, 0xda, 0xbf, 0xf5, 0xfd, 0x04, 0x6e, 0xb5, 0x1e, 0x27, 0x00, 0x72, 0x38, 0xef, 0x6c,
+		0xc5, 0xd8, 0xbf, 0x90, 0x00, 0xbf, 0x49, 0xc2, 0xcc, 0xb5, 0x93, 0x7a, 0xa7, 0xe3, 0xd0, 0xff,
+		0x70, 0x5f, 0xc4, 0xae, 0x2d, 0x92, 0xa7, 0xf9, 0xa1, 0x3b, 0xe4, 0xf3, 0xa3, 0x5c, 0x99, 0xbd,
+		0x11, 0x07, 0x99, 0x76, 0x9c, 0xef, 0x74, 0x5a, 0xfc, 0x9f, 0xe0, 0x40, 0x2d, 0x88, 0xeb, 0x8d,
+		0x86, 0x8d, 0x1d, 0xe6, 0xc1, 0xc9, 0x82, 0x7a, 0xf3, 0xc6, 0x82, 0x10, 0xbd, 0x79, 0x63, 0x21,
+		0xb5, 0xab, 0xb7, 0x5b, 0xb9, 0x2c, 0x17, 0x64, 0xff, 0x70, 0x63, 0xe1, 0xfd, 0xbe, 0x2f, 0xdb,
+		0xb1, 0x2e, 0xbb, 0x0f, 0x98, 0xd8, 0xbd, 0x6a, 0xd9, 0x97, 0x4f, 0x76, 0xac, 0xfa, 0x65, 0xec,
+		0x3e, 0x50, 0xb7, 0x6c, 0xcc, 0x5c, 0xb1, 0x98, 0x67, 0x28, 0x55, 0xf0, 0xa1, 0x02, 0x00, 0xff,
+		0xf7, 0xbe, 0x2e, 0xe3, 0x5d, 0xfa, 0x69, 0x26, 0x0b, 0x77, 0xdd, 0xbc, 0xb1, 0xe0, 0x93, 0xbe,
+		0x79, 0x63, 0x61, 0x86, 0xf5, 0xd9, 0x93, 0x65, 0xd5, 0x24, 0x7b, 0x78, 0x1c, 0xef, 0xa2, 0x53,
+		0x10, 0x7b, 0x4a, 0x37, 0x5a, 0xe2, 0x9a, 0x74, 0xe1, 0xc8, 0xcd, 0x1b, 0x0b, 0x5c, 0xf2, 0xe6,
+		0x8d, 0x85, 0x29, 0x86, 0x65, 0xcf, 0x59, 0x95, 0x37, 0xa0, 0x16, 0xc4, 0x1c, 0x57, 0x77, 0xbb,
+		0xec, 0x04, 0x31, 0x5a, 0xa8, 0x11, 0x10, 0x93, 0xf4, 0x40, 0xec, 0x99, 0xbc, 0xe3, 0x43, 0xe3,
+		0xbf, 0x63, 0xd5, 0xd5, 0x2f, 0xe3, 0x2a, 0x45, 0xaa, 0x9c, 0x91, 0x98, 0x58, 0xdf, 0xd1, 0x0d,
+		0xd3, 0x61, 0x3f, 0xf5, 0x67, 0x26, 0x32, 0x49, 0xaf, 0x37, 0xf6, 0x9c, 0x55, 0x79, 0x03, 0xba,
+		0x06, 0x53, 0x0e, 0xe1, 0x6a, 0x68, 0xae, 0x75, 0x19, 0x9b, 0xec, 0x0a, 0x77, 0xb2, 0x50, 0xfd,
+		0xf1, 0x8d, 0x85, 0x03, 0xff, 0xfb, 0xc6, 0xc2, 0x83, 0xe3, 0x9b, 0x54, 0x30, 0x9a, 0x45, 0xd3,
+		0x25, 0x7d, 0x32, 0xa6, 0x5e, 0x9f, 0xec, 0x39, 0xab, 0x4e, 0xb2, 0x9e, 0x6a, 0xf4, 0x11, 0x3d,
+		0x0d, 0xd0, 0xd6, 0xaf, 0x69, 0x36, 0x6e, 0xe9, 0xbb, 0xec, 0xfe, 0x77, 0xb2, 0xf0, 0x91, 0xbf,
+		0x46, 0xb7, 0x3e, 0xb6, 0xde, 0xd7, 0xec, 0xc9, 0xb2, 0xa4, 0x8c, 0xbe, 0xa6, 0xd2, 0xbf, 0xd1,
+		0xf3, 0x12, 0xdc, 0xd6, 0x35, 0x89, 0x39, 0xfc, 0x9c, 0xb7, 0xd3, 0xc2, 0x74, 0x9f, 0x94, 0x44,
+		0x2f, 0xff, 0x87, 0x47, 0xe6, 0x07, 0x72, 0x97, 0x37, 0x2c, 0x0b, 0xa7, 0x88, 0x9d, 0x37, 0x6f,
+		0x2c, 0xa4, 0x7a, 0x24, 0x04, 0xf9, 0xe6, 0x8d, 0x85, 0x83, 0xac, 0xdf, 0xa0, 0x3c, 0xfb, 0xc2,
+		0xff, 0x5d, 0x90, 0xd4, 0xc3, 0x9e, 0x70, 0xd9, 0xeb, 0x90, 0x50, 0xa2, 0x8b, 0x30, 0xc5, 0x02,
+		0x46, 0xb3, 0xb1, 0xee, 0x58, 0x26, 0xfd, 0x65, 0x73, 0xb2, 0x70, 0xea, 0xe6, 0x8d, 0x85, 0xc3,
+		0x81, 0x86, 0xfb, 0xad, 0xb6, 0xe1, 0xe2, 0x76, 0xc7, 0x25, 0xf1, 0x3a, 0xe7, 0x8f, 0x39, 0xae,
+		0x90, 0x55, 0x27, 0xd9, 0xb3, 0x4a, 0x1f, 0x7d, 0xcc, 0x3b, 0xd8, 0x68, 0xee, 0xb8, 0xf4, 0xb7,
+		0x67, 0xe1, 0x00, 0x33, 0x6b, 0xd8, 0x87, 0x99, 0x29, 0x78, 0xcc, 0x8f, 0xd1, 0xc7, 0x5c, 0xe2,
+		0xb9, 0x97, 0x16, 0x0e, 0xbc, 0xf6, 0xd2, 0x82, 0x94, 0xdd, 0x82, 0xc8, 0x86, 0x65, 0xb5, 0xd0,
+		0x06, 0xf0, 0x0f, 0xcf, 0x7e, 0x6b, 0x5f, 0x38, 0xfb, 0x6e, 0xbf, 0xa5, 0xca, 0x79, 0x72, 0x09,
+		0xc2, 0xff, 0xbb, 0x97, 0x16, 0xa4, 0xc2, 0xda, 0x5e, 0x09, 0xf4, 0xc9, 0xb1, 0xd8, 0x79, 0x52,
+		0x73, 0xfd, 0xd9, 0xee, 0xaf, 0x06, 0x00, 0x2e, 0x98, 0x8a, 0x8e, 0xff, 0x50, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -525,6 +656,12 @@ func (this *ProtoApplication) Equal(that interface{}) bool {
 	if !this.UnstakingCompletionTime.Equal(that1.UnstakingCompletionTime) {
 		return false
 	}
+	if this.JailedReason != that1.JailedReason {
+		return false
+	}
+	if this.JailedHeight != that1.JailedHeight {
+		return false
+	}
 	return true
 }
 func (this *Pool) Equal(that interface{}) bool {
@@ -571,6 +708,18 @@ func (m *ProtoApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailedHeight != 0 {
+		i = encodeVarintApps(dAtA, i, uint64(m.JailedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.JailedReason) > 0 {
+		i -= len(m.JailedReason)
+		copy(dAtA[i:], m.JailedReason)
+		i = encodeVarintApps(dAtA, i, uint64(len(m.JailedReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnstakingCompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnstakingCompletionTime):])
 	if err1 != nil {
 		return 0, err1
@@ -716,6 +865,13 @@ func (m *ProtoApplication) Size() (n int) {
 	n += 1 + l + sovApps(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnstakingCompletionTime)
 	n += 1 + l + sovApps(uint64(l))
+	l = len(m.JailedReason)
+	if l > 0 {
+		n += 1 + l + sovApps(uint64(l))
+	}
+	if m.JailedHeight != 0 {
+		n += 1 + sovApps(uint64(m.JailedHeight))
+	}
 	return n
 }
 
@@ -1005,6 +1161,57 @@ func (m *ProtoApplication) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedHeight", wireType)
+			}
+			m.JailedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApps(dAtA[iNdEx:])
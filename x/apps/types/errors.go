@@ -26,6 +26,9 @@ const (
 	CodeTooManyChains         CodeType          = 118
 	CodeMaxApplications       CodeType          = 119
 	CodeMinimumEditStake      CodeType          = 120
+	CodeInvalidClientPubKey   CodeType          = 121
+	CodeTooManyRevokedClients CodeType          = 122
+	CodeVestingTransfer       CodeType          = 123
 )
 
 func ErrTooManyChains(Codespace sdk.CodespaceType) sdk.Error {
@@ -101,3 +104,23 @@ func ErrInvalidNetworkIdentifier(codespace sdk.CodespaceType, err error) sdk.Err
 func ErrMinimumEditStake(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMinimumEditStake, "application must edit stake with a stake greater than or equal to current stake")
 }
+
+func ErrNoClientPubKeys(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClientPubKey, "at least one client public key must be revoked")
+}
+
+func ErrInvalidClientPubKey(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClientPubKey, "the client public key is not valid: "+err.Error())
+}
+
+func ErrDuplicateClientPubKey(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClientPubKey, "the client public key is duplicated")
+}
+
+func ErrTooManyRevokedClients(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTooManyRevokedClients, fmt.Sprintf("an application may not revoke more than %d client public keys", MaxRevokedClients))
+}
+
+func ErrVestingTransfer(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeVestingTransfer, "an application staked by a vesting account can't be transferred while its coins are vesting")
+}
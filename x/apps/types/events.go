@@ -7,6 +7,14 @@ const (
 	EventTypeStake             = "stake"
 	EventTypeBeginUnstake      = "begin_unstake"
 	EventTypeUnstake           = "unstake"
+	EventTypeRevokeClients     = "revoke_clients"
+	EventTypeJail              = "jail"
+	EventTypeUnjail            = "unjail"
 	AttributeKeyApplication    = "application"
+	AttributeKeyClientPubKeys  = "client_pub_keys"
+	AttributeKeyReason         = "reason"
+	AttributeKeyHeight         = "height"
 	AttributeValueCategory     = ModuleName
+	// jail reasons
+	AttributeValueBelowMinimumStake = "below_minimum_stake"
 )
@@ -35,6 +35,10 @@ type AuthKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string, recipientAddr sdk.Address, amt sdk.Coins) sdk.Error
 	// send coins from validator to module
 	SendCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address, recipientModule string, amt sdk.Coins) sdk.Error
+	// stake coins from account to module, including the locked coins of a vesting account
+	DelegateCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address, recipientModule string, amt sdk.Coins) sdk.Error
+	// unstake coins from module to account
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string, recipientAddr sdk.Address, amt sdk.Coins) sdk.Error
 	// mint coins
 	MintCoins(ctx sdk.Ctx, moduleName string, amt sdk.Coins) sdk.Error
 	// burn coins
@@ -49,6 +53,8 @@ type AuthKeeper interface {
 	HasCoins(ctx sdk.Ctx, addr sdk.Address, amt sdk.Coins) bool
 	// send coins
 	SendCoins(ctx sdk.Ctx, fromAddr sdk.Address, toAddr sdk.Address, amt sdk.Coins) sdk.Error
+	// get account
+	GetAccount(ctx sdk.Ctx, addr sdk.Address) authexported.Account
 }
 
 // ApplicationSet expected properties for the set of all applications (noalias)
@@ -62,7 +68,7 @@ type ApplicationSet interface {
 	// total staked tokens within the application set
 	TotalTokens(sdk.Ctx) sdk.BigInt
 	// jail a application
-	JailApplication(sdk.Ctx, sdk.Address)
+	JailApplication(sdk.Ctx, sdk.Address, string)
 	// unjail a application
 	UnjailApplication(sdk.Ctx, sdk.Address)
 	// MaxApplications returns the maximum amount of staked applications
@@ -4,6 +4,7 @@ const (
 	StakeFee   = 10000
 	UnstakeFee = 10000
 	UnjailFee  = 10000
+	RevokeFee  = 10000
 )
 
 var (
@@ -11,5 +12,6 @@ var (
 		MsgAppStakeName:   StakeFee,
 		MsgAppUnstakeName: UnstakeFee,
 		MsgAppUnjailName:  UnjailFee,
+		MsgAppRevokeName:  RevokeFee,
 	}
 )
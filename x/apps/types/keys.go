@@ -19,6 +19,8 @@ var (
 	StakedAppsKey      = []byte{0x02} // prefix for each key to a staked application index, sorted by power
 	UnstakingAppsKey   = []byte{0x03} // prefix for unstaking application
 	BurnApplicationKey = []byte{0x04} // prefix for awarding applications
+	RevokedClientsKey  = []byte{0x05} // prefix for the revoked client public keys of applications
+	MinimumStakeKey    = []byte{0x06} // key for the minimum stake the staked applications were last checked against
 )
 
 // Removes the prefix bytes from a key to expose true address
@@ -47,6 +49,16 @@ func KeyForAppBurn(address sdk.Address) []byte {
 	return append(BurnApplicationKey, address...)
 }
 
+// generates the key prefix for the revoked client public keys of the application
+func KeyForRevokedClients(address sdk.Address) []byte {
+	return append(RevokedClientsKey, address.Bytes()...)
+}
+
+// generates the key for a revoked client public key of the application
+func KeyForRevokedClient(address sdk.Address, clientPubKey []byte) []byte {
+	return append(KeyForRevokedClients(address), clientPubKey...)
+}
+
 // get the power ranking key of a application
 // NOTE the larger values are of higher value
 func getStakedValPowerRankKey(application Application) []byte {
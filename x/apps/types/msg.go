@@ -14,12 +14,14 @@ var (
 	_ codec.ProtoMarshaler = &MsgStake{}
 	_ sdk.ProtoMsg         = &MsgBeginUnstake{}
 	_ sdk.ProtoMsg         = &MsgUnjail{}
+	_ sdk.ProtoMsg         = &MsgRevokeClients{}
 )
 
 const (
 	MsgAppStakeName   = "app_stake"
 	MsgAppUnstakeName = "app_begin_unstake"
 	MsgAppUnjailName  = "app_unjail"
+	MsgAppRevokeName  = "app_revoke_clients"
 )
 
 type MsgStake struct {
@@ -235,3 +237,55 @@ func (msg MsgUnjail) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// ----------------------------------------------------------------------------------------------------------------------
+// Route provides router key for msg
+func (msg MsgRevokeClients) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRevokeClients) Type() string { return MsgAppRevokeName }
+
+// GetFee get fee for msg
+func (msg MsgRevokeClients) GetFee() sdk.BigInt {
+	return sdk.NewInt(AppFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRevokeClients) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.AppAddr}
+}
+
+func (msg MsgRevokeClients) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeClients) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for revoking client public keys of an application
+func (msg MsgRevokeClients) ValidateBasic() sdk.Error {
+	if msg.AppAddr.Empty() {
+		return ErrBadApplicationAddr(DefaultCodespace)
+	}
+	if len(msg.ClientPubKeys) == 0 {
+		return ErrNoClientPubKeys(DefaultCodespace)
+	}
+	if len(msg.ClientPubKeys) > MaxRevokedClients {
+		return ErrTooManyRevokedClients(DefaultCodespace)
+	}
+	seen := make(map[string]struct{}, len(msg.ClientPubKeys))
+	for _, clientPubKey := range msg.ClientPubKeys {
+		pk, err := crypto.NewPublicKey(clientPubKey)
+		if err != nil {
+			return ErrInvalidClientPubKey(DefaultCodespace, err)
+		}
+		if _, ok := seen[pk.RawString()]; ok {
+			return ErrDuplicateClientPubKey(DefaultCodespace)
+		}
+		seen[pk.RawString()] = struct{}{}
+	}
+	return nil
+}
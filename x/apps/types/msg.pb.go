@@ -149,42 +149,89 @@ var xxx_messageInfo_MsgUnjail proto.InternalMessageInfo
 func (*MsgUnjail) XXX_MessageName() string {
 	return "x.apps.MsgUnjail"
 }
+
+type MsgRevokeClients struct {
+	AppAddr       github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=AppAddr,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address" yaml:"address"`
+	ClientPubKeys []string                                          `protobuf:"bytes,2,rep,name=ClientPubKeys,proto3" json:"client_pub_keys" yaml:"client_pub_keys"`
+}
+
+func (m *MsgRevokeClients) Reset()         { *m = MsgRevokeClients{} }
+func (m *MsgRevokeClients) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeClients) ProtoMessage()    {}
+func (*MsgRevokeClients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd58e5eb64f87460, []int{3}
+}
+func (m *MsgRevokeClients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeClients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeClients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeClients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeClients.Merge(m, src)
+}
+func (m *MsgRevokeClients) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeClients) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeClients.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeClients proto.InternalMessageInfo
+
+func (*MsgRevokeClients) XXX_MessageName() string {
+	return "x.apps.MsgRevokeClients"
+}
 func init() {
 	proto.RegisterType((*MsgProtoStake)(nil), "x.apps.MsgProtoStake")
 	proto.RegisterType((*MsgBeginUnstake)(nil), "x.apps.MsgBeginUnstake")
 	proto.RegisterType((*MsgUnjail)(nil), "x.apps.MsgUnjail")
+	proto.RegisterType((*MsgRevokeClients)(nil), "x.apps.MsgRevokeClients")
 }
 
 func init() { proto.RegisterFile("x/apps/msg.proto", fileDescriptor_fd58e5eb64f87460) }
 
 var fileDescriptor_fd58e5eb64f87460 = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x31, 0x8f, 0xd3, 0x30,
-	0x18, 0x8d, 0x39, 0x91, 0xa8, 0xd6, 0x1d, 0x9c, 0x02, 0x43, 0x75, 0x48, 0x71, 0x95, 0xa9, 0xcb,
-	0x25, 0xa0, 0x63, 0xba, 0xed, 0xb2, 0x01, 0xaa, 0x04, 0x41, 0xb7, 0xb0, 0x9c, 0x9c, 0x9c, 0xe5,
-	0xa6, 0x49, 0x63, 0x2b, 0x76, 0xa0, 0xd9, 0x19, 0x2a, 0xb1, 0x30, 0x32, 0x56, 0x8c, 0xfc, 0x92,
-	0x8e, 0x1d, 0x11, 0x83, 0x85, 0xda, 0x05, 0x65, 0xac, 0xc4, 0xc2, 0x84, 0x12, 0x07, 0x75, 0xa0,
-	0x43, 0xa5, 0xdb, 0xfc, 0xde, 0xf3, 0xe7, 0xf7, 0x9e, 0xfc, 0xc1, 0xd3, 0x99, 0x8f, 0x39, 0x17,
-	0xfe, 0x54, 0x50, 0x8f, 0x17, 0x4c, 0x32, 0xdb, 0x9c, 0x79, 0x0d, 0x73, 0xf6, 0x98, 0x32, 0xca,
-	0x5a, 0xca, 0x6f, 0x4e, 0x5a, 0x75, 0x7f, 0x03, 0x78, 0x32, 0x12, 0xf4, 0x75, 0x03, 0xde, 0x4a,
-	0x9c, 0x12, 0xfb, 0x39, 0xb4, 0x78, 0x19, 0xdd, 0xa4, 0xa4, 0xea, 0x83, 0x01, 0x18, 0x1e, 0x07,
-	0x4f, 0x6a, 0x85, 0x4c, 0x5e, 0x46, 0x29, 0xa9, 0xb6, 0x0a, 0x9d, 0x54, 0x78, 0x9a, 0x5d, 0xba,
-	0x1a, 0xbb, 0x61, 0x23, 0xbc, 0x22, 0x95, 0x7d, 0x01, 0xcd, 0x78, 0x8c, 0x93, 0x5c, 0xf4, 0xef,
-	0x0d, 0x8e, 0x86, 0x3d, 0x3d, 0xa4, 0x99, 0xdd, 0x90, 0xc6, 0x6e, 0xd8, 0x09, 0x36, 0x85, 0xf7,
-	0xdf, 0xe3, 0xac, 0x24, 0xfd, 0xa3, 0x01, 0x18, 0xf6, 0x82, 0x37, 0x4b, 0x85, 0x8c, 0x1f, 0x0a,
-	0x3d, 0xa5, 0x89, 0x1c, 0x97, 0x91, 0x17, 0xb3, 0xa9, 0xcf, 0x59, 0x2a, 0xcf, 0x73, 0x22, 0x3f,
-	0xb0, 0x22, 0xf5, 0x39, 0x8b, 0x53, 0x22, 0xcf, 0x63, 0x56, 0x10, 0x5f, 0x56, 0x9c, 0x08, 0x2f,
-	0x48, 0xe8, 0x8b, 0x5c, 0xd6, 0x0a, 0xe9, 0x87, 0xb6, 0x0a, 0x1d, 0x6b, 0xab, 0x16, 0xba, 0xa1,
-	0xa6, 0x2f, 0x4f, 0xe7, 0x0b, 0x64, 0x7c, 0x59, 0x20, 0xf0, 0x6b, 0x81, 0xc0, 0xfc, 0x2b, 0x02,
-	0xee, 0x37, 0x00, 0x1f, 0x8e, 0x04, 0x0d, 0x08, 0x4d, 0xf2, 0xeb, 0x5c, 0xb4, 0xcd, 0x3f, 0x02,
-	0x68, 0x5d, 0xdd, 0xde, 0x16, 0x44, 0x88, 0xae, 0xfa, 0xa4, 0x56, 0xe8, 0x11, 0xe6, 0x3c, 0x4b,
-	0x62, 0x2c, 0x13, 0x96, 0xdf, 0x60, 0x2d, 0x6f, 0x15, 0x3a, 0xd3, 0x3e, 0x7b, 0x44, 0xf7, 0x8f,
-	0x42, 0xcf, 0x0e, 0xaf, 0xd0, 0x39, 0x86, 0xff, 0xac, 0xf7, 0x84, 0xfd, 0x04, 0x60, 0x6f, 0x24,
-	0xe8, 0x75, 0x3e, 0xc1, 0x49, 0x66, 0x67, 0xd0, 0xba, 0xe2, 0xbc, 0xb9, 0xdd, 0xa5, 0x0c, 0x6b,
-	0x85, 0xac, 0x5d, 0xb2, 0x07, 0x5d, 0xb2, 0x3b, 0xa6, 0xd1, 0x16, 0xff, 0xa7, 0x09, 0x5e, 0x2e,
-	0xd7, 0x0e, 0x58, 0xad, 0x1d, 0xf0, 0x73, 0xed, 0x80, 0xcf, 0x1b, 0xc7, 0x58, 0x6d, 0x1c, 0xe3,
-	0xfb, 0xc6, 0x31, 0xde, 0x1d, 0xf4, 0x71, 0xdd, 0x82, 0xb6, 0x76, 0x91, 0xd9, 0x6e, 0xe1, 0xc5,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x7e, 0x3d, 0x78, 0xb7, 0x02, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xb1, 0x6a, 0xdb, 0x40,
+	0x00, 0xd5, 0x35, 0xd4, 0xc6, 0x47, 0xdc, 0x18, 0xb5, 0x14, 0x93, 0x82, 0xce, 0x68, 0xf2, 0x62,
+	0xab, 0x25, 0x9d, 0xb2, 0x45, 0x9d, 0xda, 0x62, 0x48, 0x15, 0xb2, 0x74, 0x31, 0x67, 0xe5, 0xb8,
+	0x28, 0x92, 0xef, 0x0e, 0xdf, 0x29, 0x8d, 0xf6, 0x0e, 0x81, 0x2e, 0x1d, 0x3b, 0x9a, 0x8e, 0xfd,
+	0x92, 0x8c, 0x19, 0x4b, 0x87, 0xa3, 0xd8, 0x43, 0x8b, 0x46, 0x43, 0x97, 0x4e, 0x45, 0xba, 0x0b,
+	0x21, 0xd4, 0x83, 0xa1, 0xd0, 0x4d, 0xef, 0x3d, 0xdd, 0xbd, 0xf7, 0xe0, 0x1d, 0xec, 0x5c, 0x04,
+	0x58, 0x08, 0x19, 0x4c, 0x25, 0x1d, 0x8a, 0x19, 0x57, 0xdc, 0x6d, 0x5c, 0x0c, 0x2b, 0x66, 0xf7,
+	0x11, 0xe5, 0x94, 0xd7, 0x54, 0x50, 0x7d, 0x19, 0xd5, 0xff, 0x05, 0x60, 0x7b, 0x24, 0xe9, 0x61,
+	0x05, 0x8e, 0x14, 0x4e, 0x89, 0xfb, 0x1c, 0x36, 0x45, 0x3e, 0x19, 0xa7, 0xa4, 0xe8, 0x82, 0x1e,
+	0xe8, 0x6f, 0x87, 0x4f, 0x4a, 0x8d, 0x1a, 0x22, 0x9f, 0xa4, 0xa4, 0x58, 0x69, 0xd4, 0x2e, 0xf0,
+	0x34, 0xdb, 0xf7, 0x0d, 0xf6, 0xa3, 0x4a, 0x78, 0x4d, 0x0a, 0x77, 0x0f, 0x36, 0xe2, 0x53, 0x9c,
+	0x30, 0xd9, 0xbd, 0xd7, 0xdb, 0xea, 0xb7, 0xcc, 0x21, 0xc3, 0xdc, 0x1e, 0x32, 0xd8, 0x8f, 0xac,
+	0xe0, 0x52, 0x78, 0xff, 0x1c, 0x67, 0x39, 0xe9, 0x6e, 0xf5, 0x40, 0xbf, 0x15, 0xbe, 0xb9, 0xd2,
+	0xc8, 0xf9, 0xa6, 0xd1, 0x53, 0x9a, 0xa8, 0xd3, 0x7c, 0x32, 0x8c, 0xf9, 0x34, 0x10, 0x3c, 0x55,
+	0x03, 0x46, 0xd4, 0x3b, 0x3e, 0x4b, 0x03, 0xc1, 0xe3, 0x94, 0xa8, 0x41, 0xcc, 0x67, 0x24, 0x50,
+	0x85, 0x20, 0x72, 0x18, 0x26, 0xf4, 0x25, 0x53, 0xa5, 0x46, 0xe6, 0xa2, 0x95, 0x46, 0xdb, 0xc6,
+	0xaa, 0x86, 0x7e, 0x64, 0xe8, 0xfd, 0xce, 0xe5, 0x1c, 0x39, 0x9f, 0xe6, 0x08, 0xfc, 0x9c, 0x23,
+	0x70, 0xf9, 0x19, 0x01, 0xff, 0x0b, 0x80, 0x3b, 0x23, 0x49, 0x43, 0x42, 0x13, 0x76, 0xcc, 0x64,
+	0xdd, 0xfc, 0x3d, 0x80, 0xcd, 0x83, 0x93, 0x93, 0x19, 0x91, 0xd2, 0x56, 0x3f, 0x2b, 0x35, 0x7a,
+	0x88, 0x85, 0xc8, 0x92, 0x18, 0xab, 0x84, 0xb3, 0x31, 0x36, 0xf2, 0x4a, 0xa3, 0x5d, 0xe3, 0xb3,
+	0x46, 0xf4, 0x7f, 0x6b, 0xf4, 0x6c, 0xf3, 0x0a, 0xd6, 0x31, 0xba, 0xb1, 0x5e, 0x13, 0xf6, 0x03,
+	0x80, 0xad, 0x91, 0xa4, 0xc7, 0xec, 0x0c, 0x27, 0x99, 0x9b, 0xc1, 0xe6, 0x81, 0x10, 0xd5, 0xdf,
+	0x36, 0x65, 0x54, 0x6a, 0xd4, 0xbc, 0x4d, 0xf6, 0xc0, 0x26, 0xfb, 0xc7, 0x34, 0xc6, 0x62, 0x4d,
+	0x9a, 0x1f, 0x00, 0x76, 0x46, 0x92, 0x46, 0xe4, 0x9c, 0xa7, 0xe4, 0x45, 0x96, 0x10, 0xa6, 0xe4,
+	0xff, 0x0d, 0xe5, 0x1e, 0xc1, 0xb6, 0x31, 0x3e, 0xac, 0xd7, 0x77, 0x33, 0xba, 0x41, 0xa9, 0xd1,
+	0x4e, 0x5c, 0x0b, 0x63, 0xbb, 0xe1, 0xca, 0xfb, 0xb1, 0x5d, 0xdf, 0x5d, 0xc1, 0x8f, 0xee, 0xde,
+	0xf1, 0x77, 0xd3, 0xf0, 0xd5, 0xd5, 0xc2, 0x03, 0xd7, 0x0b, 0x0f, 0x7c, 0x5f, 0x78, 0xe0, 0xe3,
+	0xd2, 0x73, 0xae, 0x97, 0x9e, 0xf3, 0x75, 0xe9, 0x39, 0x6f, 0x37, 0x9a, 0xa8, 0x7d, 0x8a, 0x75,
+	0x87, 0x49, 0xa3, 0x7e, 0x6f, 0x7b, 0x7f, 0x06, 0x00, 0xf7, 0x19, 0xa7, 0x87, 0xa1, 0x03, 0x00,
+	0x00,
 }
 
 func (this *MsgProtoStake) Equal(that interface{}) bool {
@@ -270,6 +317,38 @@ func (this *MsgUnjail) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgRevokeClients) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevokeClients)
+	if !ok {
+		that2, ok := that.(MsgRevokeClients)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.AppAddr, that1.AppAddr) {
+		return false
+	}
+	if len(this.ClientPubKeys) != len(that1.ClientPubKeys) {
+		return false
+	}
+	for i := range this.ClientPubKeys {
+		if this.ClientPubKeys[i] != that1.ClientPubKeys[i] {
+			return false
+		}
+	}
+	return true
+}
 func (m *MsgProtoStake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -379,6 +458,45 @@ func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevokeClients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeClients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeClients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientPubKeys) > 0 {
+		for iNdEx := len(m.ClientPubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientPubKeys[iNdEx])
+			copy(dAtA[i:], m.ClientPubKeys[iNdEx])
+			i = encodeVarintMsg(dAtA, i, uint64(len(m.ClientPubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AppAddr) > 0 {
+		i -= len(m.AppAddr)
+		copy(dAtA[i:], m.AppAddr)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.AppAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsg(v)
 	base := offset
@@ -437,6 +555,25 @@ func (m *MsgUnjail) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevokeClients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppAddr)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	if len(m.ClientPubKeys) > 0 {
+		for _, s := range m.ClientPubKeys {
+			l = len(s)
+			n += 1 + l + sovMsg(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMsg(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -770,6 +907,125 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeClients) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeClients: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeClients: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppAddr = append(m.AppAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppAddr == nil {
+				m.AppAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPubKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPubKeys = append(m.ClientPubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsg(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
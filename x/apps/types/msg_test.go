@@ -435,3 +435,64 @@ func TestMsgAppUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRevokeClients_ValidateBasic(t *testing.T) {
+	addr := sdk.Address(pk.Address())
+	var clientPk crypto.Ed25519PublicKey
+	_, _ = rand.Read(clientPk[:])
+	tooMany := make([]string, MaxRevokedClients+1)
+	for i := range tooMany {
+		var p crypto.Ed25519PublicKey
+		_, _ = rand.Read(p[:])
+		tooMany[i] = p.RawString()
+	}
+	tests := []struct {
+		name string
+		msg  MsgRevokeClients
+		want sdk.Error
+	}{
+		{
+			name: "errs if no Address",
+			msg:  MsgRevokeClients{ClientPubKeys: []string{clientPk.RawString()}},
+			want: ErrBadApplicationAddr(DefaultCodespace),
+		},
+		{
+			name: "errs if no client public keys",
+			msg:  MsgRevokeClients{AppAddr: addr},
+			want: ErrNoClientPubKeys(DefaultCodespace),
+		},
+		{
+			name: "errs if invalid client public key",
+			msg:  MsgRevokeClients{AppAddr: addr, ClientPubKeys: []string{"abcd"}},
+			want: ErrInvalidClientPubKey(DefaultCodespace, fmt.Errorf("")),
+		},
+		{
+			name: "errs if duplicate client public key",
+			msg:  MsgRevokeClients{AppAddr: addr, ClientPubKeys: []string{clientPk.RawString(), clientPk.RawString()}},
+			want: ErrDuplicateClientPubKey(DefaultCodespace),
+		},
+		{
+			name: "errs if too many client public keys",
+			msg:  MsgRevokeClients{AppAddr: addr, ClientPubKeys: tooMany},
+			want: ErrTooManyRevokedClients(DefaultCodespace),
+		},
+		{
+			name: "returns nil if valid",
+			msg:  MsgRevokeClients{AppAddr: addr, ClientPubKeys: []string{clientPk.RawString()}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.msg.ValidateBasic()
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("ValidateBasic() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil || got.Code() != tt.want.Code() {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
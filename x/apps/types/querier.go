@@ -39,10 +39,23 @@ type QueryApplicationsWithOpts struct {
 	Page          int             `json:"page"`
 	Limit         int             `json:"per_page"`
 	StakingStatus sdk.StakeStatus `json:"staking_status"`
+	JailedStatus  int             `json:"jailed_status"`
 	Blockchain    string          `json:"blockchain"`
 }
 
 func (opts QueryApplicationsWithOpts) IsValid(app Application) bool {
+	if opts.JailedStatus != 0 {
+		switch opts.JailedStatus {
+		case 1: // 1 is jailed
+			if !app.Jailed {
+				return false
+			}
+		case 2: // 2 is unjailed
+			if app.Jailed {
+				return false
+			}
+		}
+	}
 	if opts.StakingStatus != 0 {
 		if opts.StakingStatus != app.Status {
 			return false
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// the maximum number of client public keys an application may revoke
+const MaxRevokedClients = 100
+
+// RevokedClient - A client public key that may no longer be used in the AATs of the application
+type RevokedClient struct {
+	Address      sdk.Address `json:"address" yaml:"address"`
+	ClientPubKey string      `json:"client_pub_key" yaml:"client_pub_key"`
+	Height       int64       `json:"height" yaml:"height"`
+}
+
+func (r RevokedClient) String() string {
+	return fmt.Sprintf("Address: %s\nClient Public Key: %s\nHeight: %d\n", r.Address, r.ClientPubKey, r.Height)
+}
@@ -28,3 +28,28 @@ func ValidateNetworkIdentifier(chain string) sdk.Error {
 	}
 	return nil
 }
+
+// EditChains - Removes the remove chains from chains, then appends the add chains not already present
+func EditChains(chains, add, remove []string) []string {
+	removed := make(map[string]bool, len(remove))
+	for _, chain := range remove {
+		removed[chain] = true
+	}
+	present := make(map[string]bool, len(chains)+len(add))
+	result := make([]string, 0, len(chains)+len(add))
+	for _, chain := range chains {
+		if removed[chain] || present[chain] {
+			continue
+		}
+		present[chain] = true
+		result = append(result, chain)
+	}
+	for _, chain := range add {
+		if present[chain] {
+			continue
+		}
+		present[chain] = true
+		result = append(result, chain)
+	}
+	return result
+}
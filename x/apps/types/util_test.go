@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditChains(t *testing.T) {
+	tests := []struct {
+		name   string
+		chains []string
+		add    []string
+		remove []string
+		want   []string
+	}{
+		{"no changes", []string{"0001", "0002"}, nil, nil, []string{"0001", "0002"}},
+		{"adds chains", []string{"0001"}, []string{"0002", "0001", "0003"}, nil, []string{"0001", "0002", "0003"}},
+		{"removes chains", []string{"0001", "0002", "0003"}, nil, []string{"0002", "0004"}, []string{"0001", "0003"}},
+		{"adds and removes chains", []string{"0001", "0002"}, []string{"0003"}, []string{"0001"}, []string{"0002", "0003"}},
+		{"removes every chain", []string{"0001"}, nil, []string{"0001"}, []string{}},
+		{"adds a removed chain back", []string{"0001", "0002"}, []string{"0001"}, []string{"0001"}, []string{"0002", "0001"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EditChains(tt.chains, tt.add, tt.remove))
+		})
+	}
+}
@@ -16,6 +16,7 @@ const (
 	StoreKey          = types.StoreKey
 	FeeCollectorName  = types.FeeCollectorName
 	QuerierRoute      = types.QuerierRoute
+	RouterKey         = types.RouterKey
 	DefaultParamspace = types.DefaultCodespace
 	QueryAccount      = types.QueryAccount
 	Burner            = types.Burner
@@ -24,29 +25,37 @@ const (
 )
 
 var (
-	NewKeeper                 = keeper.NewKeeper
-	NewModuleAddress          = types.NewModuleAddress
-	NewBaseAccountWithAddress = types.NewBaseAccountWithAddress
-	RegisterCodec             = types.RegisterCodec
-	CountSubKeys              = types.CountSubKeys
-	StdSignBytes              = types.StdSignBytes
-	DefaultTxDecoder          = types.DefaultTxDecoder
-	DefaultTxEncoder          = types.DefaultTxEncoder
-	NewTxBuilder              = types.NewTxBuilder
-	ModuleCdc                 = types.ModuleCdc
+	NewKeeper                   = keeper.NewKeeper
+	NewModuleAddress            = types.NewModuleAddress
+	NewBaseAccountWithAddress   = types.NewBaseAccountWithAddress
+	NewContinuousVestingAccount = types.NewContinuousVestingAccount
+	NewDelayedVestingAccount    = types.NewDelayedVestingAccount
+	RegisterCodec               = types.RegisterCodec
+	CountSubKeys                = types.CountSubKeys
+	StdSignBytes                = types.StdSignBytes
+	StdSignBytesMulti           = types.StdSignBytesMulti
+	StdSignBytesWithFeePayer    = types.StdSignBytesWithFeePayer
+	DefaultTxDecoder            = types.DefaultTxDecoder
+	DefaultTxEncoder            = types.DefaultTxEncoder
+	NewTxBuilder                = types.NewTxBuilder
+	ModuleCdc                   = types.ModuleCdc
 )
 
 // Type exported types
 type (
-	GenesisState       = types.GenesisState
-	Keeper             = keeper.Keeper
-	Account            = exported.Account
-	BaseAccount        = types.BaseAccount
-	Params             = types.Params
-	QueryAccountParams = types.QueryAccountParams
-	ProtoStdTx         = types.ProtoStdTx
-	StdTx              = types.StdTx
-	StdSignDoc         = types.StdSignDoc
-	StdSignature       = types.ProtoStdSignature
-	TxBuilder          = types.TxBuilder
+	GenesisState             = types.GenesisState
+	Keeper                   = keeper.Keeper
+	Account                  = exported.Account
+	BaseAccount              = types.BaseAccount
+	VestingAccount           = exported.VestingAccount
+	ContinuousVestingAccount = types.ContinuousVestingAccount
+	DelayedVestingAccount    = types.DelayedVestingAccount
+	MsgCreateVestingAccount  = types.MsgCreateVestingAccount
+	Params                   = types.Params
+	QueryAccountParams       = types.QueryAccountParams
+	ProtoStdTx               = types.ProtoStdTx
+	StdTx                    = types.StdTx
+	StdSignDoc               = types.StdSignDoc
+	StdSignature             = types.ProtoStdSignature
+	TxBuilder                = types.TxBuilder
 )
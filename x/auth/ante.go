@@ -15,7 +15,8 @@ import (
 	tmTypes "github.com/tendermint/tendermint/types"
 )
 
-// NewAnteHandler returns an AnteHandler that checks signatures and deducts fees from the first signer.
+// NewAnteHandler returns an AnteHandler that checks signatures and deducts fees from the first signer, or from the
+// fee payer of the transaction when it has one.
 func NewAnteHandler(ak keeper.Keeper) sdk.AnteHandler {
 	return func(ctx sdk.Ctx, tx sdk.Tx, txBz []byte, txIndexer txindex.TxIndexer, simulate bool) (newCtx sdk.Ctx, res sdk.Result, signer posCrypto.PublicKey, abort bool) {
 		if addr := ak.GetModuleAddress(types.FeeCollectorName); addr == nil {
@@ -30,10 +31,20 @@ func NewAnteHandler(ak keeper.Keeper) sdk.AnteHandler {
 		if !ok {
 			return newCtx, sdk.ErrInternal("all transactions must be convertible to inteface: ProtoStdTx").Result(), nil, true
 		}
-		signer, err := ValidateTransaction(ctx, ak, stdTx, ak.GetParams(ctx), txIndexer, txBz, simulate)
+		var err sdk.Error
+		if stdTx.IsMultiMsg() {
+			signer, err = ValidateMultiMsgTransaction(ctx, ak, stdTx, ak.GetParams(ctx), txIndexer, txBz, simulate)
+		} else {
+			signer, err = ValidateTransaction(ctx, ak, stdTx, ak.GetParams(ctx), txIndexer, txBz, simulate)
+		}
 		if err != nil {
 			return newCtx, err.Result(), signer, true
 		}
+		if stdTx.FeePayer != nil {
+			if err = ValidateFeePayer(ctx, ak, stdTx, ak.GetParams(ctx), simulate); err != nil {
+				return newCtx, err.Result(), signer, true
+			}
+		}
 		err = DeductFees(ak, ctx, stdTx, signer)
 		if err != nil {
 			return newCtx, err.Result(), signer, true
@@ -48,19 +59,8 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 		return nil, types.ErrInvalidMemo(ModuleName, err)
 	}
 	// check for duplicate transaction to prevent replay attacks
-	txHash := tmTypes.Tx(txBz).Hash()
-	// make http call to tendermint to check txIndexer
-	if txIndexer == nil {
-		ctx.Logger().Error(types.ErrNilTxIndexer(ModuleName).Error())
-		return nil, types.ErrNilTxIndexer(ModuleName)
-	}
-	res, err := (txIndexer).Get(txHash)
-	if err != nil {
-		ctx.Logger().Error(err.Error())
-		return nil, sdk.ErrInternal(err.Error())
-	}
-	if res != nil {
-		return nil, types.ErrDuplicateTx(ModuleName, hex.EncodeToString(txHash))
+	if err := ValidateNotDuplicate(ctx, txIndexer, txBz); err != nil {
+		return nil, err
 	}
 
 	// Please note that GetSigners() is simply redirected to Msg.GetSigners()
@@ -138,6 +138,10 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 		if !ok {
 			return nil, types.ErrTooManySignatures(ModuleName, params.TxSigLimit)
 		}
+		// threshold multisig keys are only accepted after their upgrade
+		if hasThresholdKey(p) && !k.Cdc.IsAfterThresholdMultiSigUpgrade(ctx.BlockHeight()) {
+			return nil, sdk.ErrInvalidPubKey("threshold multisig public keys are not enabled")
+		}
 		// validate the multi sig
 		if !simulate && !pk.VerifyBytes(signBytes, stdTx.GetSignature().GetSignature()) {
 			continue
@@ -147,6 +151,148 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 	return nil, sdk.ErrUnauthorized("signature verification failed for the transaction")
 }
 
+// ValidateMultiMsgTransaction validates a multi message transaction and returns its fee payer, the first signer.
+// Every message must be signed by one of its signers, the signatures following the order of the messages.
+// NOTE: the signers of a message are only the ones of Msg.GetSigners(), which for a MsgStake include the output address
+// of the message; unlike single message transactions, the current output address of a node whose MsgStake changes it
+// and the current app of an app transfer AppMsgStake can't sign a multi message transaction.
+func ValidateMultiMsgTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params, txIndexer txindex.TxIndexer, txBz []byte, simulate bool) (signer posCrypto.PublicKey, sdkErr sdk.Error) {
+	if !k.Cdc.IsAfterMultiMsgTxUpgrade(ctx.BlockHeight()) {
+		return nil, types.ErrMultiMsgTxDisabled(ModuleName)
+	}
+	// validate the memo
+	if err := ValidateMemo(stdTx, params); err != nil {
+		return nil, types.ErrInvalidMemo(ModuleName, err)
+	}
+	// check for duplicate transaction to prevent replay attacks
+	if err := ValidateNotDuplicate(ctx, txIndexer, txBz); err != nil {
+		return nil, err
+	}
+	msgs := stdTx.GetMsgs()
+	if uint64(len(msgs)) > params.MaxMsgsPerTx {
+		return nil, types.ErrTooManyMsgs(ModuleName, params.MaxMsgsPerTx)
+	}
+	sigs := stdTx.Signatures
+	if err := ValidateMultiMsgSigners(msgs, sigs); err != nil {
+		return nil, err
+	}
+	// validate the total number of signatures
+	var sigCount uint64
+	for _, sig := range sigs {
+		p, ok := sig.PublicKey.(posCrypto.PublicKeyMultiSig)
+		if !ok {
+			sigCount++
+			continue
+		}
+		// threshold multisig keys are only accepted after their upgrade
+		if hasThresholdKey(p) && !k.Cdc.IsAfterThresholdMultiSigUpgrade(ctx.BlockHeight()) {
+			return nil, sdk.ErrInvalidPubKey("threshold multisig public keys are not enabled")
+		}
+		count, _ := recSignDepth(1, params.TxSigLimit, p)
+		sigCount += count
+	}
+	if sigCount > params.TxSigLimit {
+		return nil, types.ErrTooManySignatures(ModuleName, params.TxSigLimit)
+	}
+	// validate the fees, the sum of the fee of every message
+	expectedFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, params.FeeMultiplier.GetFees(msgs)))
+	if !stdTx.GetFee().IsAllGTE(expectedFee) {
+		return nil, types.ErrInsufficientFee(ModuleName, expectedFee, stdTx.GetFee())
+	}
+	// validate the signatures, all of the same sign bytes
+	if !simulate {
+		signBytes, err := GetSignBytes(ctx.ChainID(), stdTx)
+		if err != nil {
+			return nil, sdk.ErrInternal(err.Error())
+		}
+		for _, sig := range sigs {
+			if !sig.PublicKey.VerifyBytes(signBytes, sig.Signature) {
+				return nil, sdk.ErrUnauthorized("signature verification failed for the transaction")
+			}
+		}
+	}
+	return sigs[0].PublicKey, nil
+}
+
+// ValidateFeePayer validates the signature of the fee payer of a transaction, over the same sign bytes as its signer.
+func ValidateFeePayer(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params, simulate bool) sdk.Error {
+	if !k.Cdc.IsAfterFeePayerUpgrade(ctx.BlockHeight()) {
+		return types.ErrFeePayerDisabled(ModuleName)
+	}
+	pk := stdTx.FeePayer.PublicKey
+	if p, ok := pk.(posCrypto.PublicKeyMultiSig); ok {
+		// validate the signature depth
+		if !ValidateSignatureDepth(params.TxSigLimit, p) {
+			return types.ErrTooManySignatures(ModuleName, params.TxSigLimit)
+		}
+		// threshold multisig keys are only accepted after their upgrade
+		if hasThresholdKey(p) && !k.Cdc.IsAfterThresholdMultiSigUpgrade(ctx.BlockHeight()) {
+			return sdk.ErrInvalidPubKey("threshold multisig public keys are not enabled")
+		}
+	}
+	if simulate {
+		return nil
+	}
+	signBytes, err := GetSignBytes(ctx.ChainID(), stdTx)
+	if err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	if !pk.VerifyBytes(signBytes, stdTx.FeePayer.Signature) {
+		return sdk.ErrUnauthorized("signature verification failed for the fee payer of the transaction")
+	}
+	return nil
+}
+
+// ValidateMultiMsgSigners validates that the signatures follow the order of the messages: a message is signed either by
+// a signature already used or by the next one, and no signature is left unused
+func ValidateMultiMsgSigners(msgs []sdk.Msg, sigs []types.StdSignature) sdk.Error {
+	used := 0
+	for i, msg := range msgs {
+		if isSignedBy(msg, sigs[:used]) {
+			continue
+		}
+		if used == len(sigs) || !isSignedBy(msg, sigs[used:used+1]) {
+			return sdk.ErrUnauthorized(fmt.Sprintf("the message %d is not signed by any of its signers", i))
+		}
+		used++
+	}
+	if used != len(sigs) {
+		return sdk.ErrUnauthorized("the transaction has signatures of accounts that aren't signers of its messages")
+	}
+	return nil
+}
+
+// isSignedBy returns whether one of the signatures is of a signer of the message
+func isSignedBy(msg sdk.Msg, sigs []types.StdSignature) bool {
+	for _, sig := range sigs {
+		for _, signer := range msg.GetSigners() {
+			if bytes.Equal(sig.PublicKey.Address(), signer) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateNotDuplicate checks the transaction indexer for the transaction, to prevent replay attacks
+func ValidateNotDuplicate(ctx sdk.Ctx, txIndexer txindex.TxIndexer, txBz []byte) sdk.Error {
+	txHash := tmTypes.Tx(txBz).Hash()
+	// make http call to tendermint to check txIndexer
+	if txIndexer == nil {
+		ctx.Logger().Error(types.ErrNilTxIndexer(ModuleName).Error())
+		return types.ErrNilTxIndexer(ModuleName)
+	}
+	res, err := (txIndexer).Get(txHash)
+	if err != nil {
+		ctx.Logger().Error(err.Error())
+		return sdk.ErrInternal(err.Error())
+	}
+	if res != nil {
+		return types.ErrDuplicateTx(ModuleName, hex.EncodeToString(txHash))
+	}
+	return nil
+}
+
 func ValidateSignatureDepth(limit uint64, publicKey posCrypto.PublicKeyMultiSig) (ok bool) {
 	_, ok = recSignDepth(1, limit, publicKey)
 	return
@@ -169,6 +315,19 @@ func recSignDepth(count, limit uint64, publicKey posCrypto.PublicKeyMultiSig) (c
 	return count, true
 }
 
+// hasThresholdKey returns whether the multisig public key is, or contains, a threshold multisig public key
+func hasThresholdKey(publicKey posCrypto.PublicKeyMultiSig) bool {
+	if _, ok := publicKey.(posCrypto.PublicKeyThresholdMultiSignature); ok {
+		return true
+	}
+	for _, p := range publicKey.Keys() {
+		if pk, ok := p.(posCrypto.PublicKeyMultiSig); ok && hasThresholdKey(pk) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Ctx, ak keeper.Keeper, addr sdk.Address) (Account, sdk.Error) {
@@ -192,7 +351,7 @@ func ValidateMemo(stdTx types.StdTx, params Params) sdk.Error {
 	return nil
 }
 
-// DeductFees deducts fees from the given account.
+// DeductFees deducts fees from the given account, or from the fee payer of the transaction when it has one.
 func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCrypto.PublicKey) sdk.Error {
 	fees := tx.GetFee()
 	if !fees.IsValid() {
@@ -201,7 +360,12 @@ func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCry
 	var acc Account
 	var err sdk.Error
 
-	if keeper.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
+	if feePayer := tx.GetFeePayer(); feePayer != nil {
+		acc, err = GetSignerAcc(ctx, keeper, feePayer)
+		if err != nil {
+			return err
+		}
+	} else if keeper.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
 		acc, err = GetSignerAcc(ctx, keeper, sdk.Address(signer.Address()))
 		if err != nil {
 			return err
@@ -229,7 +393,10 @@ func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCry
 // GetSignBytes returns a slice of bytes to sign over for a given transaction
 // and an account.
 func GetSignBytes(chainID string, stdTx types.StdTx) ([]byte, error) {
-	return StdSignBytes(
-		chainID, stdTx.GetEntropy(), stdTx.GetFee(), stdTx.GetMsg(), stdTx.GetMemo(),
+	if stdTx.IsMultiMsg() {
+		return StdSignBytesMulti(chainID, stdTx.GetEntropy(), stdTx.GetFee(), stdTx.GetMsgs(), stdTx.GetMemo())
+	}
+	return StdSignBytesWithFeePayer(
+		chainID, stdTx.GetEntropy(), stdTx.GetFee(), stdTx.GetMsg(), stdTx.GetMemo(), stdTx.GetFeePayer(),
 	)
 }
@@ -2,6 +2,9 @@ package auth
 
 import (
 	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -28,3 +31,35 @@ func TestSignatureDepth(t *testing.T) {
 	assert.True(t, ValidateSignatureDepth(5, mspk))
 	assert.False(t, ValidateSignatureDepth(4, mspk))
 }
+
+func TestHasThresholdKey(t *testing.T) {
+	pub1 := crypto.GenerateEd25519PrivKey().PublicKey()
+	pub2 := crypto.GenerateEd25519PrivKey().PublicKey()
+	pub3 := crypto.GenerateEd25519PrivKey().PublicKey()
+	mspk := crypto.PublicKeyMultiSignature{PublicKeys: []crypto.PublicKey{pub1, pub2}}
+	tmspk, err := crypto.NewThresholdMultiKey(1, pub1, pub2)
+	assert.Nil(t, err)
+	assert.False(t, hasThresholdKey(mspk))
+	assert.True(t, hasThresholdKey(tmspk))
+	// nested in a regular multisig
+	assert.True(t, hasThresholdKey(crypto.PublicKeyMultiSignature{PublicKeys: []crypto.PublicKey{tmspk, pub3}}))
+}
+
+func TestValidateMultiMsgSigners(t *testing.T) {
+	pub1 := crypto.GenerateEd25519PrivKey().PublicKey()
+	pub2 := crypto.GenerateEd25519PrivKey().PublicKey()
+	pub3 := crypto.GenerateEd25519PrivKey().PublicKey()
+	send := func(from crypto.PublicKey) sdk.Msg {
+		return &nodesTypes.MsgSend{FromAddress: sdk.Address(from.Address()), ToAddress: sdk.Address(pub3.Address()), Amount: sdk.NewInt(1)}
+	}
+	sig1, sig2, sig3 := types.StdSignature{PublicKey: pub1}, types.StdSignature{PublicKey: pub2}, types.StdSignature{PublicKey: pub3}
+	msgs := []sdk.Msg{send(pub1), send(pub2), send(pub1)}
+	assert.Nil(t, ValidateMultiMsgSigners(msgs, []types.StdSignature{sig1, sig2}))
+	// not in the order of the messages
+	assert.NotNil(t, ValidateMultiMsgSigners(msgs, []types.StdSignature{sig2, sig1}))
+	// a missing signature
+	assert.NotNil(t, ValidateMultiMsgSigners(msgs, []types.StdSignature{sig1}))
+	// an unused signature, of a duplicate or of an account that isn't a signer
+	assert.NotNil(t, ValidateMultiMsgSigners(msgs, []types.StdSignature{sig1, sig2, sig1}))
+	assert.NotNil(t, ValidateMultiMsgSigners(msgs, []types.StdSignature{sig1, sig2, sig3}))
+}
@@ -36,6 +36,27 @@ type ModuleAccountI interface {
 	HasPermission(string) bool
 }
 
+// VestingAccount defines an account whose coins are locked until they vest; the locked coins
+// can't be sent but they can be staked
+type VestingAccount interface {
+	Account
+
+	// Track the coins staked and unstaked, the staked ones that were still vesting
+	// don't count against the locked coins
+	TrackDelegation(blockTime time.Time, amount sdk.Coins)
+	TrackUndelegation(amount sdk.Coins)
+
+	GetVestedCoins(blockTime time.Time) sdk.Coins
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+
+	GetStartTime() int64
+	GetEndTime() int64
+
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
 // SupplyI defines an inflationary supply interface for modules that handle
 // token supply.
 type SupplyI interface {
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/keeper"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+)
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Ctx, msg sdk.Msg, _ crypto.PublicKey) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		// convert to value for switch consistency
+		if reflect.ValueOf(msg).Kind() == reflect.Ptr {
+			msg = reflect.Indirect(reflect.ValueOf(msg)).Interface().(sdk.Msg)
+		}
+		switch msg := msg.(type) {
+		case types.MsgCreateVestingAccount:
+			return k.CreateVestingAccount(ctx, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized auth message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
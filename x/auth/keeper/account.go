@@ -108,8 +108,9 @@ func (k Keeper) GetAllAccounts(ctx sdk.Ctx) []exported.Account {
 func (k Keeper) GetAllAccountsExport(ctx sdk.Ctx) []exported.Account {
 	var accounts []exported.Account
 	appendAccount := func(acc exported.Account) (stop bool) {
-		//not get empty coins accounts
-		if !acc.GetCoins().Empty() {
+		//not get empty coins accounts, unless vesting as their staked coins are tracked
+		_, isVesting := acc.(exported.VestingAccount)
+		if !acc.GetCoins().Empty() || isVesting {
 			//sanity check here
 			if acc.GetAddress() != nil {
 				accounts = append(accounts, acc)
@@ -186,6 +187,8 @@ func (k Keeper) EncodeAccount(acc exported.Account, ctx sdk.Ctx) ([]byte, error)
 		return k.EncodeBaseAccount(a, ctx)
 	case *types.ModuleAccount:
 		return k.EncodeModuleAccount(a, ctx)
+	case *types.ContinuousVestingAccount, *types.DelayedVestingAccount:
+		return k.EncodeVestingAccount(a, ctx)
 	}
 	return nil, fmt.Errorf("could not encode account: unrecognized account type")
 }
@@ -199,8 +202,37 @@ func (k Keeper) EncodeModuleAccount(macc *types.ModuleAccount, ctx sdk.Ctx) ([]b
 	return k.Cdc.MarshalBinaryBare(macc, ctx.BlockHeight())
 }
 
+// Vesting accounts are encoded after a zero byte, which can't start the encoding of a base or module account
+// (zero is not a valid protobuf field tag and amino prefixes skip zero bytes), and the byte of their type
+const (
+	vestingAccountPrefix     = byte(0x00)
+	continuousVestingAccount = byte(0x01)
+	delayedVestingAccount    = byte(0x02)
+)
+
+// "EncodeVestingAccount" - encodes a vesting account after its prefix
+func (k Keeper) EncodeVestingAccount(acc exported.Account, ctx sdk.Ctx) ([]byte, error) {
+	var accountType byte
+	switch acc.(type) {
+	case *types.ContinuousVestingAccount:
+		accountType = continuousVestingAccount
+	case *types.DelayedVestingAccount:
+		accountType = delayedVestingAccount
+	default:
+		return nil, fmt.Errorf("could not encode vesting account: unrecognized account type")
+	}
+	bz, err := k.Cdc.MarshalBinaryBare(acc, ctx.BlockHeight())
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{vestingAccountPrefix, accountType}, bz...), nil
+}
+
 // "DecodeAccount" - decodes into account interface
 func (k Keeper) DecodeAccount(bz []byte, ctx sdk.Ctx) (exported.Account, error) {
+	if len(bz) > 1 && bz[0] == vestingAccountPrefix {
+		return k.DecodeVestingAccount(bz, ctx)
+	}
 	acc, err := k.DecodeBaseAccount(bz, ctx)
 	if err == nil {
 		return acc, err
@@ -220,3 +252,18 @@ func (k Keeper) DecodeModuleAccount(bz []byte, ctx sdk.Ctx) (exported.ModuleAcco
 	err := k.Cdc.UnmarshalBinaryBare(bz, &ma, ctx.BlockHeight())
 	return &ma, err
 }
+
+// "DecodeVestingAccount" - decodes a vesting account after its prefix
+func (k Keeper) DecodeVestingAccount(bz []byte, ctx sdk.Ctx) (exported.VestingAccount, error) {
+	switch bz[1] {
+	case continuousVestingAccount:
+		var cva types.ContinuousVestingAccount
+		err := k.Cdc.UnmarshalBinaryBare(bz[2:], &cva, ctx.BlockHeight())
+		return &cva, err
+	case delayedVestingAccount:
+		var dva types.DelayedVestingAccount
+		err := k.Cdc.UnmarshalBinaryBare(bz[2:], &dva, ctx.BlockHeight())
+		return &dva, err
+	}
+	return nil, fmt.Errorf("could not decode vesting account: unrecognized account type %d", bz[1])
+}
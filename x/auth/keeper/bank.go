@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
 	"github.com/pokt-network/pocket-core/x/auth/types"
 
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -49,6 +50,62 @@ func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address
 	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
+// DelegateCoinsFromAccountToModule transfers coins staked from an Address to a ModuleAccount;
+// unlike SendCoinsFromAccountToModule the locked coins of a vesting account can be staked
+func (k Keeper) DelegateCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address,
+	recipientModule string, amt sdk.Coins) sdk.Error {
+
+	// create the account if it doesn't yet exist
+	recipientAcc := k.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		return sdk.ErrModuleAccountCreate(fmt.Sprintf("module account %s isn't able to be created", recipientModule))
+	}
+	vacc, ok := k.GetAccount(ctx, senderAddr).(exported.VestingAccount)
+	if !ok {
+		return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	}
+	if !recipientAcc.HasPermission(types.Staking) {
+		return sdk.ErrForbidden(fmt.Sprintf("module account %s does not have permissions to receive staked tokens", recipientModule))
+	}
+	if !amt.IsValid() {
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+	newCoins, hasNeg := vacc.GetCoins().SafeSub(amt)
+	if hasNeg {
+		return sdk.ErrInsufficientCoins(
+			fmt.Sprintf("insufficient account funds; %s < %s", vacc.GetCoins(), amt),
+		)
+	}
+	vacc.TrackDelegation(ctx.BlockHeader().Time, amt)
+	err := vacc.SetCoins(newCoins)
+	if err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	k.SetAccount(ctx, vacc)
+	_, er := k.AddCoins(ctx, recipientAcc.GetAddress(), amt)
+	if er != nil {
+		return er
+	}
+	k.emitTransferEvents(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return nil
+}
+
+// UndelegateCoinsFromModuleToAccount transfers coins unstaked from a ModuleAccount to an Address;
+// a vesting account tracks them so the coins still vesting are locked again
+func (k Keeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string,
+	recipientAddr sdk.Address, amt sdk.Coins) sdk.Error {
+
+	err := k.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	if err != nil {
+		return err
+	}
+	if vacc, ok := k.GetAccount(ctx, recipientAddr).(exported.VestingAccount); ok {
+		vacc.TrackUndelegation(amt)
+		k.SetAccount(ctx, vacc)
+	}
+	return nil
+}
+
 // MintCoins creates new coins from thin air and adds it to the module account.
 // Panics if the name maps to a non-minter module account or if the amount is invalid.
 func (k Keeper) MintCoins(ctx sdk.Ctx, moduleName string, amt sdk.Coins) sdk.Error {
@@ -120,6 +177,12 @@ func (k Keeper) SendCoins(ctx sdk.Ctx, fromAddr sdk.Address, toAddr sdk.Address,
 	if err != nil {
 		return err
 	}
+	k.emitTransferEvents(ctx, fromAddr, toAddr, amt)
+	return nil
+}
+
+// emitTransferEvents emits the events of a transfer of coins
+func (k Keeper) emitTransferEvents(ctx sdk.Ctx, fromAddr sdk.Address, toAddr sdk.Address, amt sdk.Coins) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -131,8 +194,6 @@ func (k Keeper) SendCoins(ctx sdk.Ctx, fromAddr sdk.Address, toAddr sdk.Address,
 			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
 		),
 	})
-
-	return nil
 }
 
 // SubtractCoins subtracts amt from the coins at the addr.
@@ -13,5 +13,14 @@ func (k Keeper) SetParams(ctx sdk.Ctx, params types.Params) {
 // GetParams gets the auth module's parameters.
 func (k Keeper) GetParams(ctx sdk.Ctx) (params types.Params) {
 	k.subspace.GetParamSet(ctx, &params)
+	params.MaxMsgsPerTx = k.MaxMsgsPerTx(ctx)
+	return
+}
+
+// MaxMsgsPerTx - Max number of messages of a multi message transaction
+// (the default is returned until multi message transactions are activated)
+func (k Keeper) MaxMsgsPerTx(ctx sdk.Ctx) (res uint64) {
+	res = types.DefaultMaxMsgsPerTx
+	k.subspace.GetIfExists(ctx, types.KeyMaxMsgsPerTx, &res)
 	return
 }
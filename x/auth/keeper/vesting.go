@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+)
+
+// CreateVestingAccount - Store ops when a new vesting account is funded by the sender; the
+// account must not exist, all of the amount is vesting
+func (k Keeper) CreateVestingAccount(ctx sdk.Ctx, msg types.MsgCreateVestingAccount) sdk.Result {
+	if !k.Cdc.IsAfterVestingAccountUpgrade(ctx.BlockHeight()) {
+		return types.ErrVestingAccountDisabled(types.DefaultCodespace).Result()
+	}
+	if k.GetAccount(ctx, msg.ToAddress) != nil {
+		return types.ErrAccountExists(types.DefaultCodespace, msg.ToAddress).Result()
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, msg.Amount))
+	baseAccount := types.NewBaseAccountWithAddress(msg.ToAddress)
+	baseAccount.Coins = coins
+	var vacc exported.VestingAccount
+	var err error
+	if msg.Delayed {
+		dva := types.NewDelayedVestingAccount(&baseAccount, msg.EndTime)
+		vacc, err = dva, dva.Validate()
+	} else {
+		cva := types.NewContinuousVestingAccount(&baseAccount, msg.StartTime, msg.EndTime)
+		vacc, err = cva, cva.Validate()
+	}
+	if err != nil {
+		return types.ErrInvalidVesting(types.DefaultCodespace, err).Result()
+	}
+	_, er := k.SubtractCoins(ctx, msg.FromAddress, coins)
+	if er != nil {
+		return er.Result()
+	}
+	k.SetAccount(ctx, vacc)
+	k.emitTransferEvents(ctx, msg.FromAddress, msg.ToAddress, coins)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("vesting account %s funded with %s by %s", msg.ToAddress, coins, msg.FromAddress))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_CreateVestingAccount(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 1)
+	start := time.Unix(1000, 0)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(start)
+	funder := keeper.GetAllAccounts(ctx)[0].GetAddress()
+	recipient := sdk.Address(types.NewModuleAddress("vesting"))
+	msg := types.MsgCreateVestingAccount{
+		FromAddress: funder,
+		ToAddress:   recipient,
+		Amount:      sdk.NewInt(1000),
+		StartTime:   start.Unix(),
+		EndTime:     start.Unix() + 1000,
+	}
+	// not enabled before the upgrade
+	res := keeper.CreateVestingAccount(ctx, msg)
+	require.Equal(t, types.CodeVestingDisabled, res.Code)
+	codec.UpgradeFeatureMap[codec.VestingAccountKey] = 1
+	t.Cleanup(func() {
+		delete(codec.UpgradeFeatureMap, codec.VestingAccountKey)
+	})
+	res = keeper.CreateVestingAccount(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultStakeDenom, amount))
+	}
+	require.Equal(t, initCoins.Sub(coins(1000)), keeper.GetCoins(ctx, funder))
+	vacc, ok := keeper.GetAccount(ctx, recipient).(*types.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, coins(1000), vacc.GetOriginalVesting())
+	// the account already exists
+	res = keeper.CreateVestingAccount(ctx, msg)
+	require.Equal(t, types.CodeAccountExists, res.Code)
+	// a delayed vesting account
+	delayed := msg
+	delayed.ToAddress = types.NewModuleAddress("delayed")
+	delayed.StartTime, delayed.Delayed = 0, true
+	res = keeper.CreateVestingAccount(ctx, delayed)
+	require.True(t, res.IsOK(), res.Log)
+	_, ok = keeper.GetAccount(ctx, delayed.ToAddress).(*types.DelayedVestingAccount)
+	require.True(t, ok)
+}
+
+func TestKeeper_VestingAccountLockedCoins(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 1)
+	start := time.Unix(1000, 0)
+	ctx = ctx.WithBlockTime(start.Add(500 * time.Second))
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultStakeDenom, amount))
+	}
+	recipient := keeper.GetAllAccounts(ctx)[0].GetAddress()
+	baseAcc := types.NewBaseAccountWithAddress(types.NewModuleAddress("vesting"))
+	baseAcc.Coins = coins(1000)
+	keeper.SetAccount(ctx, types.NewContinuousVestingAccount(&baseAcc, start.Unix(), start.Unix()+1000))
+	// half of it is locked
+	require.NotNil(t, keeper.SendCoins(ctx, baseAcc.Address, recipient, coins(501)))
+	require.Nil(t, keeper.SendCoins(ctx, baseAcc.Address, recipient, coins(100)))
+	_, err := keeper.SubtractCoins(ctx, baseAcc.Address, coins(401))
+	require.NotNil(t, err)
+	// the locked coins can be staked
+	require.NotNil(t, keeper.SendCoinsFromAccountToModule(ctx, baseAcc.Address, multiPerm, coins(900)))
+	require.Nil(t, keeper.DelegateCoinsFromAccountToModule(ctx, baseAcc.Address, multiPerm, coins(900)))
+	vacc := keeper.GetAccount(ctx, baseAcc.Address).(exported.VestingAccount)
+	require.Equal(t, coins(500), vacc.GetDelegatedVesting())
+	require.Equal(t, coins(400), vacc.GetDelegatedFree())
+	require.Equal(t, coins(900), getCoinsByName(ctx, keeper, multiPerm))
+	// without coins it is still exported
+	require.Nil(t, keeper.DelegateCoinsFromAccountToModule(ctx, baseAcc.Address, multiPerm, vacc.GetCoins()))
+	require.True(t, keeper.GetCoins(ctx, baseAcc.Address).IsZero())
+	found := false
+	for _, acc := range keeper.GetAllAccountsExport(ctx) {
+		found = found || acc.GetAddress().Equals(baseAcc.Address)
+	}
+	require.True(t, found)
+	// unstaking locks the vesting coins again
+	require.Nil(t, keeper.UndelegateCoinsFromModuleToAccount(ctx, multiPerm, baseAcc.Address, coins(900)))
+	vacc = keeper.GetAccount(ctx, baseAcc.Address).(exported.VestingAccount)
+	require.True(t, vacc.GetDelegatedFree().IsZero())
+	require.True(t, vacc.GetDelegatedVesting().IsZero())
+	require.Equal(t, coins(400), keeper.GetAccount(ctx, baseAcc.Address).SpendableCoins(ctx.BlockTime()))
+}
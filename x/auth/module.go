@@ -72,14 +72,14 @@ func (AppModule) Name() string {
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // Route module message route name
-func (AppModule) Route() string { return "" }
+func (AppModule) Route() string { return types.RouterKey }
 
 func (am AppModule) UpgradeCodec(ctx sdk.Ctx) {
 	am.accountKeeper.UpgradeCodec(ctx)
 }
 
 // NewHandler module handler
-func (AppModule) NewHandler() sdk.Handler { return nil }
+func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.accountKeeper) }
 
 // QuerierRoute module querier route name
 func (AppModule) QuerierRoute() string {
@@ -291,11 +291,11 @@ func (m MultiSigAccount) ToProto() ProtoMultiSigAccount {
 }
 
 func (pms ProtoMultiSigAccount) FromProto() (MultiSigAccount, error) {
-	pk, err := crypto.PublicKeyMultiSignature{}.NewPublicKey(pms.PubKey)
+	pk, err := crypto.NewPublicKeyBz(pms.PubKey)
 	if err != nil {
 		return MultiSigAccount{}, err
 	}
-	pkms, ok := pk.(crypto.PublicKeyMultiSignature)
+	pkms, ok := pk.(crypto.PublicKeyMultiSig)
 	if !ok {
 		return MultiSigAccount{}, fmt.Errorf("%s", "multisig account must have multipublickey type")
 	}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -160,6 +161,219 @@ func (*ProtoMultiSigAccount) XXX_MessageName() string {
 	return "x.auth.ProtoMultiSigAccount"
 }
 
+// BaseVestingAccount implements the common state of the vesting accounts; the coins originally vesting, the ones
+// staked that were free or still vesting at the time of the stake, and the time (unix seconds) the vesting ends
+type ProtoBaseVestingAccount struct {
+	ProtoBaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account" yaml:"base_account"`
+	OriginalVesting  github_com_pokt_network_pocket_core_types.Coins `protobuf:"bytes,2,rep,name=original_vesting,json=originalVesting,proto3,castrepeated=github.com/pokt-network/pocket-core/types.Coins" json:"original_vesting"`
+	DelegatedFree    github_com_pokt_network_pocket_core_types.Coins `protobuf:"bytes,3,rep,name=delegated_free,json=delegatedFree,proto3,castrepeated=github.com/pokt-network/pocket-core/types.Coins" json:"delegated_free"`
+	DelegatedVesting github_com_pokt_network_pocket_core_types.Coins `protobuf:"bytes,4,rep,name=delegated_vesting,json=delegatedVesting,proto3,castrepeated=github.com/pokt-network/pocket-core/types.Coins" json:"delegated_vesting"`
+	EndTime          int64                                           `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time" yaml:"end_time"`
+}
+
+func (m *ProtoBaseVestingAccount) Reset()         { *m = ProtoBaseVestingAccount{} }
+func (m *ProtoBaseVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ProtoBaseVestingAccount) ProtoMessage()    {}
+func (*ProtoBaseVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{3}
+}
+func (m *ProtoBaseVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoBaseVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoBaseVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtoBaseVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoBaseVestingAccount.Merge(m, src)
+}
+func (m *ProtoBaseVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoBaseVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoBaseVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtoBaseVestingAccount proto.InternalMessageInfo
+
+func (*ProtoBaseVestingAccount) XXX_MessageName() string {
+	return "x.auth.ProtoBaseVestingAccount"
+}
+
+// ContinuousVestingAccount vests its coins linearly from the start time until the end time
+type ProtoContinuousVestingAccount struct {
+	ProtoBaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account" yaml:"base_vesting_account"`
+	StartTime               int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time" yaml:"start_time"`
+}
+
+func (m *ProtoContinuousVestingAccount) Reset()         { *m = ProtoContinuousVestingAccount{} }
+func (m *ProtoContinuousVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ProtoContinuousVestingAccount) ProtoMessage()    {}
+func (*ProtoContinuousVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{4}
+}
+func (m *ProtoContinuousVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoContinuousVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoContinuousVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtoContinuousVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoContinuousVestingAccount.Merge(m, src)
+}
+func (m *ProtoContinuousVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoContinuousVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoContinuousVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtoContinuousVestingAccount proto.InternalMessageInfo
+
+func (*ProtoContinuousVestingAccount) XXX_MessageName() string {
+	return "x.auth.ProtoContinuousVestingAccount"
+}
+
+// DelayedVestingAccount vests all of its coins at once at the end time
+type ProtoDelayedVestingAccount struct {
+	ProtoBaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account" yaml:"base_vesting_account"`
+}
+
+func (m *ProtoDelayedVestingAccount) Reset()         { *m = ProtoDelayedVestingAccount{} }
+func (m *ProtoDelayedVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ProtoDelayedVestingAccount) ProtoMessage()    {}
+func (*ProtoDelayedVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{5}
+}
+func (m *ProtoDelayedVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoDelayedVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoDelayedVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtoDelayedVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoDelayedVestingAccount.Merge(m, src)
+}
+func (m *ProtoDelayedVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoDelayedVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoDelayedVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtoDelayedVestingAccount proto.InternalMessageInfo
+
+func (*ProtoDelayedVestingAccount) XXX_MessageName() string {
+	return "x.auth.ProtoDelayedVestingAccount"
+}
+
+type MsgCreateVestingAccount struct {
+	FromAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"from_address" yaml:"from_address"`
+	ToAddress   github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"to_address" yaml:"to_address"`
+	Amount      github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount" yaml:"amount"`
+	StartTime   int64                                             `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time" yaml:"start_time"`
+	EndTime     int64                                             `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time" yaml:"end_time"`
+	Delayed     bool                                              `protobuf:"varint,6,opt,name=delayed,proto3" json:"delayed" yaml:"delayed"`
+}
+
+func (m *MsgCreateVestingAccount) Reset()         { *m = MsgCreateVestingAccount{} }
+func (m *MsgCreateVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingAccount) ProtoMessage()    {}
+func (*MsgCreateVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{6}
+}
+func (m *MsgCreateVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingAccount.Merge(m, src)
+}
+func (m *MsgCreateVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateVestingAccount proto.InternalMessageInfo
+
+func (m *MsgCreateVestingAccount) GetFromAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
+}
+
+func (m *MsgCreateVestingAccount) GetToAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.ToAddress
+	}
+	return nil
+}
+
+func (m *MsgCreateVestingAccount) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgCreateVestingAccount) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *MsgCreateVestingAccount) GetDelayed() bool {
+	if m != nil {
+		return m.Delayed
+	}
+	return false
+}
+
+func (*MsgCreateVestingAccount) XXX_MessageName() string {
+	return "x.auth.MsgCreateVestingAccount"
+}
+
 // Fee Multiplier derfines a key value multiplier for the fee of the
 type FeeMultiplier struct {
 	Key        string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
@@ -170,7 +384,7 @@ func (m *FeeMultiplier) Reset()         { *m = FeeMultiplier{} }
 func (m *FeeMultiplier) String() string { return proto.CompactTextString(m) }
 func (*FeeMultiplier) ProtoMessage()    {}
 func (*FeeMultiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{3}
+	return fileDescriptor_840f82faebe7fabc, []int{7}
 }
 func (m *FeeMultiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,7 +436,7 @@ func (m *FeeMultipliers) Reset()         { *m = FeeMultipliers{} }
 func (m *FeeMultipliers) String() string { return proto.CompactTextString(m) }
 func (*FeeMultipliers) ProtoMessage()    {}
 func (*FeeMultipliers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{4}
+	return fileDescriptor_840f82faebe7fabc, []int{8}
 }
 func (m *FeeMultipliers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +486,7 @@ type Supply struct {
 func (m *Supply) Reset()      { *m = Supply{} }
 func (*Supply) ProtoMessage() {}
 func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{5}
+	return fileDescriptor_840f82faebe7fabc, []int{9}
 }
 func (m *Supply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,18 +516,21 @@ func (m *Supply) XXX_DiscardUnknown() {
 var xxx_messageInfo_Supply proto.InternalMessageInfo
 
 type ProtoStdTx struct {
-	Msg       types1.Any                                      `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg" yaml:"msg"`
-	Fee       github_com_pokt_network_pocket_core_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/pokt-network/pocket-core/types.Coins" json:"fee" yaml:"fee"`
-	Signature ProtoStdSignature                               `protobuf:"bytes,3,opt,name=signature,proto3,casttype=ProtoStdSignature" json:"signature" yaml:"signature"`
-	Memo      string                                          `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo" yaml:"memo"`
-	Entropy   int64                                           `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	Msg        types1.Any                                      `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg" yaml:"msg"`
+	Fee        github_com_pokt_network_pocket_core_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/pokt-network/pocket-core/types.Coins" json:"fee" yaml:"fee"`
+	Signature  ProtoStdSignature                               `protobuf:"bytes,3,opt,name=signature,proto3,casttype=ProtoStdSignature" json:"signature" yaml:"signature"`
+	Memo       string                                          `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo" yaml:"memo"`
+	Entropy    int64                                           `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	Msgs       []types1.Any                                    `protobuf:"bytes,6,rep,name=msgs,proto3" json:"msgs,omitempty" yaml:"msgs"`
+	Signatures []ProtoStdSignature                             `protobuf:"bytes,7,rep,name=signatures,proto3" json:"signatures,omitempty" yaml:"signatures"`
+	FeePayer   *ProtoStdSignature                              `protobuf:"bytes,8,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty" yaml:"fee_payer"`
 }
 
 func (m *ProtoStdTx) Reset()         { *m = ProtoStdTx{} }
 func (m *ProtoStdTx) String() string { return proto.CompactTextString(m) }
 func (*ProtoStdTx) ProtoMessage()    {}
 func (*ProtoStdTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{6}
+	return fileDescriptor_840f82faebe7fabc, []int{10}
 }
 func (m *ProtoStdTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +572,7 @@ func (m *ProtoStdSignature) Reset()         { *m = ProtoStdSignature{} }
 func (m *ProtoStdSignature) String() string { return proto.CompactTextString(m) }
 func (*ProtoStdSignature) ProtoMessage()    {}
 func (*ProtoStdSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{7}
+	return fileDescriptor_840f82faebe7fabc, []int{11}
 }
 func (m *ProtoStdSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,18 +620,19 @@ func (*ProtoStdSignature) XXX_MessageName() string {
 }
 
 type StdSignDoc struct {
-	ChainID string                                        `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"chain_id" yaml:"chain_id"`
-	Fee     github_com_pokt_network_pocket_core_types.Raw `protobuf:"bytes,2,opt,name=fee,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"fee" yaml:"fee"`
-	Memo    string                                        `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo" yaml:"memo"`
-	Msg     github_com_pokt_network_pocket_core_types.Raw `protobuf:"bytes,4,opt,name=msg,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"msg" yaml:"msg"`
-	Entropy int64                                         `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	ChainID  string                                            `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"chain_id" yaml:"chain_id"`
+	Fee      github_com_pokt_network_pocket_core_types.Raw     `protobuf:"bytes,2,opt,name=fee,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"fee" yaml:"fee"`
+	Memo     string                                            `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo" yaml:"memo"`
+	Msg      github_com_pokt_network_pocket_core_types.Raw     `protobuf:"bytes,4,opt,name=msg,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"msg" yaml:"msg"`
+	Entropy  int64                                             `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	FeePayer github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,6,opt,name=fee_payer,json=feePayer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"fee_payer,omitempty" yaml:"fee_payer"`
 }
 
 func (m *StdSignDoc) Reset()         { *m = StdSignDoc{} }
 func (m *StdSignDoc) String() string { return proto.CompactTextString(m) }
 func (*StdSignDoc) ProtoMessage()    {}
 func (*StdSignDoc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{8}
+	return fileDescriptor_840f82faebe7fabc, []int{12}
 }
 func (m *StdSignDoc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,6 +668,10 @@ func init() {
 	proto.RegisterType((*ProtoBaseAccount)(nil), "x.auth.ProtoBaseAccount")
 	proto.RegisterType((*ProtoModuleAccount)(nil), "x.auth.ProtoModuleAccount")
 	proto.RegisterType((*ProtoMultiSigAccount)(nil), "x.auth.ProtoMultiSigAccount")
+	proto.RegisterType((*ProtoBaseVestingAccount)(nil), "x.auth.ProtoBaseVestingAccount")
+	proto.RegisterType((*ProtoContinuousVestingAccount)(nil), "x.auth.ProtoContinuousVestingAccount")
+	proto.RegisterType((*ProtoDelayedVestingAccount)(nil), "x.auth.ProtoDelayedVestingAccount")
+	proto.RegisterType((*MsgCreateVestingAccount)(nil), "x.auth.MsgCreateVestingAccount")
 	proto.RegisterType((*FeeMultiplier)(nil), "x.auth.FeeMultiplier")
 	proto.RegisterType((*FeeMultipliers)(nil), "x.auth.FeeMultipliers")
 	proto.RegisterType((*Supply)(nil), "x.auth.Supply")
@@ -461,70 +683,139 @@ func init() {
 func init() { proto.RegisterFile("x/auth/auth.proto", fileDescriptor_840f82faebe7fabc) }
 
 var fileDescriptor_840f82faebe7fabc = []byte{
-	// 967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc6, 0x49, 0x1c, 0x8f, 0xdb, 0x90, 0x0c, 0xa6, 0xb5, 0x8b, 0xea, 0x29, 0x83, 0x2a,
-	0x55, 0x42, 0xd9, 0x85, 0x72, 0x88, 0x30, 0x48, 0x34, 0xdb, 0x52, 0x04, 0x01, 0xa9, 0x5a, 0x73,
-	0xa8, 0x72, 0xb1, 0xf6, 0xc7, 0x78, 0xb3, 0xf2, 0xee, 0xce, 0xca, 0xbb, 0xab, 0xc4, 0xff, 0x41,
-	0x4f, 0x88, 0x1b, 0x88, 0x53, 0xca, 0x91, 0x33, 0x17, 0xfe, 0x83, 0x1e, 0x23, 0x4e, 0x9c, 0x06,
-	0x94, 0x5c, 0x90, 0xb9, 0xed, 0x31, 0x27, 0x34, 0x3f, 0x36, 0x6b, 0x27, 0x48, 0x44, 0x41, 0x82,
-	0x8b, 0x35, 0xef, 0xdb, 0xf7, 0xde, 0x7c, 0xf3, 0xcd, 0xf7, 0x46, 0x06, 0x9b, 0x87, 0x86, 0x9d,
-	0x67, 0xfb, 0xe2, 0x47, 0x4f, 0x26, 0x34, 0xa3, 0x70, 0xf5, 0x50, 0xe7, 0xd1, 0x9d, 0xae, 0x4b,
-	0xd3, 0x88, 0xa6, 0x43, 0x81, 0x1a, 0x32, 0x90, 0x29, 0x77, 0xda, 0x3e, 0xf5, 0xa9, 0xc4, 0xf9,
-	0x4a, 0xa1, 0x1b, 0xd9, 0x34, 0x21, 0xa9, 0xe1, 0xd2, 0x20, 0x56, 0x48, 0xd7, 0xa7, 0xd4, 0x0f,
-	0x89, 0x21, 0x22, 0x27, 0x1f, 0x19, 0x76, 0x3c, 0x95, 0x9f, 0xf0, 0xcb, 0x25, 0xb0, 0xf1, 0x8c,
-	0xaf, 0x4c, 0x3b, 0x25, 0x3b, 0xae, 0x4b, 0xf3, 0x38, 0x83, 0x7b, 0xa0, 0x61, 0x7b, 0xde, 0x84,
-	0xa4, 0x69, 0x47, 0xbb, 0xa7, 0x3d, 0xb8, 0x61, 0x3e, 0x9a, 0x31, 0x54, 0x42, 0x67, 0x0c, 0xbd,
-	0xe7, 0x07, 0xd9, 0x7e, 0xee, 0xe8, 0x2e, 0x8d, 0x8c, 0x84, 0x8e, 0xb3, 0xad, 0x98, 0x64, 0x07,
-	0x74, 0x32, 0x36, 0x12, 0xea, 0x8e, 0x49, 0xb6, 0xe5, 0xd2, 0x09, 0x31, 0x04, 0x0b, 0x7d, 0x47,
-	0x16, 0x59, 0x65, 0x35, 0xfc, 0x08, 0x34, 0x92, 0xdc, 0x19, 0x8e, 0xc9, 0xb4, 0xb3, 0x24, 0x7a,
-	0xbf, 0x3d, 0x63, 0x08, 0x24, 0xb9, 0x13, 0x06, 0x2e, 0x47, 0x0b, 0x86, 0x36, 0xa7, 0x76, 0x14,
-	0xf6, 0x71, 0x85, 0x61, 0x6b, 0x35, 0xc9, 0x9d, 0x5d, 0x32, 0x85, 0x7b, 0x60, 0x85, 0x9f, 0x2b,
-	0xed, 0xd4, 0xef, 0xd5, 0x1f, 0xb4, 0x1e, 0xb6, 0x74, 0xb9, 0xcb, 0x63, 0x1a, 0xc4, 0xe6, 0xf6,
-	0x2b, 0x86, 0x6a, 0x3f, 0xfe, 0x86, 0x8c, 0xab, 0xb3, 0xe3, 0x75, 0xa9, 0x25, 0x5b, 0xf6, 0x6f,
-	0xbf, 0x38, 0x42, 0xb5, 0xef, 0x8e, 0x90, 0xf6, 0xe2, 0x07, 0xa4, 0xfd, 0xf2, 0xd3, 0x56, 0x43,
-	0xc9, 0x81, 0xbf, 0x5f, 0x02, 0x50, 0x68, 0xf4, 0x25, 0xf5, 0xf2, 0xf0, 0x5c, 0x25, 0x0a, 0x6e,
-	0x39, 0x76, 0x4a, 0x86, 0xb6, 0x8c, 0x87, 0x24, 0x76, 0xa9, 0x67, 0x3b, 0x21, 0x11, 0xa2, 0xb5,
-	0x1e, 0x76, 0x74, 0x79, 0x83, 0xfa, 0x45, 0x7d, 0x4d, 0xc4, 0x99, 0x1e, 0x33, 0xa4, 0x15, 0x0c,
-	0xbd, 0x2e, 0x0f, 0x3b, 0xdf, 0x09, 0x5b, 0x6d, 0xa7, 0xca, 0xfe, 0xa4, 0x6c, 0x0b, 0xdf, 0x01,
-	0xcb, 0xb1, 0x1d, 0x11, 0xa1, 0x5b, 0xd3, 0xbc, 0x3d, 0x63, 0x48, 0xc4, 0x05, 0x43, 0x2d, 0xd9,
-	0x84, 0x47, 0xd8, 0x12, 0x20, 0xfc, 0x14, 0xb4, 0x12, 0x32, 0x89, 0x82, 0x34, 0x0d, 0xa8, 0xd2,
-	0xab, 0x69, 0xde, 0x9f, 0x31, 0x34, 0x0f, 0x17, 0x0c, 0x41, 0x25, 0x76, 0x05, 0x62, 0x6b, 0x3e,
-	0xa5, 0x7f, 0xf7, 0x82, 0x2c, 0x37, 0x17, 0x54, 0xc0, 0x3f, 0x2f, 0x81, 0xb6, 0x14, 0x27, 0x0f,
-	0xb3, 0x60, 0x10, 0xf8, 0xff, 0x85, 0x89, 0x9e, 0x5d, 0x34, 0xd1, 0xf6, 0x8c, 0xa1, 0x76, 0x65,
-	0x98, 0x61, 0xc4, 0xc9, 0x0c, 0xd3, 0xc0, 0x2f, 0x18, 0x7a, 0xf3, 0xa2, 0x9d, 0xaa, 0xaf, 0xff,
-	0xb3, 0xb1, 0x3c, 0x70, 0xf3, 0x29, 0x21, 0x42, 0xb8, 0x24, 0x0c, 0xc8, 0x04, 0x76, 0x41, 0x9d,
-	0x9f, 0x49, 0x13, 0x17, 0xdc, 0x98, 0x31, 0xc4, 0x43, 0x8b, 0xff, 0x40, 0x1d, 0x80, 0xe8, 0x3c,
-	0x51, 0x9c, 0xba, 0x6e, 0xae, 0xf3, 0xd1, 0xa9, 0x50, 0x6b, 0x6e, 0xdd, 0x5f, 0xe3, 0x1b, 0xfe,
-	0x71, 0x84, 0x34, 0xfc, 0xb5, 0x06, 0xd6, 0x17, 0xb6, 0x49, 0xe1, 0x2e, 0x68, 0x8e, 0x14, 0xc2,
-	0x6f, 0x87, 0x9f, 0xf8, 0x8d, 0xd2, 0xad, 0x0b, 0xa9, 0xe6, 0x2d, 0x7e, 0xf6, 0x19, 0x43, 0xeb,
-	0x23, 0x42, 0x86, 0x73, 0x5b, 0x55, 0xf5, 0xf0, 0x3e, 0x68, 0x78, 0x64, 0x64, 0xe7, 0x61, 0xa6,
-	0x68, 0xb5, 0xf8, 0x45, 0x2b, 0xc8, 0x2a, 0x17, 0x73, 0x84, 0x0e, 0xc0, 0xea, 0x20, 0x4f, 0x92,
-	0x70, 0x0a, 0x5d, 0xb0, 0x92, 0xd1, 0xcc, 0x0e, 0x15, 0x87, 0x05, 0xd5, 0x1f, 0xa9, 0x9d, 0x65,
-	0xc6, 0xb5, 0xe4, 0x17, 0x95, 0xfd, 0x35, 0x25, 0x7f, 0x0d, 0xbf, 0xac, 0x03, 0x20, 0xbc, 0x3a,
-	0xc8, 0xbc, 0xaf, 0x0e, 0xe1, 0x0e, 0xa8, 0x47, 0xa9, 0xaf, 0xa6, 0xb5, 0xad, 0xcb, 0x47, 0x52,
-	0x2f, 0x1f, 0x49, 0x7d, 0x27, 0x9e, 0x9a, 0x5d, 0x45, 0x82, 0x27, 0x16, 0x0c, 0x01, 0x69, 0xa5,
-	0x28, 0xf5, 0xb1, 0xc5, 0x21, 0x38, 0x06, 0xf5, 0x11, 0xe1, 0x13, 0x79, 0x89, 0xfe, 0x17, 0x65,
-	0xe5, 0x88, 0x90, 0xaa, 0x72, 0x44, 0x08, 0xbe, 0xce, 0x51, 0x78, 0x17, 0x98, 0x82, 0x66, 0x1a,
-	0xf8, 0xb1, 0x9d, 0xe5, 0x13, 0xd2, 0xa9, 0x0b, 0xd6, 0xdd, 0x85, 0x37, 0x66, 0x90, 0x79, 0x83,
-	0x32, 0xc1, 0xec, 0x2b, 0x02, 0x55, 0x4d, 0xc1, 0xd0, 0x86, 0xa4, 0x71, 0x0e, 0xe1, 0x33, 0x86,
-	0x36, 0x2f, 0xd5, 0x5a, 0x55, 0x0d, 0x7f, 0x74, 0x22, 0x12, 0xd1, 0xce, 0x72, 0xf5, 0xe8, 0xf0,
-	0xb8, 0x7a, 0x74, 0x78, 0x84, 0x2d, 0x01, 0xc2, 0x6d, 0xd0, 0x20, 0x71, 0x36, 0xa1, 0xc9, 0xb4,
-	0xb3, 0x22, 0xac, 0x70, 0x97, 0x5b, 0x41, 0x41, 0x05, 0x43, 0xeb, 0xb2, 0x44, 0x01, 0xd8, 0x2a,
-	0x3f, 0xc9, 0x3b, 0xe2, 0xe3, 0x81, 0xbf, 0xd5, 0xc0, 0x65, 0x42, 0xf0, 0x43, 0xd0, 0x94, 0xf3,
-	0xbb, 0xab, 0xc6, 0xe3, 0x86, 0x6c, 0xad, 0x5e, 0x81, 0xaa, 0xb5, 0x02, 0xb0, 0x55, 0xe5, 0xc3,
-	0x8f, 0x41, 0xf3, 0xbc, 0x93, 0x7a, 0x2f, 0xde, 0xfa, 0x47, 0x61, 0xac, 0xaa, 0xa6, 0xbf, 0x2c,
-	0x98, 0xfd, 0xb9, 0x04, 0x80, 0x22, 0xf5, 0x84, 0xba, 0xf0, 0x03, 0xd0, 0x78, 0xbc, 0x6f, 0x07,
-	0xf1, 0x67, 0x4f, 0xd4, 0xbc, 0xa2, 0x19, 0x43, 0x6b, 0x2e, 0x87, 0x86, 0x81, 0x57, 0x30, 0xf4,
-	0x9a, 0x6c, 0x59, 0x22, 0xd8, 0x2a, 0xf3, 0xe1, 0xf3, 0xd2, 0x35, 0x9c, 0xca, 0xd3, 0xbf, 0x35,
-	0xc9, 0x19, 0x43, 0x5b, 0x57, 0x37, 0x89, 0x65, 0x1f, 0x48, 0x8b, 0x94, 0xb7, 0x55, 0xbf, 0xca,
-	0x6d, 0x3d, 0x97, 0xfe, 0x5f, 0xae, 0x68, 0x5c, 0x72, 0xf9, 0x35, 0x68, 0xf0, 0xb1, 0xf8, 0xf7,
-	0x3e, 0x30, 0x3f, 0x7f, 0x75, 0xd2, 0xd3, 0x8e, 0x4f, 0x7a, 0xda, 0xef, 0x27, 0x3d, 0xed, 0x9b,
-	0xd3, 0x5e, 0xed, 0xf8, 0xb4, 0x57, 0xfb, 0xf5, 0xb4, 0x57, 0xdb, 0x7b, 0xf7, 0x2a, 0x84, 0xd4,
-	0xff, 0x29, 0xc1, 0xcb, 0x59, 0x15, 0x33, 0xfd, 0xfe, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf,
-	0x08, 0xe4, 0xc3, 0x66, 0x09, 0x00, 0x00,
-}
+	// 1456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x1b, 0xce, 0xda, 0x8e, 0x1d, 0x8f, 0x93, 0x34, 0x99, 0xe6, 0x6b, 0x9c, 0x56, 0xcd, 0xe4, 0xdb,
+	0x4f, 0xd5, 0x17, 0xa9, 0xc4, 0xa6, 0xe5, 0x50, 0x61, 0x90, 0x68, 0x36, 0xa5, 0xa8, 0x84, 0x8a,
+	0x6a, 0x53, 0xa1, 0xaa, 0xaa, 0x64, 0xd6, 0xf6, 0x78, 0xbb, 0xca, 0xee, 0xce, 0x6a, 0x67, 0x96,
+	0xd4, 0x48, 0x15, 0x07, 0x24, 0xd4, 0x13, 0xe2, 0x06, 0xe2, 0x54, 0x38, 0x72, 0x43, 0xe2, 0xc2,
+	0x1f, 0x80, 0xe8, 0x8d, 0x8a, 0x13, 0xe2, 0xb0, 0xa0, 0xf6, 0x82, 0x7c, 0xf4, 0x09, 0xf5, 0x84,
+	0xe6, 0xc7, 0x7a, 0xd7, 0x76, 0x43, 0x83, 0x2b, 0x01, 0x17, 0x6b, 0xe7, 0x99, 0xf7, 0x7d, 0xe7,
+	0x9d, 0x67, 0x9e, 0xf7, 0x9d, 0x91, 0xc1, 0xf2, 0x9d, 0xba, 0x15, 0xb1, 0xdb, 0xe2, 0xa7, 0x16,
+	0x84, 0x84, 0x11, 0x58, 0xbc, 0x53, 0xe3, 0xa3, 0x93, 0x6b, 0x6d, 0x42, 0x3d, 0x42, 0x9b, 0x02,
+	0xad, 0xcb, 0x81, 0x34, 0x39, 0xb9, 0x62, 0x13, 0x9b, 0x48, 0x9c, 0x7f, 0x29, 0x74, 0x89, 0xf5,
+	0x02, 0x4c, 0xeb, 0x6d, 0xe2, 0xf8, 0x0a, 0x59, 0xb3, 0x09, 0xb1, 0x5d, 0x5c, 0x17, 0xa3, 0x56,
+	0xd4, 0xad, 0x5b, 0x7e, 0x4f, 0x4e, 0xe9, 0x5f, 0xe4, 0xc0, 0xd2, 0x35, 0xfe, 0x65, 0x58, 0x14,
+	0x6f, 0xb7, 0xdb, 0x24, 0xf2, 0x19, 0xbc, 0x09, 0x4a, 0x56, 0xa7, 0x13, 0x62, 0x4a, 0xab, 0xda,
+	0x86, 0xb6, 0x39, 0x6f, 0x5c, 0xec, 0xc7, 0x28, 0x81, 0x9e, 0xc4, 0xe8, 0x9c, 0xed, 0xb0, 0xdb,
+	0x51, 0xab, 0xd6, 0x26, 0x5e, 0x3d, 0x20, 0xfb, 0x6c, 0xcb, 0xc7, 0xec, 0x80, 0x84, 0xfb, 0xf5,
+	0x80, 0xb4, 0xf7, 0x31, 0xdb, 0x6a, 0x93, 0x10, 0xd7, 0x45, 0x16, 0xb5, 0x6d, 0xe9, 0x64, 0x26,
+	0xde, 0xf0, 0x55, 0x50, 0x0a, 0xa2, 0x56, 0x73, 0x1f, 0xf7, 0xaa, 0x39, 0x11, 0xfb, 0x7f, 0xfd,
+	0x18, 0x81, 0x20, 0x6a, 0xb9, 0x4e, 0x9b, 0xa3, 0x83, 0x18, 0x2d, 0xf7, 0x2c, 0xcf, 0x6d, 0xe8,
+	0x29, 0xa6, 0x9b, 0xc5, 0x20, 0x6a, 0xed, 0xe2, 0x1e, 0xbc, 0x09, 0x66, 0xf9, 0xbe, 0x68, 0x35,
+	0xbf, 0x91, 0xdf, 0xac, 0x9c, 0xaf, 0xd4, 0xe4, 0x2a, 0x3b, 0xc4, 0xf1, 0x8d, 0x0b, 0x0f, 0x62,
+	0x34, 0xf3, 0xd5, 0x2f, 0xa8, 0x7e, 0xf4, 0xec, 0xb8, 0x1f, 0x35, 0x65, 0xc8, 0xc6, 0xea, 0xbd,
+	0xfb, 0x68, 0xe6, 0xb3, 0xfb, 0x48, 0xbb, 0xf7, 0x25, 0xd2, 0x7e, 0xfc, 0x66, 0xab, 0xa4, 0xe8,
+	0xd0, 0x3f, 0xcf, 0x01, 0x28, 0x38, 0xba, 0x4a, 0x3a, 0x91, 0x3b, 0x64, 0x89, 0x80, 0x13, 0x2d,
+	0x8b, 0xe2, 0xa6, 0x25, 0xc7, 0x4d, 0xec, 0xb7, 0x49, 0xc7, 0x6a, 0xb9, 0x58, 0x90, 0x56, 0x39,
+	0x5f, 0xad, 0xc9, 0x13, 0xac, 0x8d, 0xf3, 0x6b, 0x20, 0x9e, 0xe9, 0xc3, 0x18, 0x69, 0x83, 0x18,
+	0x1d, 0x97, 0x9b, 0xcd, 0x46, 0xd2, 0xcd, 0x95, 0x56, 0x6a, 0xfd, 0x7a, 0x12, 0x16, 0x9e, 0x05,
+	0x05, 0xdf, 0xf2, 0xb0, 0xe0, 0xad, 0x6c, 0xac, 0xf6, 0x63, 0x24, 0xc6, 0x83, 0x18, 0x55, 0x64,
+	0x10, 0x3e, 0xd2, 0x4d, 0x01, 0xc2, 0x37, 0x40, 0x25, 0xc0, 0xa1, 0xe7, 0x50, 0xea, 0x10, 0xc5,
+	0x57, 0xd9, 0x38, 0xd3, 0x8f, 0x51, 0x16, 0x1e, 0xc4, 0x08, 0x2a, 0xb2, 0x53, 0x50, 0x37, 0xb3,
+	0x26, 0x8d, 0xd3, 0x63, 0xb4, 0x2c, 0x8c, 0xb0, 0xa0, 0x7f, 0x9b, 0x03, 0x2b, 0x92, 0x9c, 0xc8,
+	0x65, 0xce, 0x9e, 0x63, 0xff, 0x1d, 0x22, 0xba, 0x36, 0x2e, 0xa2, 0x0b, 0xfd, 0x18, 0xad, 0xa4,
+	0x82, 0x69, 0x7a, 0x3c, 0x99, 0x26, 0x75, 0xec, 0x41, 0x8c, 0x4e, 0x8d, 0xcb, 0x29, 0x9d, 0xfd,
+	0x87, 0x85, 0xf5, 0x43, 0x01, 0xac, 0x0e, 0xc5, 0xf1, 0x0e, 0xa6, 0xcc, 0xf1, 0x87, 0xf4, 0xdd,
+	0x02, 0xf3, 0x59, 0x4d, 0x3c, 0xbf, 0xa6, 0x2a, 0x19, 0x4d, 0xc1, 0x0f, 0x35, 0xb0, 0x44, 0x42,
+	0xc7, 0x76, 0x7c, 0xcb, 0x6d, 0xbe, 0x27, 0x57, 0xae, 0xe6, 0x26, 0xb7, 0xbe, 0xcb, 0xa3, 0xf6,
+	0x63, 0x34, 0x61, 0x3c, 0x0d, 0x1d, 0xc7, 0x92, 0x20, 0x6a, 0xab, 0xf0, 0x2e, 0x58, 0xec, 0x60,
+	0x17, 0xdb, 0x16, 0xc3, 0x9d, 0x66, 0x37, 0xc4, 0xf8, 0x69, 0xec, 0x5f, 0x51, 0x29, 0x8c, 0x99,
+	0x4e, 0x93, 0xc0, 0xc2, 0x30, 0xc4, 0xe5, 0x10, 0x63, 0xf8, 0x91, 0x06, 0x96, 0xd3, 0xa0, 0x09,
+	0x0b, 0x85, 0xc9, 0x14, 0xde, 0x52, 0x29, 0x4c, 0x5a, 0x4f, 0x93, 0xc5, 0xd2, 0x30, 0x4a, 0xc2,
+	0x43, 0x03, 0xcc, 0x61, 0xbf, 0xd3, 0x64, 0x8e, 0x87, 0xab, 0xb3, 0x1b, 0xda, 0x66, 0xde, 0x40,
+	0xfd, 0x18, 0x0d, 0xb1, 0x41, 0x8c, 0x8e, 0xc9, 0x13, 0x4d, 0x10, 0xdd, 0x2c, 0x61, 0xbf, 0x73,
+	0xdd, 0xf1, 0x70, 0x63, 0x3e, 0x2b, 0x2e, 0xfd, 0x77, 0x0d, 0x9c, 0x16, 0xd2, 0xd8, 0x21, 0x3e,
+	0x73, 0xfc, 0x88, 0x44, 0x74, 0x4c, 0x57, 0xef, 0x03, 0xd1, 0x5c, 0x92, 0x0d, 0x8c, 0xe9, 0x0b,
+	0x4d, 0xe8, 0x6b, 0xd4, 0xdd, 0xf8, 0x7f, 0x46, 0x66, 0xa7, 0x32, 0x32, 0x1b, 0x0b, 0xa7, 0x9b,
+	0xb0, 0x35, 0xa9, 0x69, 0x03, 0x00, 0xca, 0xac, 0x90, 0xc9, 0x9d, 0xe6, 0xc4, 0x4e, 0x45, 0xfb,
+	0x4f, 0xd1, 0xb4, 0xfd, 0xa7, 0x98, 0x6e, 0x96, 0xc5, 0x40, 0xec, 0xf7, 0xd0, 0x62, 0xfa, 0x5a,
+	0x03, 0x27, 0x45, 0xd6, 0x97, 0xb0, 0x6b, 0xf5, 0x86, 0xe4, 0xfe, 0x0b, 0xf6, 0x7d, 0x78, 0xce,
+	0xdf, 0x17, 0xc0, 0xea, 0x55, 0x6a, 0xef, 0x84, 0xd8, 0x62, 0xe3, 0x64, 0x7d, 0x00, 0xe6, 0xbb,
+	0x21, 0xf1, 0x9a, 0xa3, 0x4d, 0xf4, 0x56, 0x3f, 0x46, 0x23, 0x78, 0x5a, 0xee, 0x59, 0x54, 0x9f,
+	0xae, 0xc1, 0x56, 0x78, 0x0c, 0x35, 0x80, 0x07, 0x00, 0x30, 0x32, 0x5c, 0x5e, 0xf6, 0xd9, 0x1b,
+	0xfc, 0xb4, 0x52, 0x34, 0x3d, 0x2d, 0x46, 0x9e, 0x73, 0xe9, 0x32, 0x23, 0xc9, 0xc2, 0xfb, 0xa0,
+	0x68, 0x79, 0xe2, 0x70, 0xf2, 0xe2, 0xa6, 0xdb, 0xe3, 0xdc, 0xff, 0x1c, 0xa3, 0x17, 0x8f, 0x1e,
+	0xd5, 0x70, 0xec, 0x2b, 0x3e, 0xeb, 0xc7, 0x48, 0x45, 0x1a, 0xc4, 0x68, 0x41, 0x26, 0x2a, 0xc7,
+	0xba, 0xa9, 0x26, 0xc6, 0x34, 0x59, 0x98, 0x46, 0x93, 0xcf, 0x53, 0xbf, 0xf0, 0x02, 0x28, 0x75,
+	0xa4, 0x60, 0xab, 0xc5, 0x0d, 0x6d, 0x73, 0xce, 0x38, 0xcd, 0xaf, 0x49, 0x05, 0x0d, 0x62, 0xb4,
+	0x28, 0x3d, 0x15, 0xa0, 0x9b, 0xc9, 0x54, 0x63, 0x9e, 0x0b, 0xea, 0xb7, 0xa4, 0xf0, 0x3b, 0x60,
+	0xe1, 0x32, 0xc6, 0xe2, 0x0e, 0x0e, 0x5c, 0x07, 0x87, 0x70, 0x0d, 0xe4, 0xf9, 0xf5, 0xa8, 0x09,
+	0x06, 0x4b, 0xfd, 0x18, 0xf1, 0xa1, 0xc9, 0x7f, 0x60, 0x0d, 0x00, 0x6f, 0x68, 0xa8, 0xca, 0x70,
+	0x91, 0x6f, 0x39, 0x45, 0xcd, 0xcc, 0x77, 0x63, 0x2e, 0x59, 0x49, 0xff, 0x58, 0x03, 0x8b, 0x23,
+	0xcb, 0x50, 0xb8, 0x0b, 0xca, 0x5d, 0x85, 0x70, 0x8d, 0xf2, 0xde, 0xf9, 0x9f, 0xa4, 0x98, 0x46,
+	0x4c, 0x8d, 0x13, 0x49, 0x23, 0xef, 0x62, 0xdc, 0xcc, 0x2c, 0x95, 0xfa, 0xc3, 0x33, 0x9c, 0x8c,
+	0xae, 0x15, 0xb9, 0x4c, 0xa5, 0x55, 0x91, 0x64, 0x08, 0xc8, 0x4c, 0x3e, 0x32, 0x09, 0x1d, 0x80,
+	0xe2, 0x5e, 0x14, 0x04, 0x6e, 0x0f, 0xb6, 0xc1, 0x2c, 0x23, 0xcc, 0x72, 0xab, 0xda, 0x64, 0xff,
+	0xbe, 0xa8, 0x56, 0x96, 0x16, 0x53, 0xdd, 0xe4, 0xc2, 0xb3, 0x31, 0xa7, 0x0a, 0x79, 0x46, 0xff,
+	0x6e, 0x16, 0x00, 0xd1, 0x2b, 0xf6, 0x58, 0xe7, 0xfa, 0x1d, 0xb8, 0x0d, 0xf2, 0x1e, 0xb5, 0x55,
+	0x33, 0x59, 0xa9, 0xc9, 0xf7, 0x76, 0x2d, 0x79, 0x6f, 0xd7, 0xb6, 0xfd, 0x9e, 0xb1, 0xa6, 0x92,
+	0xe0, 0x86, 0x83, 0x18, 0x01, 0x79, 0xae, 0x1e, 0xb5, 0x75, 0x93, 0x43, 0x70, 0x1f, 0xe4, 0xbb,
+	0x18, 0x57, 0x73, 0x87, 0x5f, 0x3f, 0x7c, 0x3e, 0xf5, 0xec, 0x62, 0xac, 0x4f, 0xb3, 0x15, 0x1e,
+	0x05, 0x52, 0x50, 0xa6, 0x8e, 0xed, 0x5b, 0x2c, 0x0a, 0xb1, 0xa8, 0xb2, 0xca, 0xf9, 0xb5, 0x91,
+	0x16, 0xb8, 0xc7, 0x3a, 0x7b, 0x89, 0x81, 0xd1, 0x50, 0x09, 0xa4, 0x3e, 0x83, 0x18, 0x2d, 0xa9,
+	0x92, 0x48, 0x20, 0x5e, 0xf7, 0xcb, 0x13, 0xbe, 0x66, 0xea, 0xc3, 0xdf, 0xaf, 0x1e, 0xf6, 0x48,
+	0xb5, 0x90, 0xbe, 0x5f, 0xf9, 0x38, 0x7d, 0xbf, 0xf2, 0x91, 0x6e, 0x0a, 0x90, 0xd7, 0x05, 0xf6,
+	0x59, 0x48, 0x82, 0x9e, 0x2a, 0x29, 0x51, 0x17, 0x0a, 0x4a, 0xeb, 0x42, 0x01, 0xa2, 0xa0, 0xc4,
+	0x17, 0x7c, 0x1b, 0x14, 0x3c, 0x6a, 0xd3, 0x6a, 0x71, 0x23, 0x7f, 0xe8, 0x59, 0x9c, 0x49, 0xa4,
+	0xc8, 0x2d, 0x5f, 0x20, 0x9e, 0xc3, 0xb0, 0x17, 0xb0, 0x5e, 0x26, 0x13, 0x6a, 0x53, 0x9e, 0x09,
+	0xb5, 0x29, 0x74, 0x01, 0x18, 0xee, 0x81, 0x56, 0x4b, 0x1b, 0xf9, 0x3f, 0x27, 0xeb, 0x9c, 0x8a,
+	0xbd, 0x92, 0x3a, 0x8d, 0xac, 0xb0, 0x3c, 0xc6, 0x1b, 0xd5, 0xcd, 0x4c, 0x7c, 0xd8, 0x16, 0xf5,
+	0xd4, 0x0c, 0xac, 0x1e, 0x0e, 0xab, 0x73, 0xcf, 0x3a, 0x99, 0xb3, 0xfd, 0x18, 0x1d, 0x1f, 0xda,
+	0x8f, 0xac, 0xb3, 0x34, 0x94, 0x89, 0x9c, 0xd4, 0xcd, 0xb9, 0x2e, 0xc6, 0xd7, 0xf8, 0xa7, 0xd4,
+	0xb1, 0xe8, 0x1b, 0x9f, 0x6a, 0x60, 0xf2, 0xd0, 0xe0, 0x2b, 0xa0, 0x2c, 0x9f, 0xcb, 0xbb, 0xaa,
+	0x85, 0xcc, 0x4b, 0xfa, 0xd5, 0xa3, 0x3b, 0xa5, 0x5f, 0x01, 0xba, 0x99, 0xda, 0xc3, 0xd7, 0x40,
+	0x79, 0x18, 0x49, 0x5d, 0x1b, 0xff, 0x7d, 0xa6, 0x78, 0xcc, 0xd4, 0xa7, 0x51, 0x10, 0x99, 0xf5,
+	0xf3, 0x00, 0xa8, 0xa4, 0x2e, 0x91, 0x36, 0x7c, 0x19, 0x94, 0x76, 0x6e, 0x5b, 0x8e, 0x7f, 0xe5,
+	0x92, 0xea, 0x69, 0xa2, 0xc5, 0xb6, 0x39, 0xd4, 0x74, 0x3a, 0x69, 0x8b, 0x4d, 0x10, 0xdd, 0x4c,
+	0xec, 0xe1, 0x8d, 0xa4, 0xb2, 0x78, 0x2a, 0x97, 0x9f, 0x5a, 0x48, 0x4f, 0x62, 0xb4, 0x75, 0xf4,
+	0x42, 0x32, 0xad, 0x03, 0x59, 0x46, 0x89, 0xa2, 0xf3, 0x47, 0x51, 0xf4, 0x0d, 0xd9, 0x23, 0x0a,
+	0x69, 0x1a, 0x13, 0x9d, 0x60, 0x8a, 0x34, 0x78, 0xeb, 0x98, 0xba, 0x56, 0xee, 0x66, 0xc5, 0x56,
+	0x14, 0x89, 0xbd, 0xfb, 0x17, 0x14, 0x35, 0xdd, 0x4d, 0xff, 0x14, 0x19, 0x1a, 0x6f, 0x3e, 0x78,
+	0xb4, 0xae, 0x3d, 0x7c, 0xb4, 0xae, 0xfd, 0xfa, 0x68, 0x5d, 0xfb, 0xe4, 0xf1, 0xfa, 0xcc, 0xc3,
+	0xc7, 0xeb, 0x33, 0x3f, 0x3d, 0x5e, 0x9f, 0xb9, 0x79, 0xa4, 0x4b, 0x5f, 0xfd, 0x7b, 0x22, 0xd6,
+	0x69, 0x15, 0x45, 0xa9, 0xbf, 0xf4, 0xc7, 0x00, 0x92, 0x4f, 0xd6, 0xe7, 0x54, 0x11, 0x00, 0x00,
+}
+
+func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*MsgCreateVestingAccount)
+	if !ok {
+		that2, ok := that.(MsgCreateVestingAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.FromAddress, that1.FromAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ToAddress, that1.ToAddress) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.StartTime != that1.StartTime {
+		return false
+	}
+	if this.EndTime != that1.EndTime {
+		return false
+	}
+	if this.Delayed != that1.Delayed {
+		return false
+	}
+	return true
+}
 func (this *FeeMultiplier) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -735,7 +1026,7 @@ func (m *ProtoMultiSigAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeMultiplier) Marshal() (dAtA []byte, err error) {
+func (m *ProtoBaseVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -745,32 +1036,77 @@ func (m *FeeMultiplier) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProtoBaseVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProtoBaseVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Multiplier != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.Multiplier))
+	if m.EndTime != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.EndTime))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x28
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.DelegatedVesting) > 0 {
+		for iNdEx := len(m.DelegatedVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for iNdEx := len(m.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedFree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OriginalVesting) > 0 {
+		for iNdEx := len(m.OriginalVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ProtoBaseAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuth(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeMultipliers) Marshal() (dAtA []byte, err error) {
+func (m *ProtoContinuousVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -780,39 +1116,35 @@ func (m *FeeMultipliers) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeMultipliers) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProtoContinuousVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeMultipliers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProtoContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Default != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.Default))
+	if m.StartTime != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.StartTime))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.FeeMultis) > 0 {
-		for iNdEx := len(m.FeeMultis) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeMultis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuth(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.ProtoBaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintAuth(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Supply) Marshal() (dAtA []byte, err error) {
+func (m *ProtoDelayedVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -822,28 +1154,205 @@ func (m *Supply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProtoDelayedVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProtoDelayedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuth(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.ProtoBaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delayed {
+		i--
+		if m.Delayed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Multiplier != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Multiplier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMultipliers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMultipliers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMultipliers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Default != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Default))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeeMultis) > 0 {
+		for iNdEx := len(m.FeeMultis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeMultis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Supply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
@@ -869,6 +1378,46 @@ func (m *ProtoStdTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeePayer != nil {
+		{
+			size, err := m.FeePayer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.Entropy != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Entropy))
 		i--
@@ -975,6 +1524,13 @@ func (m *StdSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Entropy != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Entropy))
 		i--
@@ -1089,6 +1645,91 @@ func (m *ProtoMultiSigAccount) Size() (n int) {
 	return n
 }
 
+func (m *ProtoBaseVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProtoBaseAccount.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if len(m.OriginalVesting) > 0 {
+		for _, e := range m.OriginalVesting {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for _, e := range m.DelegatedFree {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.DelegatedVesting) > 0 {
+		for _, e := range m.DelegatedVesting {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovAuth(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *ProtoContinuousVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProtoBaseVestingAccount.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovAuth(uint64(m.StartTime))
+	}
+	return n
+}
+
+func (m *ProtoDelayedVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProtoBaseVestingAccount.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *MsgCreateVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovAuth(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovAuth(uint64(m.EndTime))
+	}
+	if m.Delayed {
+		n += 2
+	}
+	return n
+}
+
 func (m *FeeMultiplier) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1161,12 +1802,28 @@ func (m *ProtoStdTx) Size() (n int) {
 	if m.Entropy != 0 {
 		n += 1 + sovAuth(uint64(m.Entropy))
 	}
-	return n
-}
-
-func (m *ProtoStdSignature) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.FeePayer != nil {
+		l = m.FeePayer.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *ProtoStdSignature) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1206,6 +1863,10 @@ func (m *StdSignDoc) Size() (n int) {
 	if m.Entropy != 0 {
 		n += 1 + sovAuth(uint64(m.Entropy))
 	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -1451,24 +2112,576 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoMultiSigAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoMultiSigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoBaseVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoBaseVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoBaseVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtoBaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalVesting = append(m.OriginalVesting, types.Coin{})
+			if err := m.OriginalVesting[len(m.OriginalVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedVesting = append(m.DelegatedVesting, types.Coin{})
+			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoContinuousVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoContinuousVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtoBaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoDelayedVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoDelayedVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoDelayedVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseVestingAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1478,23 +2691,24 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			if err := m.ProtoBaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1520,7 +2734,7 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1543,15 +2757,15 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoMultiSigAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoMultiSigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1578,14 +2792,14 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1612,16 +2826,16 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1631,26 +2845,84 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delayed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -2152,6 +3424,110 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, ProtoStdSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeePayer == nil {
+				m.FeePayer = &ProtoStdSignature{}
+			}
+			if err := m.FeePayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -2477,6 +3853,40 @@ func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = append(m.FeePayer[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeePayer == nil {
+				m.FeePayer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -15,6 +15,12 @@ const (
 	CodeDupTx               sdk.CodeType = 6
 	CodeInsufficientBalance sdk.CodeType = 7
 	CodeTxIndexerNil        sdk.CodeType = 8
+	CodeMsgLimit            sdk.CodeType = 9
+	CodeMultiMsgTxDisabled  sdk.CodeType = 10
+	CodeVestingDisabled     sdk.CodeType = 11
+	CodeInvalidVesting      sdk.CodeType = 12
+	CodeAccountExists       sdk.CodeType = 13
+	CodeFeePayerDisabled    sdk.CodeType = 14
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -49,3 +55,27 @@ func ErrDuplicateTx(codespace sdk.CodespaceType, txHash string) sdk.Error {
 func ErrInsufficientBalance(codespace sdk.CodespaceType, signer sdk.Address, neededFee sdk.Coins) sdk.Error {
 	return sdk.NewError(codespace, CodeDupTx, fmt.Sprintf("the signer account : %s, does not have enough coins for the tx. Need %s", signer, neededFee.String()))
 }
+
+func ErrTooManyMsgs(codespace sdk.CodespaceType, msgLimit uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeMsgLimit, fmt.Sprintf("the limit for messages (%d) of a transaction is reached", msgLimit))
+}
+
+func ErrMultiMsgTxDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMultiMsgTxDisabled, "multi message transactions are not enabled")
+}
+
+func ErrVestingAccountDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeVestingDisabled, "vesting accounts are not enabled")
+}
+
+func ErrInvalidVesting(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVesting, fmt.Sprintf("the vesting schedule is invalid: %s", err.Error()))
+}
+
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountExists, fmt.Sprintf("the account %s already exists", addr))
+}
+
+func ErrFeePayerDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeePayerDisabled, "fee payers are not enabled")
+}
@@ -2,11 +2,57 @@ package types
 
 import "github.com/pokt-network/pocket-core/types"
 
+const (
+	MsgCreateVestingAccountFee = 10000
+)
+
+var (
+	AuthFeeMap = map[string]int64{
+		MsgCreateVestingAccountName: MsgCreateVestingAccountFee,
+	}
+)
+
+// MsgFee - The fee required by a message type: its base fee times its multiplier
+type MsgFee struct {
+	MsgType           string       `json:"msg_type"`
+	BaseFee           types.BigInt `json:"base_fee"`
+	Multiplier        int64        `json:"multiplier"`
+	DefaultMultiplier bool         `json:"default_multiplier"`
+	Fee               types.BigInt `json:"fee"`
+}
+
 func (fm FeeMultipliers) GetFee(msg types.Msg) types.BigInt {
+	multiplier, _ := fm.GetMultiplier(msg.Type())
+	return msg.GetFee().Mul(types.NewInt(multiplier))
+}
+
+// GetFees - The fee of a list of messages, the sum of the fee of each of them
+func (fm FeeMultipliers) GetFees(msgs []types.Msg) types.BigInt {
+	fee := types.ZeroInt()
+	for _, msg := range msgs {
+		fee = fee.Add(fm.GetFee(msg))
+	}
+	return fee
+}
+
+// GetMultiplier - The multiplier of the msg type, the default one if the type has none
+func (fm FeeMultipliers) GetMultiplier(msgType string) (multiplier int64, isDefault bool) {
 	for _, feeMultiplier := range fm.FeeMultis {
-		if feeMultiplier.Key == msg.Type() {
-			return msg.GetFee().Mul(types.NewInt(feeMultiplier.Multiplier))
+		if feeMultiplier.Key == msgType {
+			return feeMultiplier.Multiplier, false
 		}
 	}
-	return msg.GetFee().Mul(types.NewInt(fm.Default))
+	return fm.Default, true
+}
+
+// GetMsgFee - The breakdown of the fee required by the msg type given its base fee
+func (fm FeeMultipliers) GetMsgFee(msgType string, baseFee types.BigInt) MsgFee {
+	multiplier, isDefault := fm.GetMultiplier(msgType)
+	return MsgFee{
+		MsgType:           msgType,
+		BaseFee:           baseFee,
+		Multiplier:        multiplier,
+		DefaultMultiplier: isDefault,
+		Fee:               baseFee.Mul(types.NewInt(multiplier)),
+	}
 }
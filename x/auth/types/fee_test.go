@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeMultipliers_GetMsgFee(t *testing.T) {
+	fm := FeeMultipliers{
+		FeeMultis: []FeeMultiplier{{Key: "send", Multiplier: 3}},
+		Default:   2,
+	}
+	fee := fm.GetMsgFee("send", sdk.NewInt(10000))
+	require.Equal(t, int64(3), fee.Multiplier)
+	require.False(t, fee.DefaultMultiplier)
+	require.True(t, fee.Fee.Equal(sdk.NewInt(30000)))
+	fee = fm.GetMsgFee("app_stake", sdk.NewInt(10000))
+	require.Equal(t, int64(2), fee.Multiplier)
+	require.True(t, fee.DefaultMultiplier)
+	require.True(t, fee.Fee.Equal(sdk.NewInt(20000)))
+	require.True(t, fee.BaseFee.Equal(sdk.NewInt(10000)))
+}
@@ -33,6 +33,16 @@ func ValidateGenesis(data GenesisState) error {
 		if account.GetPubKey().PubKey() == nil {
 			return fmt.Errorf("PubKey should never be nil")
 		}
+		switch vacc := account.(type) {
+		case *ContinuousVestingAccount:
+			if err := vacc.Validate(); err != nil {
+				return fmt.Errorf("invalid vesting account %s: %s", vacc.Address, err.Error())
+			}
+		case *DelayedVestingAccount:
+			if err := vacc.Validate(); err != nil {
+				return fmt.Errorf("invalid vesting account %s: %s", vacc.Address, err.Error())
+			}
+		}
 	}
 	if data.Params.MaxMemoCharacters == 0 {
 		return fmt.Errorf("invalid max memo characters: %d", data.Params.MaxMemoCharacters)
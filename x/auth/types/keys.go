@@ -13,6 +13,8 @@ const (
 	FeeCollectorName = "fee_collector"
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = StoreKey
+	// RouterKey is the message route for auth
+	RouterKey = ModuleName
 	// default codespace
 	DefaultCodespace = ModuleName
 )
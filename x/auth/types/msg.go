@@ -0,0 +1,79 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// ensure ProtoMsg interface compliance at compile time
+var (
+	_ sdk.ProtoMsg = &MsgCreateVestingAccount{}
+)
+
+const (
+	MsgCreateVestingAccountName = "create_vesting_account"
+)
+
+// MsgCreateVestingAccount structure for funding a new vesting account
+// type MsgCreateVestingAccount struct {
+// 	FromAddress sdk.Address `json:"from_address"`
+// 	ToAddress   sdk.Address `json:"to_address"`
+// 	Amount      sdk.BigInt  `json:"amount"`
+// 	StartTime   int64       `json:"start_time"` // unix seconds, unused by delayed vesting
+// 	EndTime     int64       `json:"end_time"`   // unix seconds
+// 	Delayed     bool        `json:"delayed"`    // all of the amount vests at the end time
+// }
+
+// Route provides router key for msg
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgCreateVestingAccount) Type() string { return MsgCreateVestingAccountName }
+
+// GetFee get fee for msg
+func (msg MsgCreateVestingAccount) GetFee() sdk.BigInt {
+	return sdk.NewInt(AuthFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetRecipient returns the vesting account funded
+func (msg MsgCreateVestingAccount) GetRecipient() sdk.Address {
+	return msg.ToAddress
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing from address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing to address")
+	}
+	if msg.Amount.IsZero() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins("the amount of a vesting account must be positive")
+	}
+	if msg.EndTime <= 0 {
+		return ErrInvalidVesting(DefaultCodespace, errors.New("the end time must be positive"))
+	}
+	if msg.Delayed {
+		if msg.StartTime != 0 {
+			return ErrInvalidVesting(DefaultCodespace, errors.New("a delayed vesting account has no start time"))
+		}
+		return nil
+	}
+	if msg.StartTime <= 0 || msg.StartTime >= msg.EndTime {
+		return ErrInvalidVesting(DefaultCodespace, errors.New("the start time must be positive and before the end time"))
+	}
+	return nil
+}
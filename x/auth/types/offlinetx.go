@@ -0,0 +1,188 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/libs/rand"
+)
+
+// OfflineTx - A portable json representation of a single signer transaction, generated on a machine connected to the
+// network and signed on one that isn't. It captures the chain data needed to sign and encode it offline. When it has
+// a fee payer, the fee payer co-signs it and pays its fee instead of the signer
+type OfflineTx struct {
+	ChainID            string          `json:"chain_id"`
+	Msg                json.RawMessage `json:"msg"`
+	Fee                sdk.Coins       `json:"fee"`
+	Memo               string          `json:"memo"`
+	Entropy            int64           `json:"entropy"`
+	Signer             string          `json:"signer"`
+	Height             int64           `json:"height"`
+	CodecUpgradeHeight int64           `json:"codec_upgrade_height"`
+	RequiredFee        MsgFee          `json:"required_fee"`
+	PublicKey          string          `json:"public_key,omitempty"`
+	Signature          string          `json:"signature,omitempty"`
+	FeePayer           string          `json:"fee_payer,omitempty"`
+	FeePayerPublicKey  string          `json:"fee_payer_public_key,omitempty"`
+	FeePayerSignature  string          `json:"fee_payer_signature,omitempty"`
+}
+
+// NewOfflineTx - An unsigned transaction of msg from signer, with the chain data captured at height
+func NewOfflineTx(cdc *codec.Codec, chainID string, msg sdk.ProtoMsg, fee sdk.Coins, memo string, signer sdk.Address, height, codecUpgradeHeight int64, requiredFee MsgFee) (OfflineTx, error) {
+	if chainID == "" {
+		return OfflineTx{}, errors.New("cant build the transaction: the chainID is empty")
+	}
+	msgJSON, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return OfflineTx{}, err
+	}
+	return OfflineTx{
+		ChainID:            chainID,
+		Msg:                msgJSON,
+		Fee:                fee,
+		Memo:               memo,
+		Entropy:            rand.Int64(),
+		Signer:             signer.String(),
+		Height:             height,
+		CodecUpgradeHeight: codecUpgradeHeight,
+		RequiredFee:        requiredFee,
+	}, nil
+}
+
+// GetMsg - The message of the transaction
+func (otx OfflineTx) GetMsg(cdc *codec.Codec) (sdk.ProtoMsg, error) {
+	return MsgFromJSON(cdc, otx.Msg)
+}
+
+// WithFeePayer - The transaction with its fee paid by feePayer; the signatures of the previous sign bytes are removed
+func (otx OfflineTx) WithFeePayer(feePayer sdk.Address) OfflineTx {
+	otx.FeePayer = feePayer.String()
+	otx.PublicKey, otx.Signature = "", ""
+	otx.FeePayerPublicKey, otx.FeePayerSignature = "", ""
+	return otx
+}
+
+// HasFeePayer - Whether the fee of the transaction is paid by a fee payer instead of the signer
+func (otx OfflineTx) HasFeePayer() bool {
+	return otx.FeePayer != ""
+}
+
+// SignBytes - The bytes the signer, and the fee payer, sign
+func (otx OfflineTx) SignBytes(cdc *codec.Codec) ([]byte, error) {
+	msg, err := otx.GetMsg(cdc)
+	if err != nil {
+		return nil, err
+	}
+	var feePayer sdk.Address
+	if otx.HasFeePayer() {
+		feePayer, err = sdk.AddressFromHex(otx.FeePayer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return StdSignBytesWithFeePayer(otx.ChainID, otx.Entropy, otx.Fee, msg, otx.Memo, feePayer)
+}
+
+// IsLegacyCodec - Whether the transaction is encoded with amino, as it was before the codec upgrade
+func (otx OfflineTx) IsLegacyCodec() bool {
+	return otx.Height < otx.CodecUpgradeHeight
+}
+
+// IsSigned - Whether the transaction has the signature of its signer
+func (otx OfflineTx) IsSigned() bool {
+	return otx.Signature != ""
+}
+
+// IsFeePayerSigned - Whether the transaction has the signature of its fee payer
+func (otx OfflineTx) IsFeePayerSigned() bool {
+	return otx.FeePayerSignature != ""
+}
+
+// AddSignature - Add the signature of the signer, after verifying it and the fee against the captured required fee
+func (otx OfflineTx) AddSignature(cdc *codec.Codec, key crypto.PublicKey, sig []byte) (OfflineTx, error) {
+	if sdk.Address(key.Address()).String() != otx.Signer {
+		return otx, fmt.Errorf("the key %s is not the key of the signer %s", key.RawString(), otx.Signer)
+	}
+	if err := otx.verifySignature(cdc, key, sig); err != nil {
+		return otx, err
+	}
+	otx.PublicKey, otx.Signature = key.RawString(), hex.EncodeToString(sig)
+	return otx, nil
+}
+
+// AddFeePayerSignature - Add the signature of the fee payer, after verifying it and the fee against the captured
+// required fee
+func (otx OfflineTx) AddFeePayerSignature(cdc *codec.Codec, key crypto.PublicKey, sig []byte) (OfflineTx, error) {
+	if !otx.HasFeePayer() {
+		return otx, errors.New("the transaction has no fee payer")
+	}
+	if sdk.Address(key.Address()).String() != otx.FeePayer {
+		return otx, fmt.Errorf("the key %s is not the key of the fee payer %s", key.RawString(), otx.FeePayer)
+	}
+	if err := otx.verifySignature(cdc, key, sig); err != nil {
+		return otx, err
+	}
+	otx.FeePayerPublicKey, otx.FeePayerSignature = key.RawString(), hex.EncodeToString(sig)
+	return otx, nil
+}
+
+func (otx OfflineTx) verifySignature(cdc *codec.Codec, key crypto.PublicKey, sig []byte) error {
+	if otx.Fee.AmountOf(sdk.DefaultStakeDenom).LT(otx.RequiredFee.Fee) {
+		return fmt.Errorf("the fee %s is lower than the fee %s%s required by the message", otx.Fee, otx.RequiredFee.Fee, sdk.DefaultStakeDenom)
+	}
+	signBytes, err := otx.SignBytes(cdc)
+	if err != nil {
+		return err
+	}
+	if !key.VerifyBytes(signBytes, sig) {
+		return fmt.Errorf("the signature of %s is not valid for the transaction", key.RawString())
+	}
+	return nil
+}
+
+// Finalize - The signed transaction
+func (otx OfflineTx) Finalize(cdc *codec.Codec) (StdTx, error) {
+	if !otx.IsSigned() {
+		return StdTx{}, errors.New("the transaction is not signed")
+	}
+	if otx.HasFeePayer() && !otx.IsFeePayerSigned() {
+		return StdTx{}, errors.New("the transaction is not signed by its fee payer")
+	}
+	msg, err := otx.GetMsg(cdc)
+	if err != nil {
+		return StdTx{}, err
+	}
+	publicKey, err := crypto.NewPublicKey(otx.PublicKey)
+	if err != nil {
+		return StdTx{}, err
+	}
+	sig, err := hex.DecodeString(otx.Signature)
+	if err != nil {
+		return StdTx{}, err
+	}
+	var feePayer *StdSignature
+	if otx.HasFeePayer() {
+		feePayerKey, err := crypto.NewPublicKey(otx.FeePayerPublicKey)
+		if err != nil {
+			return StdTx{}, err
+		}
+		feePayerSig, err := hex.DecodeString(otx.FeePayerSignature)
+		if err != nil {
+			return StdTx{}, err
+		}
+		feePayer = &StdSignature{PublicKey: feePayerKey, Signature: feePayerSig}
+	}
+	return StdTx{
+		Msg:       msg,
+		Fee:       otx.Fee,
+		Signature: StdSignature{PublicKey: publicKey, Signature: sig},
+		Memo:      otx.Memo,
+		Entropy:   otx.Entropy,
+		FeePayer:  feePayer,
+	}, nil
+}
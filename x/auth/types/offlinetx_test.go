@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfflineTx_SignAndFinalize(t *testing.T) {
+	cdc, _, _ := partialTxSetup(t, 0)
+	privateKey := crypto.GenerateEd25519PrivKey()
+	signer := sdk.Address(privateKey.PublicKey().Address())
+	msg := &nodesTypes.MsgSend{FromAddress: signer, ToAddress: signer, Amount: sdk.NewInt(1)}
+	requiredFee := FeeMultipliers{Default: 2}.GetMsgFee(msg.Type(), sdk.NewInt(10000))
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(20000)))
+	otx, err := NewOfflineTx(cdc, "test", msg, fee, "memo", signer, 100, 50, requiredFee)
+	require.Nil(t, err)
+	require.False(t, otx.IsLegacyCodec())
+	require.False(t, otx.IsSigned())
+	_, err = otx.Finalize(cdc)
+	require.NotNil(t, err)
+	signBytes, err := otx.SignBytes(cdc)
+	require.Nil(t, err)
+	// only the signer may sign
+	other := crypto.GenerateEd25519PrivKey()
+	sig, err := other.Sign(signBytes)
+	require.Nil(t, err)
+	_, err = otx.AddSignature(cdc, other.PublicKey(), sig)
+	require.NotNil(t, err)
+	// the fee must cover the fee required when the transaction was generated
+	lowFee := otx
+	lowFee.Fee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10000)))
+	lowSignBytes, err := lowFee.SignBytes(cdc)
+	require.Nil(t, err)
+	sig, err = privateKey.Sign(lowSignBytes)
+	require.Nil(t, err)
+	_, err = lowFee.AddSignature(cdc, privateKey.PublicKey(), sig)
+	require.NotNil(t, err)
+	// a signature of other bytes
+	_, err = otx.AddSignature(cdc, privateKey.PublicKey(), sig)
+	require.NotNil(t, err)
+	sig, err = privateKey.Sign(signBytes)
+	require.Nil(t, err)
+	otx, err = otx.AddSignature(cdc, privateKey.PublicKey(), sig)
+	require.Nil(t, err)
+	require.True(t, otx.IsSigned())
+	tx, err := otx.Finalize(cdc)
+	require.Nil(t, err)
+	require.Nil(t, tx.ValidateBasic())
+	require.True(t, tx.Signature.PublicKey.VerifyBytes(signBytes, tx.Signature.Signature))
+	require.Equal(t, otx.Entropy, tx.Entropy)
+}
+
+func TestOfflineTx_FeePayer(t *testing.T) {
+	cdc, _, _ := partialTxSetup(t, 0)
+	privateKey, payerKey := crypto.GenerateEd25519PrivKey(), crypto.GenerateEd25519PrivKey()
+	signer, payer := sdk.Address(privateKey.PublicKey().Address()), sdk.Address(payerKey.PublicKey().Address())
+	msg := &nodesTypes.MsgSend{FromAddress: signer, ToAddress: signer, Amount: sdk.NewInt(1)}
+	requiredFee := FeeMultipliers{Default: 1}.GetMsgFee(msg.Type(), sdk.NewInt(10000))
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10000)))
+	otx, err := NewOfflineTx(cdc, "test", msg, fee, "", signer, 100, 50, requiredFee)
+	require.Nil(t, err)
+	require.False(t, otx.HasFeePayer())
+	_, err = otx.AddFeePayerSignature(cdc, payerKey.PublicKey(), nil)
+	require.NotNil(t, err)
+	otx = otx.WithFeePayer(payer)
+	require.True(t, otx.HasFeePayer())
+	signBytes, err := otx.SignBytes(cdc)
+	require.Nil(t, err)
+	expected, err := StdSignBytesWithFeePayer("test", otx.Entropy, fee, msg, "", payer)
+	require.Nil(t, err)
+	require.Equal(t, expected, signBytes)
+	// only the fee payer may sign as the fee payer
+	sig, err := privateKey.Sign(signBytes)
+	require.Nil(t, err)
+	_, err = otx.AddFeePayerSignature(cdc, privateKey.PublicKey(), sig)
+	require.NotNil(t, err)
+	otx, err = otx.AddSignature(cdc, privateKey.PublicKey(), sig)
+	require.Nil(t, err)
+	// both must sign
+	_, err = otx.Finalize(cdc)
+	require.NotNil(t, err)
+	feePayerSig, err := payerKey.Sign(signBytes)
+	require.Nil(t, err)
+	otx, err = otx.AddFeePayerSignature(cdc, payerKey.PublicKey(), feePayerSig)
+	require.Nil(t, err)
+	require.True(t, otx.IsFeePayerSigned())
+	tx, err := otx.Finalize(cdc)
+	require.Nil(t, err)
+	require.Nil(t, tx.ValidateBasic())
+	require.Equal(t, payer, tx.GetFeePayer())
+	require.True(t, tx.FeePayer.PublicKey.VerifyBytes(signBytes, tx.FeePayer.Signature))
+	// changing the fee payer removes the signatures
+	otx = otx.WithFeePayer(sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address()))
+	require.False(t, otx.IsSigned())
+	require.False(t, otx.IsFeePayerSigned())
+}
@@ -10,6 +10,7 @@ import (
 const (
 	DefaultMaxMemoCharacters uint64 = 256
 	DefaultTxSigLimit        uint64 = 7
+	DefaultMaxMsgsPerTx      uint64 = 256
 )
 
 // Parameter keys
@@ -17,6 +18,7 @@ var (
 	KeyMaxMemoCharacters = []byte("MaxMemoCharacters")
 	KeyTxSigLimit        = []byte("TxSigLimit")
 	KeyFeeMultiplier     = []byte("FeeMultipliers")
+	KeyMaxMsgsPerTx      = []byte("MaxMsgsPerTx")
 	DefaultFeeMultiplier = FeeMultipliers{
 		FeeMultis: nil,
 		Default:   1,
@@ -30,6 +32,7 @@ type Params struct {
 	MaxMemoCharacters uint64         `json:"max_memo_characters" yaml:"max_memo_characters"`
 	TxSigLimit        uint64         `json:"tx_sig_limit" yaml:"tx_sig_limit"`
 	FeeMultiplier     FeeMultipliers `json:"fee_multipliers"`
+	MaxMsgsPerTx      uint64         `json:"max_msgs_per_tx" yaml:"max_msgs_per_tx"`
 }
 
 // ParamKeyTable for auth module
@@ -45,6 +48,7 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: KeyMaxMemoCharacters, Value: &p.MaxMemoCharacters},
 		{Key: KeyTxSigLimit, Value: &p.TxSigLimit},
 		{Key: KeyFeeMultiplier, Value: &p.FeeMultiplier},
+		{Key: KeyMaxMsgsPerTx, Value: &p.MaxMsgsPerTx},
 	}
 }
 
@@ -59,6 +63,7 @@ func DefaultParams() Params {
 		MaxMemoCharacters: DefaultMaxMemoCharacters,
 		TxSigLimit:        DefaultTxSigLimit,
 		FeeMultiplier:     DefaultFeeMultiplier,
+		MaxMsgsPerTx:      DefaultMaxMsgsPerTx,
 	}
 }
 
@@ -69,5 +74,6 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("MaxMemoCharacters: %d\n", p.MaxMemoCharacters))
 	sb.WriteString(fmt.Sprintf("TxSigLimit: %d\n", p.TxSigLimit))
 	sb.WriteString(fmt.Sprintf("FeeMultiplier: %v\n", p.FeeMultiplier))
+	sb.WriteString(fmt.Sprintf("MaxMsgsPerTx: %d\n", p.MaxMsgsPerTx))
 	return sb.String()
 }
@@ -0,0 +1,267 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/libs/rand"
+)
+
+// PartiallySignedTx - A portable json representation of an unsigned or partially signed multisig transaction,
+// passed between the signers until it has the signatures it needs
+type PartiallySignedTx struct {
+	ChainID      string             `json:"chain_id"`
+	Msg          json.RawMessage    `json:"msg"`
+	Fee          sdk.Coins          `json:"fee"`
+	Memo         string             `json:"memo"`
+	Entropy      int64              `json:"entropy"`
+	Address      string             `json:"address"`
+	PublicKey    string             `json:"public_key"`
+	Threshold    int                `json:"threshold"`
+	RequiredKeys []string           `json:"required_keys"`
+	Signatures   []PartialSignature `json:"signatures"`
+}
+
+// PartialSignature - The signature of one of the keys of the multisig
+type PartialSignature struct {
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"`
+}
+
+// PartialTxStatus - The signatures collected and missing of a partially signed transaction
+type PartialTxStatus struct {
+	Address   string   `json:"address"`
+	Threshold int      `json:"threshold"`
+	Signed    []string `json:"signed"`
+	Unsigned  []string `json:"unsigned"`
+	Complete  bool     `json:"complete"`
+}
+
+// NewPartiallySignedTx - An unsigned transaction of msg from the multisig public key
+func NewPartiallySignedTx(cdc *codec.Codec, chainID string, msg sdk.ProtoMsg, fee sdk.Coins, memo string, publicKey crypto.PublicKeyMultiSig) (PartiallySignedTx, error) {
+	if chainID == "" {
+		return PartiallySignedTx{}, errors.New("cant build the transaction: the chainID is empty")
+	}
+	msgJSON, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return PartiallySignedTx{}, err
+	}
+	keys := make([]string, 0, len(publicKey.Keys()))
+	for _, key := range publicKey.Keys() {
+		keys = append(keys, key.RawString())
+	}
+	return PartiallySignedTx{
+		ChainID:      chainID,
+		Msg:          msgJSON,
+		Fee:          fee,
+		Memo:         memo,
+		Entropy:      rand.Int64(),
+		Address:      sdk.Address(publicKey.Address()).String(),
+		PublicKey:    publicKey.RawString(),
+		Threshold:    threshold(publicKey),
+		RequiredKeys: keys,
+		Signatures:   make([]PartialSignature, 0),
+	}, nil
+}
+
+// GetMsg - The message of the transaction
+func (ptx PartiallySignedTx) GetMsg(cdc *codec.Codec) (sdk.ProtoMsg, error) {
+	return MsgFromJSON(cdc, ptx.Msg)
+}
+
+// MsgFromJSON - The proto message of its amino json
+func MsgFromJSON(cdc *codec.Codec, bz []byte) (sdk.ProtoMsg, error) {
+	var m sdk.Msg
+	if err := cdc.UnmarshalJSON(bz, &m); err != nil {
+		return nil, err
+	}
+	if protoMsg, ok := m.(sdk.ProtoMsg); ok && reflect.ValueOf(m).Kind() == reflect.Ptr {
+		return protoMsg, nil
+	}
+	// use reflection to convert to proto msg
+	val := reflect.ValueOf(m)
+	vp := reflect.New(val.Type())
+	vp.Elem().Set(val)
+	protoMsg, ok := vp.Interface().(sdk.ProtoMsg)
+	if !ok {
+		return nil, fmt.Errorf("the message %s is not a proto message", m.Type())
+	}
+	return protoMsg, nil
+}
+
+// GetPublicKey - The multisig public key of the transaction
+func (ptx PartiallySignedTx) GetPublicKey() (crypto.PublicKeyMultiSig, error) {
+	pk, err := crypto.NewPublicKey(ptx.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, ok := pk.(crypto.PublicKeyMultiSig)
+	if !ok {
+		return nil, errors.New("the public key of the transaction is not a multisig public key")
+	}
+	return publicKey, nil
+}
+
+// SignBytes - The bytes each key of the multisig signs
+func (ptx PartiallySignedTx) SignBytes(cdc *codec.Codec) ([]byte, error) {
+	msg, err := ptx.GetMsg(cdc)
+	if err != nil {
+		return nil, err
+	}
+	return StdSignBytes(ptx.ChainID, ptx.Entropy, ptx.Fee, msg, ptx.Memo)
+}
+
+// AddSignature - Add (or replace) the signature of one of the keys of the multisig, after verifying it
+func (ptx PartiallySignedTx) AddSignature(cdc *codec.Codec, key crypto.PublicKey, sig []byte) (PartiallySignedTx, error) {
+	publicKey, err := ptx.GetPublicKey()
+	if err != nil {
+		return ptx, err
+	}
+	if keyIndex(key, publicKey.Keys()) == -1 {
+		return ptx, fmt.Errorf("the key %s is not one of the keys of the multisig", key.RawString())
+	}
+	signBytes, err := ptx.SignBytes(cdc)
+	if err != nil {
+		return ptx, err
+	}
+	if !key.VerifyBytes(signBytes, sig) {
+		return ptx, fmt.Errorf("the signature of %s is not valid for the transaction", key.RawString())
+	}
+	signatures := make([]PartialSignature, 0, len(ptx.Signatures)+1)
+	for _, s := range ptx.Signatures {
+		if s.PublicKey != key.RawString() {
+			signatures = append(signatures, s)
+		}
+	}
+	ptx.Signatures = append(signatures, PartialSignature{PublicKey: key.RawString(), Signature: hex.EncodeToString(sig)})
+	return ptx, nil
+}
+
+// Combine - Merge the signatures of other copies of the same transaction
+func (ptx PartiallySignedTx) Combine(cdc *codec.Codec, others ...PartiallySignedTx) (PartiallySignedTx, error) {
+	signBytes, err := ptx.SignBytes(cdc)
+	if err != nil {
+		return ptx, err
+	}
+	for _, other := range others {
+		otherSignBytes, err := other.SignBytes(cdc)
+		if err != nil {
+			return ptx, err
+		}
+		if !bytes.Equal(signBytes, otherSignBytes) || other.PublicKey != ptx.PublicKey {
+			return ptx, errors.New("the partially signed transactions are not the same transaction")
+		}
+		for _, s := range other.Signatures {
+			key, err := crypto.NewPublicKey(s.PublicKey)
+			if err != nil {
+				return ptx, err
+			}
+			sig, err := hex.DecodeString(s.Signature)
+			if err != nil {
+				return ptx, err
+			}
+			ptx, err = ptx.AddSignature(cdc, key, sig)
+			if err != nil {
+				return ptx, err
+			}
+		}
+	}
+	return ptx, nil
+}
+
+// Status - The keys of the multisig that signed and that didn't
+func (ptx PartiallySignedTx) Status() PartialTxStatus {
+	status := PartialTxStatus{
+		Address:   ptx.Address,
+		Threshold: ptx.Threshold,
+		Signed:    make([]string, 0),
+		Unsigned:  make([]string, 0),
+	}
+	for _, key := range ptx.RequiredKeys {
+		if _, found := ptx.signature(key); found {
+			status.Signed = append(status.Signed, key)
+		} else {
+			status.Unsigned = append(status.Unsigned, key)
+		}
+	}
+	status.Complete = len(status.Signed) >= ptx.Threshold
+	return status
+}
+
+// Finalize - The signed transaction, once it has the signatures the multisig needs
+func (ptx PartiallySignedTx) Finalize(cdc *codec.Codec) (StdTx, error) {
+	publicKey, err := ptx.GetPublicKey()
+	if err != nil {
+		return StdTx{}, err
+	}
+	msg, err := ptx.GetMsg(cdc)
+	if err != nil {
+		return StdTx{}, err
+	}
+	signBytes, err := ptx.SignBytes(cdc)
+	if err != nil {
+		return StdTx{}, err
+	}
+	// a threshold multisig takes exactly its threshold of signatures, in the order of the keys
+	ms, needed := crypto.NewMultiSigForKey(publicKey), threshold(publicKey)
+	for i, key := range publicKey.Keys() {
+		if ms.NumOfSigs() == needed {
+			break
+		}
+		s, found := ptx.signature(key.RawString())
+		if !found {
+			continue
+		}
+		sig, err := hex.DecodeString(s.Signature)
+		if err != nil {
+			return StdTx{}, err
+		}
+		ms = ms.AddSignatureByIndex(sig, i)
+	}
+	if ms.NumOfSigs() < needed {
+		return StdTx{}, fmt.Errorf("the transaction has %d of the %d signatures it needs", ms.NumOfSigs(), needed)
+	}
+	if !publicKey.VerifyBytes(signBytes, ms.Marshal()) {
+		return StdTx{}, errors.New("the signatures of the transaction are not valid")
+	}
+	return StdTx{
+		Msg:       msg,
+		Fee:       ptx.Fee,
+		Signature: StdSignature{PublicKey: publicKey, Signature: ms.Marshal()},
+		Memo:      ptx.Memo,
+		Entropy:   ptx.Entropy,
+	}, nil
+}
+
+func (ptx PartiallySignedTx) signature(key string) (PartialSignature, bool) {
+	for _, s := range ptx.Signatures {
+		if s.PublicKey == key {
+			return s, true
+		}
+	}
+	return PartialSignature{}, false
+}
+
+// threshold - The number of signatures the multisig public key needs
+func threshold(publicKey crypto.PublicKeyMultiSig) int {
+	if pk, ok := publicKey.(crypto.PublicKeyThresholdMultiSignature); ok {
+		return int(pk.Threshold)
+	}
+	return len(publicKey.Keys())
+}
+
+func keyIndex(key crypto.PublicKey, keys []crypto.PublicKey) int {
+	for i, k := range keys {
+		if key.Equals(k) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	codecTypes "github.com/pokt-network/pocket-core/codec/types"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/require"
+)
+
+func partialTxSetup(t *testing.T, threshold uint64) (*codec.Codec, PartiallySignedTx, []crypto.PrivateKey) {
+	cdc := codec.NewCodec(codecTypes.NewInterfaceRegistry())
+	sdk.RegisterCodec(cdc)
+	nodesTypes.RegisterCodec(cdc)
+	crypto.RegisterAmino(cdc.AminoCodec().Amino)
+	privateKeys := []crypto.PrivateKey{crypto.GenerateEd25519PrivKey(), crypto.GenerateEd25519PrivKey(), crypto.GenerateEd25519PrivKey()}
+	keys := []crypto.PublicKey{privateKeys[0].PublicKey(), privateKeys[1].PublicKey(), privateKeys[2].PublicKey()}
+	var publicKey crypto.PublicKeyMultiSig = crypto.PublicKeyMultiSignature{PublicKeys: keys}
+	if threshold != 0 {
+		var err error
+		publicKey, err = crypto.NewThresholdMultiKey(threshold, keys...)
+		require.Nil(t, err)
+	}
+	msg := &nodesTypes.MsgSend{
+		FromAddress: sdk.Address(publicKey.Address()),
+		ToAddress:   sdk.Address(keys[0].Address()),
+		Amount:      sdk.NewInt(1),
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10000)))
+	ptx, err := NewPartiallySignedTx(cdc, "test", msg, fee, "memo", publicKey)
+	require.Nil(t, err)
+	return cdc, ptx, privateKeys
+}
+
+func signPartialTx(t *testing.T, cdc *codec.Codec, ptx PartiallySignedTx, privateKey crypto.PrivateKey) PartiallySignedTx {
+	signBytes, err := ptx.SignBytes(cdc)
+	require.Nil(t, err)
+	sig, err := privateKey.Sign(signBytes)
+	require.Nil(t, err)
+	ptx, err = ptx.AddSignature(cdc, privateKey.PublicKey(), sig)
+	require.Nil(t, err)
+	return ptx
+}
+
+func TestPartiallySignedTx_SignAndFinalize(t *testing.T) {
+	cdc, ptx, privateKeys := partialTxSetup(t, 0)
+	require.Equal(t, 3, ptx.Threshold)
+	require.Len(t, ptx.RequiredKeys, 3)
+	msg, err := ptx.GetMsg(cdc)
+	require.Nil(t, err)
+	require.Equal(t, "send", msg.Type())
+	// out of order, and a signature replaced by a new one of the same key
+	ptx = signPartialTx(t, cdc, ptx, privateKeys[2])
+	ptx = signPartialTx(t, cdc, ptx, privateKeys[0])
+	ptx = signPartialTx(t, cdc, ptx, privateKeys[2])
+	status := ptx.Status()
+	require.Equal(t, []string{ptx.RequiredKeys[0], ptx.RequiredKeys[2]}, status.Signed)
+	require.Equal(t, []string{ptx.RequiredKeys[1]}, status.Unsigned)
+	require.False(t, status.Complete)
+	_, err = ptx.Finalize(cdc)
+	require.NotNil(t, err)
+	ptx = signPartialTx(t, cdc, ptx, privateKeys[1])
+	require.True(t, ptx.Status().Complete)
+	tx, err := ptx.Finalize(cdc)
+	require.Nil(t, err)
+	require.Nil(t, tx.ValidateBasic())
+	signBytes, err := ptx.SignBytes(cdc)
+	require.Nil(t, err)
+	require.True(t, tx.Signature.PublicKey.VerifyBytes(signBytes, tx.Signature.Signature))
+	require.Equal(t, ptx.Entropy, tx.Entropy)
+	require.Equal(t, "memo", tx.Memo)
+}
+
+func TestPartiallySignedTx_CombineThreshold(t *testing.T) {
+	cdc, ptx, privateKeys := partialTxSetup(t, 2)
+	require.Equal(t, 2, ptx.Threshold)
+	// each signer signs its own copy
+	ptx0 := signPartialTx(t, cdc, ptx, privateKeys[0])
+	ptx1 := signPartialTx(t, cdc, ptx, privateKeys[1])
+	ptx2 := signPartialTx(t, cdc, ptx, privateKeys[2])
+	combined, err := ptx2.Combine(cdc, ptx0, ptx1)
+	require.Nil(t, err)
+	require.Len(t, combined.Status().Signed, 3)
+	require.True(t, combined.Status().Complete)
+	// the transaction takes exactly the threshold of the signatures
+	tx, err := combined.Finalize(cdc)
+	require.Nil(t, err)
+	signBytes, err := ptx.SignBytes(cdc)
+	require.Nil(t, err)
+	require.True(t, tx.Signature.PublicKey.VerifyBytes(signBytes, tx.Signature.Signature))
+	// not the same transaction
+	other := ptx0
+	other.Memo = "other"
+	_, err = ptx1.Combine(cdc, other)
+	require.NotNil(t, err)
+}
+
+func TestPartiallySignedTx_AddSignatureInvalid(t *testing.T) {
+	cdc, ptx, privateKeys := partialTxSetup(t, 0)
+	signBytes, err := ptx.SignBytes(cdc)
+	require.Nil(t, err)
+	// a key that isn't one of the multisig
+	outsider := crypto.GenerateEd25519PrivKey()
+	sig, err := outsider.Sign(signBytes)
+	require.Nil(t, err)
+	_, err = ptx.AddSignature(cdc, outsider.PublicKey(), sig)
+	require.NotNil(t, err)
+	// a signature of other bytes
+	sig, err = privateKeys[0].Sign([]byte("foo"))
+	require.Nil(t, err)
+	_, err = ptx.AddSignature(cdc, privateKeys[0].PublicKey(), sig)
+	require.NotNil(t, err)
+	// a signature of another key
+	sig, err = privateKeys[1].Sign(signBytes)
+	require.Nil(t, err)
+	_, err = ptx.AddSignature(cdc, privateKeys[0].PublicKey(), sig)
+	require.NotNil(t, err)
+	require.Len(t, ptx.Signatures, 0)
+}
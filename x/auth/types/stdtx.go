@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/pokt-network/pocket-core/codec"
@@ -27,6 +28,22 @@ func NewTx(msgs sdk.ProtoMsg, fee sdk.Coins, sig StdSignature, memo string, entr
 	}
 }
 
+// NewMultiMsgTx - A transaction of an ordered list of messages, executed atomically, signed by every signer of them.
+// NOTE: the first signature is the fee payer
+func NewMultiMsgTx(msgs []sdk.ProtoMsg, fee sdk.Coins, sigs []StdSignature, memo string, entropy int64) StdTx {
+	m := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		m[i] = msg
+	}
+	return StdTx{
+		Msgs:       m,
+		Fee:        fee,
+		Signatures: sigs,
+		Memo:       memo,
+		Entropy:    entropy,
+	}
+}
+
 // CountSubKeys counts the total number of keys for a multi-sig public key.
 func CountSubKeys(pub crypto.PubKey) int {
 	v, ok := pub.(multisig.PubKeyMultisigThreshold)
@@ -44,17 +61,52 @@ func CountSubKeys(pub crypto.PubKey) int {
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, memo string) ([]byte, error) {
+	return StdSignBytesWithFeePayer(chainID, entropy, fee, msg, memo, nil)
+}
+
+// StdSignBytesWithFeePayer returns the bytes to sign for a transaction whose fee is paid by feePayer; both the signer
+// and the fee payer sign the same bytes. A nil feePayer gives the bytes of StdSignBytes
+func StdSignBytesWithFeePayer(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, memo string, feePayer sdk.Address) ([]byte, error) {
 	msgsBytes := msg.GetSignBytes()
 	var feeBytes sdk.Raw
 	feeBytes, err := fee.MarshalJSON()
 	if err != nil {
 		return nil, fmt.Errorf("could not marshal fee to json for StdSignBytes function: %v", err.Error())
 	}
+	bz, err := ModuleCdc.MarshalJSON(StdSignDoc{
+		ChainID:  chainID,
+		Fee:      feeBytes,
+		Memo:     memo,
+		Msg:      msgsBytes,
+		Entropy:  entropy,
+		FeePayer: feePayer,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal bytes to json for StdSignDoc function: %v", err.Error())
+	}
+	return sdk.MustSortJSON(bz), nil
+}
+
+// StdSignBytesMulti returns the bytes to sign for a multi message transaction; every signer signs the same bytes
+func StdSignBytesMulti(chainID string, entropy int64, fee sdk.Coins, msgs []sdk.Msg, memo string) ([]byte, error) {
+	msgsBytes := make([]json.RawMessage, len(msgs))
+	for i, msg := range msgs {
+		msgsBytes[i] = msg.GetSignBytes()
+	}
+	msgsJSON, err := json.Marshal(msgsBytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal msgs to json for StdSignBytesMulti function: %v", err.Error())
+	}
+	var feeBytes sdk.Raw
+	feeBytes, err = fee.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal fee to json for StdSignBytesMulti function: %v", err.Error())
+	}
 	bz, err := ModuleCdc.MarshalJSON(StdSignDoc{
 		ChainID: chainID,
 		Fee:     feeBytes,
 		Memo:    memo,
-		Msg:     msgsBytes,
+		Msg:     msgsJSON,
 		Entropy: entropy,
 	})
 	if err != nil {
@@ -68,12 +120,20 @@ func StdSignBytes(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, mem
 
 var _ codec.ProtoMarshaler = &StdTx{}
 
+var _ sdk.MultiMsgTx = StdTx{}
+
+// StdTx carries either a single Msg with its Signature, or (multi message transactions) an ordered list of Msgs with
+// the Signatures of all of their signers. A single message transaction may carry the signature of a FeePayer, the
+// account the fee is deducted from instead of the signer
 type StdTx struct {
-	Msg       sdk.Msg      `json:"msg" yaml:"msg"`
-	Fee       sdk.Coins    `json:"fee" yaml:"fee"`
-	Signature StdSignature `json:"signature" yaml:"signature"`
-	Memo      string       `json:"memo" yaml:"memo"`
-	Entropy   int64        `json:"entropy" yaml:"entropy"`
+	Msg        sdk.Msg        `json:"msg" yaml:"msg"`
+	Fee        sdk.Coins      `json:"fee" yaml:"fee"`
+	Signature  StdSignature   `json:"signature" yaml:"signature"`
+	Memo       string         `json:"memo" yaml:"memo"`
+	Entropy    int64          `json:"entropy" yaml:"entropy"`
+	Msgs       []sdk.Msg      `json:"msgs,omitempty" yaml:"msgs"`
+	Signatures []StdSignature `json:"signatures,omitempty" yaml:"signatures"`
+	FeePayer   *StdSignature  `json:"fee_payer,omitempty" yaml:"fee_payer"`
 }
 
 func (tx *StdTx) Reset() {
@@ -134,23 +194,58 @@ func (tx *StdTx) Unmarshal(data []byte) error {
 }
 
 func (tx StdTx) ToProto() (ProtoStdTx, error) {
-	pMsg, ok := tx.Msg.(sdk.ProtoMsg)
-	if !ok {
-		return ProtoStdTx{}, fmt.Errorf("unable to convert sdk.Msg to sdk.ProtoMsg: %v", tx.Msg)
+	if tx.IsMultiMsg() {
+		msgs := make([]types.Any, len(tx.Msgs))
+		for i, msg := range tx.Msgs {
+			any, err := msgToAny(msg)
+			if err != nil {
+				return ProtoStdTx{}, err
+			}
+			msgs[i] = any
+		}
+		sigs := make([]ProtoStdSignature, len(tx.Signatures))
+		for i, sig := range tx.Signatures {
+			sigs[i] = sig.ToProto()
+		}
+		return ProtoStdTx{
+			Fee:        tx.Fee,
+			Memo:       tx.Memo,
+			Entropy:    tx.Entropy,
+			Msgs:       msgs,
+			Signatures: sigs,
+		}, nil
 	}
-	any, err := types.NewAnyWithValue(pMsg)
+	any, err := msgToAny(tx.Msg)
 	if err != nil {
-		return ProtoStdTx{}, fmt.Errorf("unable to convert sdk.ProtoMsg into any %v", pMsg)
+		return ProtoStdTx{}, err
+	}
+	var feePayer *ProtoStdSignature
+	if tx.FeePayer != nil {
+		fp := tx.FeePayer.ToProto()
+		feePayer = &fp
 	}
 	return ProtoStdTx{
-		Msg:       *any,
+		Msg:       any,
 		Fee:       tx.Fee,
 		Signature: tx.Signature.ToProto(),
 		Memo:      tx.Memo,
 		Entropy:   tx.Entropy,
+		FeePayer:  feePayer,
 	}, nil
 }
 
+func msgToAny(msg sdk.Msg) (types.Any, error) {
+	pMsg, ok := msg.(sdk.ProtoMsg)
+	if !ok {
+		return types.Any{}, fmt.Errorf("unable to convert sdk.Msg to sdk.ProtoMsg: %v", msg)
+	}
+	any, err := types.NewAnyWithValue(pMsg)
+	if err != nil {
+		return types.Any{}, fmt.Errorf("unable to convert sdk.ProtoMsg into any %v", pMsg)
+	}
+	return *any, nil
+}
+
 func (tx StdTx) WithSignature(sig StdSignature) (StdTx, error) {
 	tx.Signature = sig
 	return tx, nil
@@ -172,12 +267,72 @@ func (tx StdTx) GetSignature() StdSignature {
 	return tx.Signature
 }
 
+// GetFeePayer returns the address of the account paying the fee instead of the signer, nil if there is none.
+func (tx StdTx) GetFeePayer() sdk.Address {
+	if tx.FeePayer == nil || tx.FeePayer.PublicKey == nil {
+		return nil
+	}
+	return sdk.Address(tx.FeePayer.PublicKey.Address())
+}
+
+// GetSigners returns the signers of the transaction's messages, in the order of the messages and without duplicates.
 func (tx StdTx) GetSigners() []sdk.Address {
-	return tx.GetMsg().GetSigners()
+	if !tx.IsMultiMsg() {
+		return tx.GetMsg().GetSigners()
+	}
+	var signers []sdk.Address
+	seen := make(map[string]struct{})
+	for _, msg := range tx.Msgs {
+		for _, signer := range msg.GetSigners() {
+			if _, ok := seen[signer.String()]; ok {
+				continue
+			}
+			seen[signer.String()] = struct{}{}
+			signers = append(signers, signer)
+		}
+	}
+	return signers
 }
 
-// GetMsg returns the all the transaction's messages.
-func (tx StdTx) GetMsg() sdk.Msg { return tx.Msg }
+// GetMsg returns the transaction's message; the first message of a multi message transaction.
+func (tx StdTx) GetMsg() sdk.Msg {
+	if tx.IsMultiMsg() {
+		return tx.Msgs[0]
+	}
+	return tx.Msg
+}
+
+// IsMultiMsg returns whether the transaction carries a list of messages instead of a single one.
+func (tx StdTx) IsMultiMsg() bool { return len(tx.Msgs) != 0 }
+
+// GetMsgs returns all the transaction's messages, in order.
+func (tx StdTx) GetMsgs() []sdk.Msg {
+	if tx.IsMultiMsg() {
+		return tx.Msgs
+	}
+	return []sdk.Msg{tx.Msg}
+}
+
+// GetMsgSigner returns the public key of the signature that signs the message at index i, nil if there is none.
+func (tx StdTx) GetMsgSigner(i int) posCrypto.PublicKey {
+	if !tx.IsMultiMsg() {
+		return tx.Signature.PublicKey
+	}
+	if i < 0 || i >= len(tx.Msgs) {
+		return nil
+	}
+	for _, sig := range tx.Signatures {
+		if sig.PublicKey == nil {
+			continue
+		}
+		for _, signer := range tx.Msgs[i].GetSigners() {
+			if bytes.Equal(sig.PublicKey.Address(), signer) {
+				return sig.PublicKey
+			}
+		}
+	}
+	return nil
+}
 
 // ValidateBasic does a simple and lightweight validation check that doesn't
 // require access to any other information.
@@ -185,6 +340,38 @@ func (tx StdTx) ValidateBasic() sdk.Error {
 	if !tx.Fee.IsValid() {
 		return sdk.ErrInsufficientFee(fmt.Sprintf("invalid fee %s amount provided", tx.Fee.String()))
 	}
+	if tx.FeePayer != nil {
+		if tx.IsMultiMsg() {
+			return sdk.ErrUnknownRequest("a multi message transaction must not have a fee payer")
+		}
+		if tx.FeePayer.PublicKey == nil {
+			return sdk.ErrInvalidPubKey("the signature of the fee payer must have a public key")
+		}
+		if len(tx.FeePayer.Signature) == 0 {
+			return sdk.ErrUnauthorized("empty fee payer signature")
+		}
+	}
+	if tx.IsMultiMsg() {
+		// a multi message transaction must not also carry the fields of a single message one
+		if tx.Msg != nil || tx.Signature.PublicKey != nil || len(tx.Signature.Signature) != 0 {
+			return sdk.ErrUnknownRequest("a multi message transaction must not have a msg or a signature")
+		}
+		if len(tx.Signatures) == 0 {
+			return sdk.ErrUnauthorized("empty signatures")
+		}
+		for _, sig := range tx.Signatures {
+			if sig.PublicKey == nil {
+				return sdk.ErrInvalidPubKey("the signatures of a multi message transaction must have a public key")
+			}
+			if len(sig.Signature) == 0 {
+				return sdk.ErrUnauthorized("empty signature")
+			}
+		}
+		return nil
+	}
+	if len(tx.Signatures) != 0 {
+		return sdk.ErrUnknownRequest("a single message transaction must not have signatures")
+	}
 	if len(tx.Signature.Signature) == 0 {
 		return sdk.ErrUnauthorized("empty signature")
 	}
@@ -201,12 +388,43 @@ func (ptx ProtoStdTx) FromProto() (StdTx, error) {
 	if err != nil {
 		return StdTx{}, err
 	}
+	var msgs []sdk.Msg
+	for i := range ptx.Msgs {
+		var msg sdk.ProtoMsg
+		err = ModuleCdc.ProtoCodec().UnpackAny(&ptx.Msgs[i], &msg)
+		if err != nil {
+			return StdTx{}, err
+		}
+		if msg == nil {
+			return StdTx{}, fmt.Errorf("empty message at index %d", i)
+		}
+		msgs = append(msgs, msg)
+	}
+	var sigs []StdSignature
+	for _, pss := range ptx.Signatures {
+		s, err := pss.FromProto()
+		if err != nil {
+			return StdTx{}, err
+		}
+		sigs = append(sigs, s)
+	}
+	var feePayer *StdSignature
+	if ptx.FeePayer != nil {
+		fp, err := ptx.FeePayer.FromProto()
+		if err != nil {
+			return StdTx{}, err
+		}
+		feePayer = &fp
+	}
 	return StdTx{
-		Msg:       res,
-		Fee:       ptx.Fee,
-		Signature: ss,
-		Memo:      ptx.Memo,
-		Entropy:   ptx.Entropy,
+		Msg:        res,
+		Fee:        ptx.Fee,
+		Signature:  ss,
+		Memo:       ptx.Memo,
+		Entropy:    ptx.Entropy,
+		Msgs:       msgs,
+		Signatures: sigs,
+		FeePayer:   feePayer,
 	}, nil
 }
 
@@ -306,6 +524,19 @@ func DefaultTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
 		// ProtoStdTx.ProtoMsg is an interface. The concrete types
 		// are registered by MakeTxCodec
 		err := cdc.UnmarshalBinaryLengthPrefixed(txBytes, &tx, blockHeight)
+		// before multi message transactions, their fields were unknown and ignored
+		if !cdc.IsAfterMultiMsgTxUpgrade(blockHeight) {
+			if err != nil {
+				if t, e := decodeIgnoringMultiMsg(cdc, txBytes, blockHeight); e == nil {
+					tx, err = t, nil
+				}
+			}
+			tx.Msgs, tx.Signatures = nil, nil
+		}
+		// before fee payers, the field was unknown and ignored: the fee is charged to the signer
+		if !cdc.IsAfterFeePayerUpgrade(blockHeight) {
+			tx.FeePayer = nil
+		}
 
 		//replicate error on new stake msg sent before upgrade block for compatibility reasons (happened on 56550 BU)
 		if !cdc.IsAfterNonCustodialUpgrade(blockHeight) {
@@ -327,6 +558,16 @@ func DefaultTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
 	}
 }
 
+// decodeIgnoringMultiMsg decodes the transaction without the fields of multi message transactions
+func decodeIgnoringMultiMsg(cdc *codec.Codec, txBytes []byte, blockHeight int64) (StdTx, error) {
+	var ptx ProtoStdTx
+	if err := cdc.UnmarshalBinaryLengthPrefixed(txBytes, &ptx, blockHeight); err != nil {
+		return StdTx{}, err
+	}
+	ptx.Msgs, ptx.Signatures = nil, nil
+	return ptx.FromProto()
+}
+
 // DefaultTxEncoder logic for standard transaction encoding
 func DefaultTxEncoder(cdc *codec.Codec) sdk.TxEncoder {
 	return func(tx sdk.Tx, blockHeight int64) ([]byte, error) {
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	codecTypes "github.com/pokt-network/pocket-core/codec/types"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/require"
+)
+
+func multiMsgTxSetup(t *testing.T) (*codec.Codec, StdTx, []crypto.PrivateKey) {
+	cdc := codec.NewCodec(codecTypes.NewInterfaceRegistry())
+	sdk.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	nodesTypes.RegisterCodec(cdc)
+	crypto.RegisterAmino(cdc.AminoCodec().Amino)
+	privateKeys := []crypto.PrivateKey{crypto.GenerateEd25519PrivKey(), crypto.GenerateEd25519PrivKey()}
+	a0, a1 := sdk.Address(privateKeys[0].PublicKey().Address()), sdk.Address(privateKeys[1].PublicKey().Address())
+	msgs := []sdk.ProtoMsg{
+		&nodesTypes.MsgSend{FromAddress: a0, ToAddress: a1, Amount: sdk.NewInt(1)},
+		&nodesTypes.MsgSend{FromAddress: a1, ToAddress: a0, Amount: sdk.NewInt(2)},
+		&nodesTypes.MsgSend{FromAddress: a0, ToAddress: a1, Amount: sdk.NewInt(3)},
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(30000)))
+	tx := NewMultiMsgTx(msgs, fee, nil, "memo", 1)
+	signBytes, err := StdSignBytesMulti("test", tx.Entropy, tx.Fee, tx.GetMsgs(), tx.Memo)
+	require.Nil(t, err)
+	for _, privateKey := range privateKeys {
+		sig, err := privateKey.Sign(signBytes)
+		require.Nil(t, err)
+		tx.Signatures = append(tx.Signatures, StdSignature{PublicKey: privateKey.PublicKey(), Signature: sig})
+	}
+	return cdc, tx, privateKeys
+}
+
+func TestStdTx_MultiMsg(t *testing.T) {
+	_, tx, privateKeys := multiMsgTxSetup(t)
+	require.True(t, tx.IsMultiMsg())
+	require.Nil(t, tx.ValidateBasic())
+	require.Len(t, tx.GetMsgs(), 3)
+	require.Equal(t, tx.Msgs[0], tx.GetMsg())
+	// the signers of all of the messages, in order and without duplicates
+	require.Equal(t, []sdk.Address{sdk.Address(privateKeys[0].PublicKey().Address()), sdk.Address(privateKeys[1].PublicKey().Address())}, tx.GetSigners())
+	require.Equal(t, privateKeys[0].PublicKey(), tx.GetMsgSigner(0))
+	require.Equal(t, privateKeys[1].PublicKey(), tx.GetMsgSigner(1))
+	require.Equal(t, privateKeys[0].PublicKey(), tx.GetMsgSigner(2))
+	require.Nil(t, tx.GetMsgSigner(3))
+	// the sign bytes are of every message
+	single, err := StdSignBytes("test", tx.Entropy, tx.Fee, tx.Msgs[0], tx.Memo)
+	require.Nil(t, err)
+	multi, err := StdSignBytesMulti("test", tx.Entropy, tx.Fee, tx.GetMsgs(), tx.Memo)
+	require.Nil(t, err)
+	require.NotEqual(t, single, multi)
+	require.True(t, tx.Signatures[0].VerifyBytes(multi, tx.Signatures[0].Signature))
+}
+
+func TestStdTx_MultiMsgValidateBasic(t *testing.T) {
+	_, tx, _ := multiMsgTxSetup(t)
+	// no signatures
+	noSigs := tx
+	noSigs.Signatures = nil
+	require.NotNil(t, noSigs.ValidateBasic())
+	// both the single and the multi message fields
+	withMsg := tx
+	withMsg.Msg = tx.Msgs[0]
+	require.NotNil(t, withMsg.ValidateBasic())
+	withSig := tx
+	withSig.Signature = tx.Signatures[0]
+	require.NotNil(t, withSig.ValidateBasic())
+	// a signature without its public key
+	noKey := tx
+	noKey.Signatures = []StdSignature{{Signature: tx.Signatures[0].Signature}}
+	require.NotNil(t, noKey.ValidateBasic())
+	// a single message transaction with signatures
+	single := StdTx{Msg: tx.Msgs[0], Fee: tx.Fee, Signature: tx.Signatures[0], Signatures: tx.Signatures}
+	require.NotNil(t, single.ValidateBasic())
+	single.Signatures = nil
+	require.Nil(t, single.ValidateBasic())
+	require.False(t, single.IsMultiMsg())
+}
+
+func TestStdTx_MultiMsgEncoding(t *testing.T) {
+	codec.UpgradeFeatureMap[codec.MultiMsgTxKey] = 1
+	t.Cleanup(func() {
+		delete(codec.UpgradeFeatureMap, codec.MultiMsgTxKey)
+	})
+	cdc, tx, _ := multiMsgTxSetup(t)
+	bz, err := DefaultTxEncoder(cdc)(tx, -1)
+	require.Nil(t, err)
+	decoded, err := DefaultTxDecoder(cdc)(bz, 1)
+	require.Nil(t, err)
+	require.Equal(t, tx, decoded)
+	// before the upgrade the fields of multi message transactions are ignored
+	decoded, err = DefaultTxDecoder(cdc)(bz, 0)
+	require.Nil(t, err)
+	require.False(t, decoded.(StdTx).IsMultiMsg())
+	require.Nil(t, decoded.GetMsg())
+}
+
+func TestStdTx_FeePayer(t *testing.T) {
+	codec.UpgradeFeatureMap[codec.FeePayerKey] = 1
+	t.Cleanup(func() {
+		delete(codec.UpgradeFeatureMap, codec.FeePayerKey)
+	})
+	cdc, multiMsgTx, privateKeys := multiMsgTxSetup(t)
+	signer, payer := privateKeys[0], privateKeys[1]
+	msg := multiMsgTx.Msgs[0]
+	feePayer := sdk.Address(payer.PublicKey().Address())
+	// without a fee payer the sign bytes are unchanged
+	signBytes, err := StdSignBytes("test", 1, multiMsgTx.Fee, msg, "")
+	require.Nil(t, err)
+	noFeePayer, err := StdSignBytesWithFeePayer("test", 1, multiMsgTx.Fee, msg, "", nil)
+	require.Nil(t, err)
+	require.Equal(t, signBytes, noFeePayer)
+	require.NotContains(t, string(signBytes), "fee_payer")
+	// the signer and the fee payer sign the fee payer
+	signBytes, err = StdSignBytesWithFeePayer("test", 1, multiMsgTx.Fee, msg, "", feePayer)
+	require.Nil(t, err)
+	require.NotEqual(t, noFeePayer, signBytes)
+	sig, err := signer.Sign(signBytes)
+	require.Nil(t, err)
+	feePayerSig, err := payer.Sign(signBytes)
+	require.Nil(t, err)
+	tx := StdTx{
+		Msg:       msg,
+		Fee:       multiMsgTx.Fee,
+		Signature: StdSignature{PublicKey: signer.PublicKey(), Signature: sig},
+		Entropy:   1,
+		FeePayer:  &StdSignature{PublicKey: payer.PublicKey(), Signature: feePayerSig},
+	}
+	require.Nil(t, tx.ValidateBasic())
+	require.Equal(t, feePayer, tx.GetFeePayer())
+	require.Nil(t, StdTx{}.GetFeePayer())
+	// a fee payer signature without its public key, or empty
+	noKey := tx
+	noKey.FeePayer = &StdSignature{Signature: feePayerSig}
+	require.NotNil(t, noKey.ValidateBasic())
+	noSig := tx
+	noSig.FeePayer = &StdSignature{PublicKey: payer.PublicKey()}
+	require.NotNil(t, noSig.ValidateBasic())
+	// only single message transactions have a fee payer
+	multiMsgTx.FeePayer = tx.FeePayer
+	require.NotNil(t, multiMsgTx.ValidateBasic())
+	// encoding
+	bz, err := DefaultTxEncoder(cdc)(tx, -1)
+	require.Nil(t, err)
+	decoded, err := DefaultTxDecoder(cdc)(bz, 1)
+	require.Nil(t, err)
+	require.Equal(t, tx, decoded)
+	// before the upgrade the fee payer is ignored
+	decoded, err = DefaultTxDecoder(cdc)(bz, 0)
+	require.Nil(t, err)
+	require.Nil(t, decoded.(StdTx).FeePayer)
+}
@@ -197,13 +197,21 @@ func (bldr TxBuilder) SignMultisigTransaction(address sdk.Address, keys []crypto
 		return nil, err
 	}
 	// sign using multisignature sturcture
-	var ms = crypto.MultiSig(crypto.MultiSignature{})
-	if tx.GetSignature().GetSignature() == nil || len(tx.GetSignature().GetSignature()) == 0 {
-		ms = ms.NewMultiSignature()
-	} else {
+	var ms = crypto.NewMultiSigForKey(tx.Signature.PublicKey)
+	if tx.GetSignature().GetSignature() != nil && len(tx.GetSignature().GetSignature()) != 0 {
 		ms = ms.Unmarshal(tx.GetSignature().GetSignature())
 	}
-	if len(keys) != 0 {
+	if thresholdKey, ok := tx.Signature.PublicKey.(crypto.PublicKeyThresholdMultiSignature); ok {
+		// the signer is found in the threshold key, so the signatures may be added in any order
+		if _, found := ms.(crypto.ThresholdMultiSignature).GetSignatureByKey(pubKey, thresholdKey.Keys()); !found &&
+			uint64(ms.NumOfSigs()) >= thresholdKey.Threshold {
+			return nil, fmt.Errorf("the transaction already has the %d signatures the threshold multisig needs", thresholdKey.Threshold)
+		}
+		ms, err = ms.AddSignature(sigBytes, pubKey, thresholdKey.Keys())
+		if err != nil {
+			return nil, err
+		}
+	} else if len(keys) != 0 {
 		ms, err = ms.AddSignature(sigBytes, pubKey, keys)
 		if err != nil {
 			return nil, err
@@ -241,14 +249,21 @@ func (bldr TxBuilder) BuildAndSignMultisigTransaction(address sdk.Address, publi
 	if err != nil {
 		return nil, err
 	}
-	sigBytes, _, err := bldr.keybase.Sign(address, passphrase, signBz)
+	sigBytes, pubKey, err := bldr.keybase.Sign(address, passphrase, signBz)
 	if err != nil {
 		return nil, err
 	}
 	// sign using multisignature structure
-	var ms = crypto.MultiSig(crypto.MultiSignature{})
-	ms = ms.NewMultiSignature()
-	ms = ms.AddSignatureByIndex(sigBytes, 0)
+	var ms = crypto.NewMultiSigForKey(publicKey)
+	if _, ok := publicKey.(crypto.PublicKeyThresholdMultiSignature); ok {
+		// any of the keys may start a threshold multisig
+		ms, err = ms.AddSignature(sigBytes, pubKey, publicKey.Keys())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		ms = ms.AddSignatureByIndex(sigBytes, 0)
+	}
 	sig := StdSignature{
 		PublicKey: publicKey,
 		Signature: ms.Marshal(),
@@ -0,0 +1,442 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
+)
+
+//-----------------------------------------------------------------------------
+// BaseVestingAccount
+
+// BaseVestingAccount implements the state and the logic shared by the vesting accounts
+type BaseVestingAccount struct {
+	*BaseAccount
+	OriginalVesting  sdk.Coins `json:"original_vesting" yaml:"original_vesting"`   // coins vesting at the creation of the account
+	DelegatedFree    sdk.Coins `json:"delegated_free" yaml:"delegated_free"`       // coins staked that were vested at the time of the stake
+	DelegatedVesting sdk.Coins `json:"delegated_vesting" yaml:"delegated_vesting"` // coins staked that were vesting at the time of the stake
+	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // when the coins are fully vested (unix seconds)
+}
+
+// NewBaseVestingAccount - returns a base vesting account, all of the coins of the base account are vesting
+func NewBaseVestingAccount(baseAccount *BaseAccount, endTime int64) *BaseVestingAccount {
+	return &BaseVestingAccount{
+		BaseAccount:     baseAccount,
+		OriginalVesting: baseAccount.Coins,
+		EndTime:         endTime,
+	}
+}
+
+// spendableCoins - The coins that are not locked given the still vesting coins. The vesting coins that are staked
+// don't count against the balance, so the spendable amount of every denomination is min(balance, balance + staked
+// vesting - vesting), which is never negative
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
+	var spendableCoins sdk.Coins
+	for _, coin := range bva.Coins {
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+		spendableAmt := sdk.MinInt(coin.Amount.Add(delVestingAmt).Sub(vestingAmt), coin.Amount)
+		if spendableAmt.IsPositive() {
+			spendableCoins = spendableCoins.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, spendableAmt)))
+		}
+	}
+	return spendableCoins
+}
+
+// trackDelegation - Tracks the coins staked; the still vesting ones (that are not already staked) are staked first
+func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
+	for _, coin := range amount {
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+		// x = min(max(vesting - staked vesting, 0), amount); y = amount - x
+		x := sdk.MinInt(sdk.MaxInt(vestingAmt.Sub(delVestingAmt), sdk.ZeroInt()), coin.Amount)
+		y := coin.Amount.Sub(x)
+		if x.IsPositive() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, x)))
+		}
+		if y.IsPositive() {
+			bva.DelegatedFree = bva.DelegatedFree.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, y)))
+		}
+	}
+}
+
+// TrackUndelegation - Tracks the coins unstaked; the staked free ones are unstaked first. The amount
+// may be less than the one staked (slashed stake), what's left of the staked vesting coins stays tracked
+func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	for _, coin := range amount {
+		delegatedFree := bva.DelegatedFree.AmountOf(coin.Denom)
+		delegatedVesting := bva.DelegatedVesting.AmountOf(coin.Denom)
+		// x = min(staked free, amount); y = min(staked vesting, amount - x)
+		x := sdk.MinInt(delegatedFree, coin.Amount)
+		y := sdk.MinInt(delegatedVesting, coin.Amount.Sub(x))
+		if x.IsPositive() {
+			bva.DelegatedFree = bva.DelegatedFree.Sub(sdk.NewCoins(sdk.NewCoin(coin.Denom, x)))
+		}
+		if y.IsPositive() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Sub(sdk.NewCoins(sdk.NewCoin(coin.Denom, y)))
+		}
+	}
+}
+
+// GetOriginalVesting - Implements exported.VestingAccount
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// GetDelegatedFree - Implements exported.VestingAccount
+func (bva BaseVestingAccount) GetDelegatedFree() sdk.Coins {
+	return bva.DelegatedFree
+}
+
+// GetDelegatedVesting - Implements exported.VestingAccount
+func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
+	return bva.DelegatedVesting
+}
+
+// GetEndTime - Implements exported.VestingAccount
+func (bva BaseVestingAccount) GetEndTime() int64 {
+	return bva.EndTime
+}
+
+// Validate - Checks the vesting schedule of the account
+func (bva BaseVestingAccount) Validate() error {
+	if bva.BaseAccount == nil {
+		return errors.New("the base account of a vesting account can't be nil")
+	}
+	if bva.EndTime <= 0 {
+		return errors.New("the end time of a vesting account must be positive")
+	}
+	if !bva.OriginalVesting.IsValid() || bva.OriginalVesting.Empty() {
+		return fmt.Errorf("invalid original vesting coins: %s", bva.OriginalVesting)
+	}
+	return nil
+}
+
+func (bva BaseVestingAccount) ToProto() ProtoBaseVestingAccount {
+	return ProtoBaseVestingAccount{
+		ProtoBaseAccount: bva.BaseAccount.ToProto(),
+		OriginalVesting:  bva.OriginalVesting,
+		DelegatedFree:    bva.DelegatedFree,
+		DelegatedVesting: bva.DelegatedVesting,
+		EndTime:          bva.EndTime,
+	}
+}
+
+func (m *ProtoBaseVestingAccount) FromProto() (*BaseVestingAccount, error) {
+	ba, err := m.ProtoBaseAccount.FromProto()
+	if err != nil {
+		return nil, err
+	}
+	return &BaseVestingAccount{
+		BaseAccount:      &ba,
+		OriginalVesting:  m.OriginalVesting,
+		DelegatedFree:    m.DelegatedFree,
+		DelegatedVesting: m.DelegatedVesting,
+		EndTime:          m.EndTime,
+	}, nil
+}
+
+type vestingAccountYAML struct {
+	Address          sdk.Address
+	Coins            sdk.Coins
+	PubKey           string
+	OriginalVesting  sdk.Coins
+	DelegatedFree    sdk.Coins
+	DelegatedVesting sdk.Coins
+	StartTime        int64 `yaml:",omitempty"`
+	EndTime          int64
+}
+
+func (bva BaseVestingAccount) marshalYAML(startTime int64) (interface{}, error) {
+	var pubkey string
+	if bva.PubKey != nil {
+		pubkey = bva.PubKey.RawString()
+	}
+	bs, err := yaml.Marshal(vestingAccountYAML{
+		Address:          bva.Address,
+		Coins:            bva.Coins,
+		PubKey:           pubkey,
+		OriginalVesting:  bva.OriginalVesting,
+		DelegatedFree:    bva.DelegatedFree,
+		DelegatedVesting: bva.DelegatedVesting,
+		StartTime:        startTime,
+		EndTime:          bva.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return string(bs), nil
+}
+
+//-----------------------------------------------------------------------------
+// ContinuousVestingAccount
+
+var _ exported.VestingAccount = (*ContinuousVestingAccount)(nil)
+var _ codec.ProtoMarshaler = &ContinuousVestingAccount{}
+
+// ContinuousVestingAccount - a vesting account whose coins vest linearly from the start time until the end time
+type ContinuousVestingAccount struct {
+	*BaseVestingAccount
+	StartTime int64 `json:"start_time" yaml:"start_time"` // when the coins start to vest (unix seconds)
+}
+
+// NewContinuousVestingAccount - returns a continuous vesting account, all of the coins of the base account are vesting
+func NewContinuousVestingAccount(baseAccount *BaseAccount, startTime, endTime int64) *ContinuousVestingAccount {
+	return &ContinuousVestingAccount{
+		BaseVestingAccount: NewBaseVestingAccount(baseAccount, endTime),
+		StartTime:          startTime,
+	}
+}
+
+// GetVestedCoins - The coins vested at blockTime, proportional to the time elapsed since the start time
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	now := blockTime.Unix()
+	if now <= cva.StartTime {
+		return sdk.NewCoins()
+	}
+	if now >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+	elapsed, duration := now-cva.StartTime, cva.EndTime-cva.StartTime
+	var vestedCoins []sdk.Coin
+	for _, coin := range cva.OriginalVesting {
+		vestedCoins = append(vestedCoins, sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(elapsed).QuoRaw(duration)))
+	}
+	return sdk.NewCoins(vestedCoins...)
+}
+
+// GetVestingCoins - The coins still vesting at blockTime
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins - Implements exported.Account, the locked coins can't be spent
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation - Implements exported.VestingAccount
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime - Implements exported.VestingAccount
+func (cva ContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// Validate - Checks the vesting schedule of the account
+func (cva ContinuousVestingAccount) Validate() error {
+	if cva.BaseVestingAccount == nil {
+		return errors.New("the base vesting account of a continuous vesting account can't be nil")
+	}
+	if err := cva.BaseVestingAccount.Validate(); err != nil {
+		return err
+	}
+	if cva.StartTime <= 0 || cva.StartTime >= cva.EndTime {
+		return fmt.Errorf("the start time (%d) of a continuous vesting account must be positive and before the end time (%d)", cva.StartTime, cva.EndTime)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (cva ContinuousVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ContinuousVestingAccount.
+func (cva ContinuousVestingAccount) MarshalYAML() (interface{}, error) {
+	return cva.BaseVestingAccount.marshalYAML(cva.StartTime)
+}
+
+func (cva *ContinuousVestingAccount) Reset() {
+	*cva = ContinuousVestingAccount{}
+}
+
+func (cva *ContinuousVestingAccount) ProtoMessage() {
+	p := cva.ToProto()
+	p.ProtoMessage()
+}
+
+func (cva *ContinuousVestingAccount) Marshal() ([]byte, error) {
+	p := cva.ToProto()
+	return p.Marshal()
+}
+
+func (cva *ContinuousVestingAccount) MarshalTo(data []byte) (n int, err error) {
+	p := cva.ToProto()
+	return p.MarshalTo(data)
+}
+
+func (cva *ContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p := cva.ToProto()
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (cva *ContinuousVestingAccount) Size() int {
+	p := cva.ToProto()
+	return p.Size()
+}
+
+func (cva *ContinuousVestingAccount) Unmarshal(data []byte) error {
+	var pcva ProtoContinuousVestingAccount
+	err := pcva.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	c, err := pcva.FromProto()
+	if err != nil {
+		return err
+	}
+	*cva = c
+	return nil
+}
+
+func (cva ContinuousVestingAccount) ToProto() ProtoContinuousVestingAccount {
+	return ProtoContinuousVestingAccount{
+		ProtoBaseVestingAccount: cva.BaseVestingAccount.ToProto(),
+		StartTime:               cva.StartTime,
+	}
+}
+
+func (m *ProtoContinuousVestingAccount) FromProto() (ContinuousVestingAccount, error) {
+	bva, err := m.ProtoBaseVestingAccount.FromProto()
+	if err != nil {
+		return ContinuousVestingAccount{}, err
+	}
+	return ContinuousVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          m.StartTime,
+	}, nil
+}
+
+//-----------------------------------------------------------------------------
+// DelayedVestingAccount
+
+var _ exported.VestingAccount = (*DelayedVestingAccount)(nil)
+var _ codec.ProtoMarshaler = &DelayedVestingAccount{}
+
+// DelayedVestingAccount - a vesting account whose coins all vest at once at the end time (cliff)
+type DelayedVestingAccount struct {
+	*BaseVestingAccount
+}
+
+// NewDelayedVestingAccount - returns a delayed vesting account, all of the coins of the base account are vesting
+func NewDelayedVestingAccount(baseAccount *BaseAccount, endTime int64) *DelayedVestingAccount {
+	return &DelayedVestingAccount{
+		BaseVestingAccount: NewBaseVestingAccount(baseAccount, endTime),
+	}
+}
+
+// GetVestedCoins - The coins vested at blockTime, all of them after the end time and none before
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= dva.EndTime {
+		return dva.OriginalVesting
+	}
+	return sdk.NewCoins()
+}
+
+// GetVestingCoins - The coins still vesting at blockTime
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.OriginalVesting.Sub(dva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins - Implements exported.Account, the locked coins can't be spent
+func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation - Implements exported.VestingAccount
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime - Implements exported.VestingAccount, the coins of a delayed vesting account don't start to vest
+func (dva DelayedVestingAccount) GetStartTime() int64 {
+	return 0
+}
+
+// Validate - Checks the vesting schedule of the account
+func (dva DelayedVestingAccount) Validate() error {
+	if dva.BaseVestingAccount == nil {
+		return errors.New("the base vesting account of a delayed vesting account can't be nil")
+	}
+	return dva.BaseVestingAccount.Validate()
+}
+
+// String implements fmt.Stringer
+func (dva DelayedVestingAccount) String() string {
+	out, _ := dva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a DelayedVestingAccount.
+func (dva DelayedVestingAccount) MarshalYAML() (interface{}, error) {
+	return dva.BaseVestingAccount.marshalYAML(0)
+}
+
+func (dva *DelayedVestingAccount) Reset() {
+	*dva = DelayedVestingAccount{}
+}
+
+func (dva *DelayedVestingAccount) ProtoMessage() {
+	p := dva.ToProto()
+	p.ProtoMessage()
+}
+
+func (dva *DelayedVestingAccount) Marshal() ([]byte, error) {
+	p := dva.ToProto()
+	return p.Marshal()
+}
+
+func (dva *DelayedVestingAccount) MarshalTo(data []byte) (n int, err error) {
+	p := dva.ToProto()
+	return p.MarshalTo(data)
+}
+
+func (dva *DelayedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p := dva.ToProto()
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (dva *DelayedVestingAccount) Size() int {
+	p := dva.ToProto()
+	return p.Size()
+}
+
+func (dva *DelayedVestingAccount) Unmarshal(data []byte) error {
+	var pdva ProtoDelayedVestingAccount
+	err := pdva.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	d, err := pdva.FromProto()
+	if err != nil {
+		return err
+	}
+	*dva = d
+	return nil
+}
+
+func (dva DelayedVestingAccount) ToProto() ProtoDelayedVestingAccount {
+	return ProtoDelayedVestingAccount{
+		ProtoBaseVestingAccount: dva.BaseVestingAccount.ToProto(),
+	}
+}
+
+func (m *ProtoDelayedVestingAccount) FromProto() (DelayedVestingAccount, error) {
+	bva, err := m.ProtoBaseVestingAccount.FromProto()
+	if err != nil {
+		return DelayedVestingAccount{}, err
+	}
+	return DelayedVestingAccount{
+		BaseVestingAccount: bva,
+	}, nil
+}
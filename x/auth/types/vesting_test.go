@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func vestingTestBaseAccount() *BaseAccount {
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	acc := NewBaseAccountWithAddress(sdk.Address(pk.Address()))
+	acc.PubKey = pk
+	acc.Coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultStakeDenom, 1000))
+	return &acc
+}
+
+func TestContinuousVestingAccount(t *testing.T) {
+	start := time.Unix(1000, 0)
+	end := start.Add(1000 * time.Second)
+	cva := NewContinuousVestingAccount(vestingTestBaseAccount(), start.Unix(), end.Unix())
+	require.Nil(t, cva.Validate())
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultStakeDenom, amount))
+	}
+	// before the start nothing is vested
+	require.True(t, cva.GetVestedCoins(start).IsZero())
+	require.Equal(t, coins(1000), cva.GetVestingCoins(start))
+	require.True(t, cva.SpendableCoins(start).IsZero())
+	// linear unlock
+	middle := start.Add(250 * time.Second)
+	require.Equal(t, coins(250), cva.GetVestedCoins(middle))
+	require.Equal(t, coins(750), cva.GetVestingCoins(middle))
+	require.Equal(t, coins(250), cva.SpendableCoins(middle))
+	// after the end everything is vested
+	require.Equal(t, coins(1000), cva.GetVestedCoins(end))
+	require.Equal(t, coins(1000), cva.SpendableCoins(end))
+	// invalid schedules
+	require.NotNil(t, NewContinuousVestingAccount(vestingTestBaseAccount(), end.Unix(), start.Unix()).Validate())
+	require.NotNil(t, NewContinuousVestingAccount(vestingTestBaseAccount(), 0, end.Unix()).Validate())
+}
+
+func TestDelayedVestingAccount(t *testing.T) {
+	end := time.Unix(2000, 0)
+	dva := NewDelayedVestingAccount(vestingTestBaseAccount(), end.Unix())
+	require.Nil(t, dva.Validate())
+	require.True(t, dva.GetVestedCoins(end.Add(-time.Second)).IsZero())
+	require.True(t, dva.SpendableCoins(end.Add(-time.Second)).IsZero())
+	require.Equal(t, dva.OriginalVesting, dva.GetVestedCoins(end))
+	require.Equal(t, dva.OriginalVesting, dva.SpendableCoins(end))
+	require.NotNil(t, NewDelayedVestingAccount(vestingTestBaseAccount(), 0).Validate())
+}
+
+func TestVestingAccount_TrackDelegation(t *testing.T) {
+	start := time.Unix(1000, 0)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultStakeDenom, amount))
+	}
+	cva := NewContinuousVestingAccount(vestingTestBaseAccount(), start.Unix(), start.Unix()+1000)
+	middle := start.Add(500 * time.Second)
+	// stake 600 when 500 are vesting: the vesting ones are staked first
+	cva.TrackDelegation(middle, coins(600))
+	require.Nil(t, cva.SetCoins(cva.GetCoins().Sub(coins(600))))
+	require.Equal(t, coins(500), cva.DelegatedVesting)
+	require.Equal(t, coins(100), cva.DelegatedFree)
+	// the 400 left are vested and spendable
+	require.Equal(t, coins(400), cva.SpendableCoins(middle))
+	// unstake: the free ones are unstaked first, the vesting ones are locked again
+	cva.TrackUndelegation(coins(600))
+	require.Nil(t, cva.SetCoins(cva.GetCoins().Add(coins(600))))
+	require.True(t, cva.DelegatedVesting.IsZero())
+	require.True(t, cva.DelegatedFree.IsZero())
+	require.Equal(t, coins(500), cva.SpendableCoins(middle))
+	// a slashed stake leaves the vesting ones tracked
+	cva.TrackDelegation(middle, coins(600))
+	cva.TrackUndelegation(coins(300))
+	require.Equal(t, coins(300), cva.DelegatedVesting)
+	require.True(t, cva.DelegatedFree.IsZero())
+}
+
+func TestVestingAccount_Encoding(t *testing.T) {
+	cva := NewContinuousVestingAccount(vestingTestBaseAccount(), 1000, 2000)
+	cva.TrackDelegation(time.Unix(1500, 0), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultStakeDenom, 100)))
+	bz, err := cva.Marshal()
+	require.Nil(t, err)
+	var decodedCVA ContinuousVestingAccount
+	require.Nil(t, decodedCVA.Unmarshal(bz))
+	require.Equal(t, *cva, decodedCVA)
+	dva := NewDelayedVestingAccount(vestingTestBaseAccount(), 2000)
+	bz, err = dva.Marshal()
+	require.Nil(t, err)
+	var decodedDVA DelayedVestingAccount
+	require.Nil(t, decodedDVA.Unmarshal(bz))
+	require.Equal(t, *dva, decodedDVA)
+}
+
+func TestVestingAccount_Genesis(t *testing.T) {
+	genesis := NewGenesisState(DefaultParams(), Accounts{
+		NewContinuousVestingAccount(vestingTestBaseAccount(), 1000, 2000),
+		NewDelayedVestingAccount(vestingTestBaseAccount(), 2000),
+	}, nil)
+	bz, err := ModuleCdc.MarshalJSON(genesis)
+	require.Nil(t, err)
+	var decoded GenesisState
+	require.Nil(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, genesis.Accounts, decoded.Accounts)
+	require.Nil(t, ValidateGenesis(decoded))
+	// an invalid vesting schedule
+	genesis.Accounts = append(genesis.Accounts, NewDelayedVestingAccount(vestingTestBaseAccount(), 0))
+	require.NotNil(t, ValidateGenesis(genesis))
+}
@@ -24,6 +24,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgDaoTransfer(ctx, msg, k)
 		case types.MsgUpgrade:
 			return handleMsgUpgrade(ctx, msg, k)
+		case types.MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, msg, k)
+		case types.MsgVote:
+			return handleMsgVote(ctx, msg, k)
+		case types.MsgCancelDAOTransfer:
+			return handleMsgCancelDAOTransfer(ctx, msg, k)
+		case types.MsgSignalVersion:
+			return handleMsgSignalVersion(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -32,6 +40,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 func handleMsgChangeParam(ctx sdk.Ctx, msg types.MsgChangeParam, k keeper.Keeper) sdk.Result {
+	if msg.ActivationHeight != 0 {
+		return k.ScheduleParamChange(ctx, msg)
+	}
 	return k.ModifyParam(ctx, msg.ParamKey, msg.ParamVal, msg.FromAddress)
 }
 
@@ -52,3 +63,19 @@ func handleMsgDaoTransfer(ctx sdk.Ctx, msg types.MsgDAOTransfer, k keeper.Keeper
 func handleMsgUpgrade(ctx sdk.Ctx, msg types.MsgUpgrade, k keeper.Keeper) sdk.Result {
 	return k.HandleUpgrade(ctx, types.NewACLKey(ModuleName, string(types.UpgradeKey)), msg.Upgrade, msg.Address)
 }
+
+func handleMsgSubmitProposal(ctx sdk.Ctx, msg types.MsgSubmitProposal, k keeper.Keeper) sdk.Result {
+	return k.SubmitProposal(ctx, msg)
+}
+
+func handleMsgVote(ctx sdk.Ctx, msg types.MsgVote, k keeper.Keeper) sdk.Result {
+	return k.Vote(ctx, msg)
+}
+
+func handleMsgCancelDAOTransfer(ctx sdk.Ctx, msg types.MsgCancelDAOTransfer, k keeper.Keeper) sdk.Result {
+	return k.CancelDAOTransfer(ctx, msg)
+}
+
+func handleMsgSignalVersion(ctx sdk.Ctx, msg types.MsgSignalVersion, k keeper.Keeper) sdk.Result {
+	return k.SignalVersion(ctx, msg)
+}
@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// EndBlocker - Activate the scheduled param changes, execute the proposals whose voting period ended
+// and the dao actions whose timelock ended
+func (k Keeper) EndBlocker(ctx sdk.Ctx) {
+	k.activatePendingParamChanges(ctx)
+	k.tallyProposals(ctx)
+	k.executeQueuedDAOTransfers(ctx)
+}
@@ -56,9 +56,13 @@ func createTestKeeperAndContext(
 	subspaces ...sdk.Subspace,
 ) (sdk.Context, Keeper) {
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyGov := sdk.NewKVStoreKey(govTypes.StoreKey)
+	tkeyGov := sdk.NewTransientStoreKey(govTypes.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db, false, 5000000)
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyGov, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(sdk.ParamsKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(sdk.ParamsTKey, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
@@ -87,8 +91,8 @@ func createTestKeeperAndContext(
 	ak.GetModuleAccount(ctx, "FAKE")
 	pk := NewKeeper(
 		cdc,
-		sdk.ParamsKey,
-		sdk.ParamsTKey,
+		keyGov,
+		tkeyGov,
 		govTypes.DefaultParamspace,
 		ak,
 		append(subspaces, akSubspace)...,
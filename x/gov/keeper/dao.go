@@ -12,8 +12,10 @@ func (k Keeper) DAOTransferFrom(ctx sdk.Ctx, owner, to sdk.Address, amount sdk.B
 	if !k.GetDAOOwner(ctx).Equals(owner) {
 		return sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to transfer from the dao %s", owner.String())).Result()
 	}
-	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
-	err := k.AuthKeeper.SendCoinsFromModuleToAccount(ctx, types.DAOAccountName, to, coins)
+	if k.isDAOTimelocked(ctx, amount) {
+		return k.queueDAOAction(ctx, types.DAOTransfer, owner, to, amount)
+	}
+	err := k.transferDAOTokens(ctx, to, amount)
 	if err != nil {
 		return err.Result()
 	}
@@ -33,8 +35,10 @@ func (k Keeper) DAOBurn(ctx sdk.Ctx, owner sdk.Address, amount sdk.BigInt) sdk.R
 	if !k.GetDAOOwner(ctx).Equals(owner) {
 		return sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to burn from the dao %s", owner.String())).Result()
 	}
-	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
-	err := k.AuthKeeper.BurnCoins(ctx, types.DAOAccountName, coins)
+	if k.isDAOTimelocked(ctx, amount) {
+		return k.queueDAOAction(ctx, types.DAOBurn, owner, nil, amount)
+	}
+	err := k.burnDAOTokens(ctx, amount)
 	if err != nil {
 		return err.Result()
 	}
@@ -55,6 +59,24 @@ func (k Keeper) DAOBurn(ctx sdk.Ctx, owner sdk.Address, amount sdk.BigInt) sdk.R
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+// transferDAOTokens - Move tokens out of the dao to an account, within the spending cap of the period
+func (k Keeper) transferDAOTokens(ctx sdk.Ctx, to sdk.Address, amount sdk.BigInt) sdk.Error {
+	if err := k.spendDAOTokens(ctx, amount); err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
+	return k.AuthKeeper.SendCoinsFromModuleToAccount(ctx, types.DAOAccountName, to, coins)
+}
+
+// burnDAOTokens - Burn tokens of the dao, within the spending cap of the period
+func (k Keeper) burnDAOTokens(ctx sdk.Ctx, amount sdk.BigInt) sdk.Error {
+	if err := k.spendDAOTokens(ctx, amount); err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
+	return k.AuthKeeper.BurnCoins(ctx, types.DAOAccountName, coins)
+}
+
 func (k Keeper) GetDAOTokens(ctx sdk.Ctx) sdk.BigInt {
 	return k.GetDAOAccount(ctx).GetCoins().AmountOf(sdk.DefaultStakeDenom)
 }
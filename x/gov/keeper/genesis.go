@@ -26,10 +26,83 @@ func (k Keeper) InitGenesis(ctx sdk.Ctx, data types.GenesisState) []abci.Validat
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Errorf("unable to set dao tokens: %s", err.Error()).Error())
 	}
+	nextProposalID := uint64(1)
+	for _, proposal := range data.Proposals {
+		k.SetProposal(ctx, proposal)
+		if proposal.IsActive() {
+			k.insertActiveProposal(ctx, proposal)
+		}
+		if proposal.ID >= nextProposalID {
+			nextProposalID = proposal.ID + 1
+		}
+	}
+	if len(data.Proposals) != 0 {
+		k.setNextProposalID(ctx, nextProposalID)
+	}
+	for _, vote := range data.Votes {
+		k.SetVote(ctx, vote)
+	}
+	for _, power := range data.VoterPowers {
+		k.SetVoterPower(ctx, power)
+	}
+	for _, change := range data.PendingParamChanges {
+		k.SetPendingParamChange(ctx, change)
+	}
+	for _, record := range data.ParamHistory {
+		k.AppendParamChangeRecord(ctx, record)
+	}
+	nextDAOTransferID := uint64(1)
+	for _, transfer := range data.DAOTransferQueue {
+		k.SetQueuedDAOTransfer(ctx, transfer)
+		if transfer.ID >= nextDAOTransferID {
+			nextDAOTransferID = transfer.ID + 1
+		}
+	}
+	if len(data.DAOTransferQueue) != 0 {
+		k.setNextDAOTransferID(ctx, nextDAOTransferID)
+	}
+	if data.DAOSpending != nil {
+		k.SetDAOSpending(ctx, *data.DAOSpending)
+	}
+	for _, signal := range data.VersionSignals {
+		k.SetVersionSignal(ctx, signal)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func (k Keeper) ExportGenesis(ctx sdk.Ctx) types.GenesisState {
-	return types.NewGenesisState(k.GetParams(ctx), k.GetDAOTokens(ctx))
+	gs := types.NewGenesisState(k.GetParams(ctx), k.GetDAOTokens(ctx))
+	k.IterateAndExecuteOverProposals(ctx, func(proposal types.Proposal) (stop bool) {
+		gs.Proposals = append(gs.Proposals, proposal)
+		return false
+	})
+	k.IterateAndExecuteOverVotes(ctx, func(vote types.Vote) (stop bool) {
+		gs.Votes = append(gs.Votes, vote)
+		return false
+	})
+	k.IterateAndExecuteOverVoterPowers(ctx, func(power types.VoterPower) (stop bool) {
+		gs.VoterPowers = append(gs.VoterPowers, power)
+		return false
+	})
+	k.IterateAndExecuteOverPendingParamChanges(ctx, func(change types.PendingParamChange) (stop bool) {
+		gs.PendingParamChanges = append(gs.PendingParamChanges, change)
+		return false
+	})
+	k.IterateAndExecuteOverParamHistory(ctx, func(record types.ParamChangeRecord) (stop bool) {
+		gs.ParamHistory = append(gs.ParamHistory, record)
+		return false
+	})
+	k.IterateAndExecuteOverQueuedDAOTransfers(ctx, func(transfer types.QueuedDAOTransfer) (stop bool) {
+		gs.DAOTransferQueue = append(gs.DAOTransferQueue, transfer)
+		return false
+	})
+	if spending, found := k.getDAOSpending(ctx); found {
+		gs.DAOSpending = &spending
+	}
+	k.IterateAndExecuteOverVersionSignals(ctx, func(signal types.VersionSignal) (stop bool) {
+		gs.VersionSignals = append(gs.VersionSignals, signal)
+		return false
+	})
+	return gs
 }
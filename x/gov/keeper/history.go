@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// AppendParamChangeRecord - Store the record of a param change after the ones applied at the same height
+func (k Keeper) AppendParamChangeRecord(ctx sdk.Ctx, record types.ParamChangeRecord) {
+	store := ctx.KVStore(k.key)
+	index := uint64(0)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.KeyForParamChangeRecordsAtHeight(record.Height))
+	for ; iter.Valid(); iter.Next() {
+		index++
+	}
+	iter.Close()
+	bz, _ := k.cdc.MarshalBinaryLengthPrefixed(&record, ctx.BlockHeight())
+	_ = store.Set(types.KeyForParamChangeRecord(record.Height, index), bz)
+}
+
+// GetParamHistory - Retrieve the records of the changes of key (all keys if empty) applied from fromHeight
+// to toHeight inclusive; a zero toHeight has no upper bound
+func (k Keeper) GetParamHistory(ctx sdk.Ctx, key string, fromHeight, toHeight int64) (records []types.ParamChangeRecord) {
+	records = make([]types.ParamChangeRecord, 0)
+	if fromHeight < 0 {
+		fromHeight = 0
+	}
+	end := sdk.PrefixEndBytes(types.ParamHistoryKey)
+	if toHeight > 0 {
+		if toHeight < fromHeight {
+			return
+		}
+		end = sdk.PrefixEndBytes(types.KeyForParamChangeRecordsAtHeight(toHeight))
+	}
+	store := ctx.KVStore(k.key)
+	iter, _ := store.Iterator(types.KeyForParamChangeRecordsAtHeight(fromHeight), end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.ParamChangeRecord
+		_ = k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &record, ctx.BlockHeight())
+		if key != "" && record.ParamKey != key {
+			continue
+		}
+		records = append(records, record)
+	}
+	return
+}
+
+// IterateAndExecuteOverParamHistory - Goes over the records of the applied param changes and executes handler
+func (k Keeper) IterateAndExecuteOverParamHistory(ctx sdk.Ctx, handler func(record types.ParamChangeRecord) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.ParamHistoryKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.ParamChangeRecord
+		_ = k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &record, ctx.BlockHeight())
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// recordParamChange - Keep the record of a param change applied by signer
+func (k Keeper) recordParamChange(ctx sdk.Ctx, aclKey string, oldValue, newValue []byte, signer sdk.Address) {
+	if !k.cdc.IsAfterParamHistoryUpgrade(ctx.BlockHeight()) {
+		return
+	}
+	k.AppendParamChangeRecord(ctx, types.ParamChangeRecord{
+		ParamKey: aclKey,
+		OldValue: oldValue,
+		NewValue: newValue,
+		Height:   ctx.BlockHeight(),
+		Signer:   signer,
+	})
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/go-amino"
+)
+
+func TestParamHistory(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	daoOwnerKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	votingPeriodKey := types.NewACLKey(types.ModuleName, string(types.ProposalVotingPeriodKey))
+	signer := k.GetACL(ctx).GetOwner(daoOwnerKey)
+	oldOwner, _ := amino.MarshalJSON(k.GetDAOOwner(ctx))
+	newOwner, _ := amino.MarshalJSON(getRandomValidatorAddress())
+	assert.True(t, k.ModifyParam(ctx.WithBlockHeight(10), daoOwnerKey, newOwner, signer).IsOK())
+	assert.True(t, k.ModifyParam(ctx.WithBlockHeight(20), votingPeriodKey, []byte(`"50"`), k.GetACL(ctx).GetOwner(votingPeriodKey)).IsOK())
+	assert.True(t, k.ModifyParam(ctx.WithBlockHeight(20), votingPeriodKey, []byte(`"60"`), k.GetACL(ctx).GetOwner(votingPeriodKey)).IsOK())
+	// rejected changes are not recorded
+	assert.False(t, k.ModifyParam(ctx.WithBlockHeight(30), daoOwnerKey, oldOwner, getRandomValidatorAddress()).IsOK())
+	history := k.GetParamHistory(ctx, "", 0, 0)
+	assert.Len(t, history, 3)
+	assert.Equal(t, daoOwnerKey, history[0].ParamKey)
+	assert.Equal(t, oldOwner, history[0].OldValue)
+	assert.Equal(t, newOwner, history[0].NewValue)
+	assert.Equal(t, int64(10), history[0].Height)
+	assert.Equal(t, signer, history[0].Signer)
+	// changes applied at the same height are kept in order
+	assert.Equal(t, history[1].NewValue, history[2].OldValue)
+	// filters by key and height range
+	assert.Len(t, k.GetParamHistory(ctx, votingPeriodKey, 0, 0), 2)
+	assert.Len(t, k.GetParamHistory(ctx, daoOwnerKey, 11, 0), 0)
+	assert.Len(t, k.GetParamHistory(ctx, "", 10, 10), 1)
+	assert.Len(t, k.GetParamHistory(ctx, "", 11, 20), 2)
+	assert.Len(t, k.GetParamHistory(ctx, "", 20, 10), 0)
+	assert.Equal(t, history, k.ExportGenesis(ctx).ParamHistory)
+}
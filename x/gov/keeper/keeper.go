@@ -17,7 +17,10 @@ type Keeper struct {
 	codespace  sdk.CodespaceType
 	paramstore sdk.Subspace
 	AuthKeeper types.AuthKeeper
+	PosKeeper  types.PosKeeper
 	spaces     map[string]sdk.Subspace
+	// stops the node at the end of the block, without committing it, when the binary can't process the chain any further
+	HaltHandler func(reason string)
 }
 
 // NewKeeper constructs a params keeper
@@ -20,9 +20,17 @@ func (k Keeper) GetACL(ctx sdk.Ctx) (res types.ACL) {
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
-		ACL:      k.GetACL(ctx),
-		Upgrade:  k.GetUpgrade(ctx),
-		DAOOwner: k.GetDAOOwner(ctx),
+		ACL:                  k.GetACL(ctx),
+		Upgrade:              k.GetUpgrade(ctx),
+		DAOOwner:             k.GetDAOOwner(ctx),
+		ProposalVotingPeriod: k.ProposalVotingPeriod(ctx),
+		ProposalVoterSet:     k.ProposalVoterSet(ctx),
+		ProposalQuorum:       k.ProposalQuorum(ctx),
+		ProposalThreshold:    k.ProposalThreshold(ctx),
+		DAOSpendPeriod:       k.DAOSpendPeriod(ctx),
+		DAOSpendCap:          k.DAOSpendCap(ctx),
+		DAOTimelockThreshold: k.DAOTimelockThreshold(ctx),
+		DAOTimelockPeriod:    k.DAOTimelockPeriod(ctx),
 	}
 }
 
@@ -41,6 +49,64 @@ func (k Keeper) GetUpgrade(ctx sdk.Ctx) (res types.Upgrade) {
 	return
 }
 
+// ProposalVotingPeriod - Number of blocks a proposal is open for votes
+// (the default is returned until the proposal params are activated)
+func (k Keeper) ProposalVotingPeriod(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultProposalVotingPeriod
+	k.paramstore.GetIfExists(ctx, types.ProposalVotingPeriodKey, &res)
+	return
+}
+
+// ProposalVoterSet - The set of accounts allowed to vote on proposals
+func (k Keeper) ProposalVoterSet(ctx sdk.Ctx) (res string) {
+	res = types.DefaultProposalVoterSet
+	k.paramstore.GetIfExists(ctx, types.ProposalVoterSetKey, &res)
+	return
+}
+
+// ProposalQuorum - Percentage of the voting power that must vote for a proposal to be valid
+func (k Keeper) ProposalQuorum(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultProposalQuorum
+	k.paramstore.GetIfExists(ctx, types.ProposalQuorumKey, &res)
+	return
+}
+
+// ProposalThreshold - Percentage of the cast voting power that must approve a proposal for it to pass
+func (k Keeper) ProposalThreshold(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultProposalThreshold
+	k.paramstore.GetIfExists(ctx, types.ProposalThresholdKey, &res)
+	return
+}
+
+// DAOSpendPeriod - Number of blocks of a dao spending period
+// (the default is returned until the dao treasury params are activated)
+func (k Keeper) DAOSpendPeriod(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultDAOSpendPeriod
+	k.paramstore.GetIfExists(ctx, types.DAOSpendPeriodKey, &res)
+	return
+}
+
+// DAOSpendCap - Max amount of uPOKT moved out of the dao in a spending period
+func (k Keeper) DAOSpendCap(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultDAOSpendCap
+	k.paramstore.GetIfExists(ctx, types.DAOSpendCapKey, &res)
+	return
+}
+
+// DAOTimelockThreshold - Amount of uPOKT above which dao actions are queued
+func (k Keeper) DAOTimelockThreshold(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultDAOTimelockThreshold
+	k.paramstore.GetIfExists(ctx, types.DAOTimelockThresholdKey, &res)
+	return
+}
+
+// DAOTimelockPeriod - Number of blocks a queued dao action waits before its execution
+func (k Keeper) DAOTimelockPeriod(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultDAOTimelockPeriod
+	k.paramstore.GetIfExists(ctx, types.DAOTimelockPeriodKey, &res)
+	return
+}
+
 func (k Keeper) GetCodec() *codec.Codec {
 	return k.cdc
 }
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// SetPendingParamChange - Store a param change scheduled for its activation height
+func (k Keeper) SetPendingParamChange(ctx sdk.Ctx, change types.PendingParamChange) {
+	store := ctx.KVStore(k.key)
+	bz, _ := k.cdc.MarshalBinaryLengthPrefixed(&change, ctx.BlockHeight())
+	_ = store.Set(types.KeyForPendingParamChange(change.ActivationHeight, change.ParamKey), bz)
+}
+
+// GetPendingParamChanges - Retrieve the scheduled param changes, ordered by activation height
+func (k Keeper) GetPendingParamChanges(ctx sdk.Ctx) (changes []types.PendingParamChange) {
+	changes = make([]types.PendingParamChange, 0)
+	k.IterateAndExecuteOverPendingParamChanges(ctx, func(change types.PendingParamChange) (stop bool) {
+		changes = append(changes, change)
+		return false
+	})
+	return
+}
+
+// IterateAndExecuteOverPendingParamChanges - Goes over the scheduled param changes and executes handler
+func (k Keeper) IterateAndExecuteOverPendingParamChanges(ctx sdk.Ctx, handler func(change types.PendingParamChange) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.PendingParamsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var change types.PendingParamChange
+		_ = k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &change, ctx.BlockHeight())
+		if handler(change) {
+			break
+		}
+	}
+}
+
+// getMaturePendingParamChanges - Retrieve the param changes scheduled at or before height
+func (k Keeper) getMaturePendingParamChanges(ctx sdk.Ctx, height int64) (changes []types.PendingParamChange) {
+	store := ctx.KVStore(k.key)
+	iter, _ := store.Iterator(types.PendingParamsKey, sdk.PrefixEndBytes(types.KeyForPendingParamChangesAtHeight(height)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var change types.PendingParamChange
+		_ = k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &change, ctx.BlockHeight())
+		changes = append(changes, change)
+	}
+	return
+}
+
+func (k Keeper) deletePendingParamChange(ctx sdk.Ctx, change types.PendingParamChange) {
+	store := ctx.KVStore(k.key)
+	_ = store.Delete(types.KeyForPendingParamChange(change.ActivationHeight, change.ParamKey))
+}
+
+// ScheduleParamChange - Store ops when a param change with an activation height is submitted;
+// a change of the same param scheduled at the same height is replaced
+func (k Keeper) ScheduleParamChange(ctx sdk.Ctx, msg types.MsgChangeParam) sdk.Result {
+	if !k.cdc.IsAfterScheduledParamUpgrade(ctx.BlockHeight()) {
+		return sdk.ErrUnknownRequest("scheduled param changes are not enabled").Result()
+	}
+	if msg.ActivationHeight <= ctx.BlockHeight() {
+		return types.ErrInvalidActivationHeight(k.codespace, msg.ActivationHeight, ctx.BlockHeight()).Result()
+	}
+	if err := k.VerifyACL(ctx, msg.ParamKey, msg.FromAddress); err != nil {
+		return err.Result()
+	}
+	// unmarshal the value into the param on a throwaway context so a bad value fails now and not at activation
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.UpdateParam(cacheCtx, msg.ParamKey, msg.ParamVal); err != nil {
+		return err.Result()
+	}
+	k.SetPendingParamChange(ctx, types.PendingParamChange{
+		ParamKey:         msg.ParamKey,
+		ParamVal:         msg.ParamVal,
+		ActivationHeight: msg.ActivationHeight,
+		Owner:            msg.FromAddress,
+		ScheduledHeight:  ctx.BlockHeight(),
+	})
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventScheduleParam,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, fmt.Sprintf("scheduled: %s to: %v", msg.ParamKey, msg.ParamVal)),
+			sdk.NewAttribute(types.AttributeActivation, fmt.Sprintf("%d", msg.ActivationHeight)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// activatePendingParamChanges - Apply the param changes scheduled at or before the current height;
+// the ACL is verified again so a change of its owner in the meantime cancels the change
+func (k Keeper) activatePendingParamChanges(ctx sdk.Ctx) {
+	if !k.cdc.IsAfterScheduledParamUpgrade(ctx.BlockHeight()) {
+		return
+	}
+	for _, change := range k.getMaturePendingParamChanges(ctx, ctx.BlockHeight()) {
+		k.deletePendingParamChange(ctx, change)
+		res := k.ModifyParam(ctx, change.ParamKey, change.ParamVal, change.Owner)
+		if !res.IsOK() {
+			k.Logger(ctx).Error(fmt.Sprintf("unable to activate the scheduled change of %s: %s", change.ParamKey, res.Log))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventActivateParam,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, fmt.Sprintf("activated: %s to: %v", change.ParamKey, change.ParamVal)),
+			sdk.NewAttribute(types.AttributeActivation, fmt.Sprintf("%d", change.ActivationHeight)),
+			sdk.NewAttribute(types.AttributeStatus, fmt.Sprintf("%t", res.IsOK())),
+			sdk.NewAttribute(sdk.AttributeKeySender, change.Owner.String()),
+		))
+	}
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/go-amino"
+)
+
+func TestScheduleParamChange(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	aclKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	owner := k.GetDAOOwner(ctx)
+	newOwner := getRandomValidatorAddress()
+	value, _ := amino.MarshalJSON(newOwner)
+	msg := types.MsgChangeParam{
+		FromAddress:      k.GetACL(ctx).GetOwner(aclKey),
+		ParamKey:         aclKey,
+		ParamVal:         value,
+		ActivationHeight: 10,
+	}
+	// the activation height must be in the future
+	assert.Equal(t, types.CodeInvalidActivationHeight, k.ScheduleParamChange(ctx, msg).Code)
+	msg.ActivationHeight = 15
+	// only the ACL owner can schedule
+	unauthorized := msg
+	unauthorized.FromAddress = getRandomValidatorAddress()
+	assert.Equal(t, types.CodeUnauthorizedParamChange, k.ScheduleParamChange(ctx, unauthorized).Code)
+	// a value that doesn't unmarshal into the param is rejected when scheduled
+	invalid := msg
+	invalid.ParamVal = []byte(`"not an address"`)
+	assert.Equal(t, types.CodeSettingParameter, k.ScheduleParamChange(ctx, invalid).Code)
+	assert.Empty(t, k.GetPendingParamChanges(ctx))
+	assert.Equal(t, owner, k.GetDAOOwner(ctx))
+	assert.True(t, k.ScheduleParamChange(ctx, msg).IsOK())
+	pending := k.GetPendingParamChanges(ctx)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, int64(15), pending[0].ActivationHeight)
+	assert.Equal(t, int64(10), pending[0].ScheduledHeight)
+	assert.Equal(t, pending, k.ExportGenesis(ctx).PendingParamChanges)
+	// nothing changes before the activation height
+	k.EndBlocker(ctx.WithBlockHeight(14))
+	assert.Equal(t, owner, k.GetDAOOwner(ctx))
+	k.EndBlocker(ctx.WithBlockHeight(15))
+	assert.Equal(t, newOwner, k.GetDAOOwner(ctx))
+	assert.Empty(t, k.GetPendingParamChanges(ctx))
+}
@@ -0,0 +1,415 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	nodesExported "github.com/pokt-network/pocket-core/x/nodes/exported"
+)
+
+// SetProposal - Store a proposal
+func (k Keeper) SetProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	store := ctx.KVStore(k.key)
+	bz, _ := k.cdc.MarshalBinaryLengthPrefixed(&proposal, ctx.BlockHeight())
+	_ = store.Set(types.KeyForProposal(proposal.ID), bz)
+}
+
+// GetProposal - Retrieve a proposal by id
+func (k Keeper) GetProposal(ctx sdk.Ctx, id uint64) (proposal types.Proposal, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForProposal(id))
+	if bz == nil {
+		return proposal, false
+	}
+	_ = k.cdc.UnmarshalBinaryLengthPrefixed(bz, &proposal, ctx.BlockHeight())
+	return proposal, true
+}
+
+// GetProposals - Retrieve all of the proposals, ordered by id
+func (k Keeper) GetProposals(ctx sdk.Ctx) (proposals []types.Proposal) {
+	proposals = make([]types.Proposal, 0)
+	k.IterateAndExecuteOverProposals(ctx, func(proposal types.Proposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+	return
+}
+
+// IterateAndExecuteOverProposals - Goes over all of the proposals and executes handler
+func (k Keeper) IterateAndExecuteOverProposals(ctx sdk.Ctx, handler func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.ProposalsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var proposal types.Proposal
+		_ = k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &proposal, ctx.BlockHeight())
+		if handler(proposal) {
+			break
+		}
+	}
+}
+
+// SetVote - Store a vote on a proposal, replacing any previous vote of the voter
+func (k Keeper) SetVote(ctx sdk.Ctx, vote types.Vote) {
+	store := ctx.KVStore(k.key)
+	bz, _ := k.cdc.MarshalBinaryLengthPrefixed(&vote, ctx.BlockHeight())
+	_ = store.Set(types.KeyForVote(vote.ProposalID, vote.Voter), bz)
+}
+
+// GetVotes - Retrieve the votes on a proposal
+func (k Keeper) GetVotes(ctx sdk.Ctx, id uint64) (votes []types.Vote) {
+	votes = make([]types.Vote, 0)
+	k.iterateAndExecuteOverVotes(ctx, types.KeyForVotes(id), func(vote types.Vote) (stop bool) {
+		votes = append(votes, vote)
+		return false
+	})
+	return
+}
+
+// IterateAndExecuteOverVotes - Goes over the votes on all of the proposals and executes handler
+func (k Keeper) IterateAndExecuteOverVotes(ctx sdk.Ctx, handler func(vote types.Vote) (stop bool)) {
+	k.iterateAndExecuteOverVotes(ctx, types.VotesKey, handler)
+}
+
+func (k Keeper) iterateAndExecuteOverVotes(ctx sdk.Ctx, prefix []byte, handler func(vote types.Vote) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.Vote
+		_ = k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &vote, ctx.BlockHeight())
+		if handler(vote) {
+			break
+		}
+	}
+}
+
+// SetVoterPower - Store the voting power of a voter on a proposal
+func (k Keeper) SetVoterPower(ctx sdk.Ctx, power types.VoterPower) {
+	store := ctx.KVStore(k.key)
+	bz, _ := power.Power.Marshal()
+	_ = store.Set(types.KeyForVoterPower(power.ProposalID, power.Voter), bz)
+}
+
+// getVoterPower - Retrieve the voting power of a voter on a proposal, zero if outside of its voter set
+func (k Keeper) getVoterPower(ctx sdk.Ctx, id uint64, voter sdk.Address) sdk.BigInt {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForVoterPower(id, voter))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	power := sdk.ZeroInt()
+	_ = power.Unmarshal(bz)
+	return power
+}
+
+// IterateAndExecuteOverVoterPowers - Goes over the voter sets of all of the proposals and executes handler
+func (k Keeper) IterateAndExecuteOverVoterPowers(ctx sdk.Ctx, handler func(power types.VoterPower) (stop bool)) {
+	k.iterateAndExecuteOverVoterPowers(ctx, types.VoterPowersKey, handler)
+}
+
+func (k Keeper) iterateAndExecuteOverVoterPowers(ctx sdk.Ctx, prefix []byte, handler func(power types.VoterPower) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.VoterPowersKey):]
+		power := types.VoterPower{
+			ProposalID: binary.BigEndian.Uint64(key[:8]),
+			Voter:      sdk.Address(append([]byte{}, key[8:]...)),
+			Power:      sdk.ZeroInt(),
+		}
+		_ = power.Power.Unmarshal(iter.Value())
+		if handler(power) {
+			break
+		}
+	}
+}
+
+// deleteVoterPowers - Remove the voter set of a proposal once it is tallied
+func (k Keeper) deleteVoterPowers(ctx sdk.Ctx, id uint64) {
+	store := ctx.KVStore(k.key)
+	var keys [][]byte
+	k.iterateAndExecuteOverVoterPowers(ctx, types.KeyForVoterPowers(id), func(power types.VoterPower) (stop bool) {
+		keys = append(keys, types.KeyForVoterPower(id, power.Voter))
+		return false
+	})
+	for _, key := range keys {
+		_ = store.Delete(key)
+	}
+}
+
+// snapshotVoterSet - Store the voting power of the configured voter set for the proposal, returning the total
+func (k Keeper) snapshotVoterSet(ctx sdk.Ctx, id uint64) sdk.BigInt {
+	total := sdk.ZeroInt()
+	snapshot := func(voter sdk.Address, power sdk.BigInt) {
+		k.SetVoterPower(ctx, types.VoterPower{ProposalID: id, Voter: voter, Power: power})
+		total = total.Add(power)
+	}
+	switch k.ProposalVoterSet(ctx) {
+	case types.ACLVoterSet:
+		voters := make([]string, 0)
+		for voter := range k.aclVoters(ctx) {
+			voters = append(voters, voter)
+		}
+		sort.Strings(voters)
+		for _, voter := range voters {
+			addr, _ := sdk.AddressFromHex(voter)
+			snapshot(addr, sdk.OneInt())
+		}
+	case types.StakeVoterSet:
+		if k.PosKeeper == nil {
+			break
+		}
+		k.PosKeeper.IterateAndExecuteOverStakedVals(ctx, func(_ int64, validator nodesExported.ValidatorI) (stop bool) {
+			snapshot(validator.GetAddress(), validator.GetTokens())
+			return false
+		})
+	}
+	return total
+}
+
+// getNextProposalID - Retrieve the id to be used by the next proposal
+func (k Keeper) getNextProposalID(ctx sdk.Ctx) uint64 {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.NextProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextProposalID(ctx sdk.Ctx, id uint64) {
+	store := ctx.KVStore(k.key)
+	_ = store.Set(types.NextProposalIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// insertActiveProposal - Add a proposal to the queue of proposals open for votes
+func (k Keeper) insertActiveProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	store := ctx.KVStore(k.key)
+	_ = store.Set(types.KeyForActiveProposal(proposal.VotingEndHeight, proposal.ID), []byte{})
+}
+
+// deleteActiveProposal - Remove a proposal from the queue of proposals open for votes
+func (k Keeper) deleteActiveProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	store := ctx.KVStore(k.key)
+	_ = store.Delete(types.KeyForActiveProposal(proposal.VotingEndHeight, proposal.ID))
+}
+
+// getMatureProposals - Retrieve the active proposals whose voting period ends at or before height
+func (k Keeper) getMatureProposals(ctx sdk.Ctx, height int64) (ids []uint64) {
+	store := ctx.KVStore(k.key)
+	iter, _ := store.Iterator(types.ActiveProposalsKey, sdk.PrefixEndBytes(types.KeyForActiveProposalsAtHeight(height)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		ids = append(ids, types.ProposalIDFromActiveProposalKey(iter.Key()))
+	}
+	return
+}
+
+// aclVoters - The distinct owners of the ACL keys
+func (k Keeper) aclVoters(ctx sdk.Ctx) map[string]struct{} {
+	voters := make(map[string]struct{})
+	for _, pair := range k.GetACL(ctx) {
+		if pair.Addr != nil {
+			voters[pair.Addr.String()] = struct{}{}
+		}
+	}
+	return voters
+}
+
+// VotingPower - The voting power of the account under the configured voter set
+func (k Keeper) VotingPower(ctx sdk.Ctx, addr sdk.Address) sdk.BigInt {
+	switch k.ProposalVoterSet(ctx) {
+	case types.ACLVoterSet:
+		if _, found := k.aclVoters(ctx)[addr.String()]; found {
+			return sdk.OneInt()
+		}
+	case types.StakeVoterSet:
+		if k.PosKeeper == nil {
+			break
+		}
+		validator := k.PosKeeper.Validator(ctx, addr)
+		if validator != nil && validator.IsStaked() {
+			return validator.GetTokens()
+		}
+	}
+	return sdk.ZeroInt()
+}
+
+// TotalVotingPower - The voting power of the whole configured voter set
+func (k Keeper) TotalVotingPower(ctx sdk.Ctx) sdk.BigInt {
+	switch k.ProposalVoterSet(ctx) {
+	case types.ACLVoterSet:
+		return sdk.NewInt(int64(len(k.aclVoters(ctx))))
+	case types.StakeVoterSet:
+		if k.PosKeeper != nil {
+			return k.PosKeeper.GetStakedTokens(ctx)
+		}
+	}
+	return sdk.ZeroInt()
+}
+
+// SubmitProposal - Store ops when a proposal is submitted
+func (k Keeper) SubmitProposal(ctx sdk.Ctx, msg types.MsgSubmitProposal) sdk.Result {
+	if !k.cdc.IsAfterGovProposalUpgrade(ctx.BlockHeight()) {
+		return sdk.ErrUnknownRequest("unrecognized gov message type: " + msg.Type()).Result()
+	}
+	if !k.VotingPower(ctx, msg.Proposer).IsPositive() {
+		return types.ErrUnauthorizedVoter(k.codespace, msg.Proposer).Result()
+	}
+	acl := k.GetACL(ctx)
+	paramNames := k.GetAllParamNames(ctx)
+	// the changes are applied in order on a discarded cache to reject the values that can't be set
+	cacheCtx, _ := ctx.CacheContext()
+	for _, change := range msg.Changes {
+		if _, found := paramNames[change.Key]; !found {
+			return types.ErrInvalidProposal(k.codespace, "unrecognized param key "+change.Key).Result()
+		}
+		if acl.GetOwner(change.Key) == nil {
+			return types.ErrInvalidProposal(k.codespace, "no ACL owner for param key "+change.Key).Result()
+		}
+		if err := k.UpdateParam(cacheCtx, change.Key, change.Value); err != nil {
+			return err.Result()
+		}
+	}
+	if msg.Upgrade != nil && acl.GetOwner(types.NewACLKey(types.ModuleName, string(types.UpgradeKey))) == nil {
+		return types.ErrInvalidProposal(k.codespace, "no ACL owner for the upgrade").Result()
+	}
+	id := k.getNextProposalID(ctx)
+	proposal := types.NewProposal(id, msg.Proposer, msg.Changes, msg.Upgrade, ctx.BlockHeight(), ctx.BlockHeight()+k.ProposalVotingPeriod(ctx))
+	// the votes are weighted by the voter set at submission, so stake moved during the voting period doesn't count
+	proposal.TotalPower = k.snapshotVoterSet(ctx, id)
+	k.SetProposal(ctx, proposal)
+	k.insertActiveProposal(ctx, proposal)
+	k.setNextProposalID(ctx, id+1)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventSubmitProposal,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Vote - Store ops when a vote is cast on a proposal
+func (k Keeper) Vote(ctx sdk.Ctx, msg types.MsgVote) sdk.Result {
+	if !k.cdc.IsAfterGovProposalUpgrade(ctx.BlockHeight()) {
+		return sdk.ErrUnknownRequest("unrecognized gov message type: " + msg.Type()).Result()
+	}
+	proposal, found := k.GetProposal(ctx, msg.ProposalID)
+	if !found {
+		return types.ErrProposalNotFound(k.codespace, msg.ProposalID).Result()
+	}
+	if !proposal.IsActive() || ctx.BlockHeight() > proposal.VotingEndHeight {
+		return types.ErrProposalNotActive(k.codespace, msg.ProposalID).Result()
+	}
+	if !k.getVoterPower(ctx, msg.ProposalID, msg.Voter).IsPositive() {
+		return types.ErrUnauthorizedVoter(k.codespace, msg.Voter).Result()
+	}
+	k.SetVote(ctx, types.Vote{
+		ProposalID: msg.ProposalID,
+		Voter:      msg.Voter,
+		Approve:    msg.Approve,
+		Height:     ctx.BlockHeight(),
+	})
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", msg.ProposalID)),
+			sdk.NewAttribute(sdk.AttributeKeyAction, fmt.Sprintf("approve: %t", msg.Approve)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// tallyProposals - Tally the proposals whose voting period ended and execute the ones that pass
+func (k Keeper) tallyProposals(ctx sdk.Ctx) {
+	if !k.cdc.IsAfterGovProposalUpgrade(ctx.BlockHeight()) {
+		return
+	}
+	for _, id := range k.getMatureProposals(ctx, ctx.BlockHeight()) {
+		proposal, found := k.GetProposal(ctx, id)
+		if !found {
+			continue
+		}
+		k.deleteActiveProposal(ctx, proposal)
+		proposal = k.tally(ctx, proposal)
+		k.deleteVoterPowers(ctx, proposal.ID)
+		if proposal.Passes(k.ProposalQuorum(ctx), k.ProposalThreshold(ctx)) {
+			proposal.Status = types.ProposalStatusPassed
+			if err := k.executeProposal(ctx, proposal); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("unable to execute proposal %d: %s", proposal.ID, err.Error()))
+				proposal.Status = types.ProposalStatusFailed
+			}
+		} else {
+			proposal.Status = types.ProposalStatusRejected
+		}
+		k.SetProposal(ctx, proposal)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventProposalTally,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeStatus, proposal.Status),
+		))
+	}
+}
+
+// tally - Sum the voting power of the votes on the proposal, as snapshotted at its submission
+func (k Keeper) tally(ctx sdk.Ctx, proposal types.Proposal) types.Proposal {
+	proposal.YesPower, proposal.NoPower = sdk.ZeroInt(), sdk.ZeroInt()
+	for _, vote := range k.GetVotes(ctx, proposal.ID) {
+		power := k.getVoterPower(ctx, proposal.ID, vote.Voter)
+		if vote.Approve {
+			proposal.YesPower = proposal.YesPower.Add(power)
+		} else {
+			proposal.NoPower = proposal.NoPower.Add(power)
+		}
+	}
+	return proposal
+}
+
+// executeProposal - Apply the changes of a passed proposal on behalf of the ACL owners; either all changes apply or none
+func (k Keeper) executeProposal(ctx sdk.Ctx, proposal types.Proposal) sdk.Error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, change := range proposal.Changes {
+		// ModifyParam doesn't fail on a value that can't be set, so the change is tried on a discarded cache first
+		checkCtx, _ := cacheCtx.CacheContext()
+		if err := k.UpdateParam(checkCtx, change.Key, change.Value); err != nil {
+			return types.ErrInvalidProposal(k.codespace, err.Error())
+		}
+		// the owner is looked up for every change as the proposal may change the ACL itself
+		res := k.ModifyParam(cacheCtx, change.Key, change.Value, k.GetACL(cacheCtx).GetOwner(change.Key))
+		if !res.IsOK() {
+			return types.ErrInvalidProposal(k.codespace, res.Log)
+		}
+	}
+	// the upgrade goes last as it also updates the process wide upgrade heights
+	if proposal.Upgrade != nil {
+		aclKey := types.NewACLKey(types.ModuleName, string(types.UpgradeKey))
+		res := k.HandleUpgrade(cacheCtx, aclKey, *proposal.Upgrade, k.GetACL(cacheCtx).GetOwner(aclKey))
+		if !res.IsOK() {
+			return types.ErrInvalidProposal(k.codespace, res.Log)
+		}
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
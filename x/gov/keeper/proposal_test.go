@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/go-amino"
+)
+
+func TestProposal_ACLVoters(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	acl := k.GetACL(ctx)
+	daoOwnerKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	newOwner := getRandomValidatorAddress()
+	value, _ := amino.MarshalJSON(newOwner)
+	proposer := acl.GetOwner(daoOwnerKey)
+	msg := types.MsgSubmitProposal{
+		Proposer: proposer,
+		Changes:  []types.ParamChange{{Key: daoOwnerKey, Value: value}},
+	}
+	// accounts outside of the voter set can't propose
+	outsider := getRandomValidatorAddress()
+	msg.Proposer = outsider
+	assert.Equal(t, types.CodeUnauthorizedVoter, k.SubmitProposal(ctx, msg).Code)
+	msg.Proposer = proposer
+	// unknown params can't be proposed
+	msg.Changes[0].Key = "gov/unknown"
+	assert.Equal(t, types.CodeInvalidProposal, k.SubmitProposal(ctx, msg).Code)
+	msg.Changes[0].Key = daoOwnerKey
+	// values that can't be set can't be proposed
+	msg.Changes[0].Value = []byte(`"not an address"`)
+	assert.Equal(t, types.CodeSettingParameter, k.SubmitProposal(ctx, msg).Code)
+	msg.Changes[0].Value = value
+	assert.True(t, k.SubmitProposal(ctx, msg).IsOK())
+	proposal, found := k.GetProposal(ctx, 1)
+	assert.True(t, found)
+	assert.True(t, proposal.IsActive())
+	assert.Equal(t, ctx.BlockHeight()+types.DefaultProposalVotingPeriod, proposal.VotingEndHeight)
+	// every distinct ACL owner has one vote
+	assert.True(t, k.TotalVotingPower(ctx).Equal(sdk.NewInt(int64(len(k.aclVoters(ctx))))))
+	voters := acl.GetAll()
+	yes := 0
+	for key, voter := range voters {
+		approve := key != daoOwnerKey
+		if approve {
+			yes++
+		}
+		assert.True(t, k.Vote(ctx, types.MsgVote{Voter: voter, ProposalID: 1, Approve: approve}).IsOK())
+	}
+	assert.Equal(t, types.CodeUnauthorizedVoter, k.Vote(ctx, types.MsgVote{Voter: outsider, ProposalID: 1, Approve: true}).Code)
+	assert.Equal(t, types.CodeProposalNotFound, k.Vote(ctx, types.MsgVote{Voter: proposer, ProposalID: 2, Approve: true}).Code)
+	assert.Len(t, k.GetVotes(ctx, 1), len(voters))
+	// nothing happens before the end of the voting period
+	k.EndBlocker(ctx)
+	proposal, _ = k.GetProposal(ctx, 1)
+	assert.True(t, proposal.IsActive())
+	assert.NotEqual(t, newOwner, k.GetDAOOwner(ctx))
+	// the passed proposal is executed at the end of the voting period
+	ctx = ctx.WithBlockHeight(proposal.VotingEndHeight)
+	k.EndBlocker(ctx)
+	proposal, _ = k.GetProposal(ctx, 1)
+	assert.Equal(t, types.ProposalStatusPassed, proposal.Status)
+	assert.True(t, proposal.YesPower.Equal(sdk.NewInt(int64(yes))))
+	assert.True(t, proposal.NoPower.Equal(sdk.OneInt()))
+	assert.Equal(t, newOwner, k.GetDAOOwner(ctx))
+	// votes are no longer accepted
+	assert.Equal(t, types.CodeProposalNotActive, k.Vote(ctx, types.MsgVote{Voter: proposer, ProposalID: 1, Approve: true}).Code)
+}
+
+func TestProposal_Rejected(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	daoOwnerKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	owner := k.GetDAOOwner(ctx)
+	value, _ := amino.MarshalJSON(getRandomValidatorAddress())
+	proposer := k.GetACL(ctx).GetOwner(daoOwnerKey)
+	assert.True(t, k.SubmitProposal(ctx, types.MsgSubmitProposal{
+		Proposer: proposer,
+		Changes:  []types.ParamChange{{Key: daoOwnerKey, Value: value}},
+	}).IsOK())
+	// a single yes vote doesn't reach the quorum
+	assert.True(t, k.Vote(ctx, types.MsgVote{Voter: proposer, ProposalID: 1, Approve: true}).IsOK())
+	proposal, _ := k.GetProposal(ctx, 1)
+	ctx = ctx.WithBlockHeight(proposal.VotingEndHeight)
+	k.EndBlocker(ctx)
+	proposal, _ = k.GetProposal(ctx, 1)
+	assert.Equal(t, types.ProposalStatusRejected, proposal.Status)
+	assert.Equal(t, owner, k.GetDAOOwner(ctx))
+	// the proposals are exported
+	gs := k.ExportGenesis(ctx)
+	assert.Equal(t, []types.Proposal{proposal}, gs.Proposals)
+	assert.Len(t, gs.Votes, 1)
+}
+
+func TestProposal_ExecuteInvalidValue(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	daoOwnerKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	owner := k.GetDAOOwner(ctx)
+	value, _ := amino.MarshalJSON(getRandomValidatorAddress())
+	proposal := types.NewProposal(1, owner, []types.ParamChange{
+		{Key: daoOwnerKey, Value: value},
+		{Key: daoOwnerKey, Value: []byte(`"not an address"`)},
+	}, nil, ctx.BlockHeight(), ctx.BlockHeight())
+	// a change that can't be set fails the execution and none of the changes apply
+	err := k.executeProposal(ctx, proposal)
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeInvalidProposal, err.Code())
+	assert.Equal(t, owner, k.GetDAOOwner(ctx))
+}
+
+func TestProposal_StakeVoterSetSnapshot(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	pos := fakePosKeeper{}
+	stake := func(tokens int64) sdk.Address {
+		addr := getRandomValidatorAddress()
+		pos[addr.String()] = nodesTypes.Validator{Address: addr, Status: sdk.Staked, StakedTokens: sdk.NewInt(tokens)}
+		return addr
+	}
+	v1, v2, v3 := stake(100), stake(200), stake(300)
+	k.PosKeeper = pos
+	k.paramstore.Set(ctx, types.ProposalVoterSetKey, types.StakeVoterSet)
+	daoOwnerKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	value, _ := amino.MarshalJSON(getRandomValidatorAddress())
+	assert.True(t, k.SubmitProposal(ctx, types.MsgSubmitProposal{
+		Proposer: v1,
+		Changes:  []types.ParamChange{{Key: daoOwnerKey, Value: value}},
+	}).IsOK())
+	proposal, _ := k.GetProposal(ctx, 1)
+	assert.True(t, proposal.TotalPower.Equal(sdk.NewInt(600)))
+	// validators staked after the submission can't vote and stake added after it doesn't count
+	v4 := stake(1000)
+	assert.Equal(t, types.CodeUnauthorizedVoter, k.Vote(ctx, types.MsgVote{Voter: v4, ProposalID: 1, Approve: true}).Code)
+	raised := pos[v3.String()]
+	raised.StakedTokens = sdk.NewInt(3000)
+	pos[v3.String()] = raised
+	assert.True(t, k.Vote(ctx, types.MsgVote{Voter: v1, ProposalID: 1, Approve: true}).IsOK())
+	assert.True(t, k.Vote(ctx, types.MsgVote{Voter: v2, ProposalID: 1, Approve: true}).IsOK())
+	assert.True(t, k.Vote(ctx, types.MsgVote{Voter: v3, ProposalID: 1, Approve: false}).IsOK())
+	assert.Len(t, k.ExportGenesis(ctx).VoterPowers, 3)
+	ctx = ctx.WithBlockHeight(proposal.VotingEndHeight)
+	k.EndBlocker(ctx)
+	proposal, _ = k.GetProposal(ctx, 1)
+	assert.True(t, proposal.YesPower.Equal(sdk.NewInt(300)))
+	assert.True(t, proposal.NoPower.Equal(sdk.NewInt(300)))
+	assert.True(t, proposal.TotalPower.Equal(sdk.NewInt(600)))
+	// the snapshot is dropped once the proposal is tallied
+	assert.Empty(t, k.ExportGenesis(ctx).VoterPowers)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/gov/types"
@@ -19,6 +21,22 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDAOOwner(ctx, k)
 		case types.QueryUpgrade:
 			return queryUpgrade(ctx, k)
+		case types.QueryProposals:
+			return queryProposals(ctx, k)
+		case types.QueryProposal:
+			return queryProposal(ctx, req, k)
+		case types.QueryVotes:
+			return queryVotes(ctx, req, k)
+		case types.QueryParamQueue:
+			return queryPendingParamChanges(ctx, k)
+		case types.QueryHistory:
+			return queryParamHistory(ctx, req, k)
+		case types.QueryDAOQueue:
+			return queryDAOQueue(ctx, k)
+		case types.QueryDAOSpending:
+			return queryDAOSpending(ctx, k)
+		case types.QueryReadiness:
+			return queryUpgradeReadiness(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -60,3 +78,98 @@ func queryUpgrade(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
 	}
 	return res, nil
 }
+
+func queryProposals(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	proposals := k.GetProposals(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, proposals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryProposal(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	proposal, found := k.GetProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, types.ErrProposalNotFound(types.DefaultCodespace, params.ProposalID)
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, proposal)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryVotes(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	votes := k.GetVotes(ctx, params.ProposalID)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, votes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryPendingParamChanges(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	changes := k.GetPendingParamChanges(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, changes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryParamHistory(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamHistoryParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	records := k.GetParamHistory(ctx, params.Key, params.FromHeight, params.ToHeight)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryDAOQueue(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	transfers := k.GetQueuedDAOTransfers(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, transfers)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryDAOSpending(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	status := k.GetDAOSpendingStatus(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, status)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryUpgradeReadiness(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryReadinessParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	readiness := k.GetUpgradeReadiness(ctx, params.Version)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, readiness)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
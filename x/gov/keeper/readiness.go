@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// SetVersionSignal - Store the version signalled by a validator
+func (k Keeper) SetVersionSignal(ctx sdk.Ctx, signal types.VersionSignal) {
+	store := ctx.KVStore(k.key)
+	bz, _ := k.cdc.MarshalBinaryLengthPrefixed(&signal, ctx.BlockHeight())
+	_ = store.Set(types.KeyForVersionSignal(signal.Address), bz)
+}
+
+// GetVersionSignal - Retrieve the version signalled by the validator with addr
+func (k Keeper) GetVersionSignal(ctx sdk.Ctx, addr sdk.Address) (signal types.VersionSignal, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForVersionSignal(addr))
+	if bz == nil {
+		return
+	}
+	_ = k.cdc.UnmarshalBinaryLengthPrefixed(bz, &signal, ctx.BlockHeight())
+	return signal, true
+}
+
+// GetVersionSignals - Retrieve the versions signalled by the validators
+func (k Keeper) GetVersionSignals(ctx sdk.Ctx) (signals []types.VersionSignal) {
+	signals = make([]types.VersionSignal, 0)
+	k.IterateAndExecuteOverVersionSignals(ctx, func(signal types.VersionSignal) (stop bool) {
+		signals = append(signals, signal)
+		return false
+	})
+	return
+}
+
+// IterateAndExecuteOverVersionSignals - Goes over the signalled versions and executes handler
+func (k Keeper) IterateAndExecuteOverVersionSignals(ctx sdk.Ctx, handler func(signal types.VersionSignal) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.VersionSignalsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var signal types.VersionSignal
+		_ = k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &signal, ctx.BlockHeight())
+		if handler(signal) {
+			break
+		}
+	}
+}
+
+// SignalVersion - Store ops when a validator signals the version of its binary;
+// a new signal replaces the previous one
+func (k Keeper) SignalVersion(ctx sdk.Ctx, msg types.MsgSignalVersion) sdk.Result {
+	if !k.cdc.IsAfterUpgradeSignalUpgrade(ctx.BlockHeight()) {
+		return sdk.ErrUnknownRequest("version signals are not enabled").Result()
+	}
+	if k.PosKeeper == nil {
+		return types.ErrNotStakedValidator(k.codespace, msg.Address).Result()
+	}
+	validator := k.PosKeeper.Validator(ctx, msg.Address)
+	if validator == nil || !validator.IsStaked() {
+		return types.ErrNotStakedValidator(k.codespace, msg.Address).Result()
+	}
+	k.SetVersionSignal(ctx, types.VersionSignal{
+		Address: msg.Address,
+		Version: msg.Version,
+		Height:  ctx.BlockHeight(),
+	})
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventSignalVersion,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeVersion, msg.Version),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// GetUpgradeReadiness - The staked tokens of the validators that signalled at least version
+// (the version of the scheduled upgrade if empty) against all of the staked tokens
+func (k Keeper) GetUpgradeReadiness(ctx sdk.Ctx, version string) types.UpgradeReadiness {
+	upgrade := k.GetUpgrade(ctx)
+	if version == "" {
+		version = upgrade.Version
+	}
+	readiness := types.UpgradeReadiness{
+		Version:     version,
+		ReadyTokens: sdk.ZeroInt(),
+		TotalTokens: sdk.ZeroInt(),
+		Versions:    make([]types.VersionStake, 0),
+	}
+	if version == upgrade.Version {
+		readiness.Height = upgrade.Height
+	}
+	if k.PosKeeper == nil {
+		return readiness
+	}
+	readiness.TotalTokens = k.PosKeeper.GetStakedTokens(ctx)
+	stakes := make(map[string]sdk.BigInt)
+	k.IterateAndExecuteOverVersionSignals(ctx, func(signal types.VersionSignal) (stop bool) {
+		validator := k.PosKeeper.Validator(ctx, signal.Address)
+		if validator == nil || !validator.IsStaked() {
+			return false
+		}
+		tokens, found := stakes[signal.Version]
+		if !found {
+			tokens = sdk.ZeroInt()
+		}
+		stakes[signal.Version] = tokens.Add(validator.GetTokens())
+		if comp, err := sdk.CompareVersionStrings(signal.Version, version); err == nil && comp >= 0 {
+			readiness.ReadyTokens = readiness.ReadyTokens.Add(validator.GetTokens())
+		}
+		return false
+	})
+	for v, tokens := range stakes {
+		readiness.Versions = append(readiness.Versions, types.VersionStake{Version: v, Tokens: tokens})
+	}
+	sort.Slice(readiness.Versions, func(i, j int) bool {
+		return readiness.Versions[i].Version < readiness.Versions[j].Version
+	})
+	return readiness
+}
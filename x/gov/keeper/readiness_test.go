@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	nodesExported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakePosKeeper - a fixed set of validators
+type fakePosKeeper map[string]nodesTypes.Validator
+
+func (f fakePosKeeper) GetStakedTokens(ctx sdk.Ctx) sdk.BigInt {
+	total := sdk.ZeroInt()
+	for _, validator := range f {
+		if validator.IsStaked() {
+			total = total.Add(validator.GetTokens())
+		}
+	}
+	return total
+}
+
+func (f fakePosKeeper) Validator(ctx sdk.Ctx, addr sdk.Address) nodesExported.ValidatorI {
+	validator, found := f[addr.String()]
+	if !found {
+		return nil
+	}
+	return validator
+}
+
+func (f fakePosKeeper) IterateAndExecuteOverStakedVals(ctx sdk.Ctx, fn func(index int64, validator nodesExported.ValidatorI) (stop bool)) {
+	i := int64(0)
+	for _, validator := range f {
+		if !validator.IsStaked() {
+			continue
+		}
+		if fn(i, validator) {
+			return
+		}
+		i++
+	}
+}
+
+func TestUpgradeReadiness(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	pos := fakePosKeeper{}
+	stake := func(tokens int64, status sdk.StakeStatus) sdk.Address {
+		addr := getRandomValidatorAddress()
+		pos[addr.String()] = nodesTypes.Validator{Address: addr, Status: status, StakedTokens: sdk.NewInt(tokens)}
+		return addr
+	}
+	v1, v2, v3 := stake(100, sdk.Staked), stake(200, sdk.Staked), stake(300, sdk.Staked)
+	unstaked := stake(400, sdk.Unstaked)
+	k.PosKeeper = pos
+	k.paramstore.Set(ctx, types.UpgradeKey, types.NewUpgrade(1000, "0.12.0"))
+	// only staked validators can signal
+	assert.Equal(t, types.CodeNotStakedValidator, k.SignalVersion(ctx, types.MsgSignalVersion{Address: unstaked, Version: "0.12.0"}).Code)
+	assert.Equal(t, types.CodeNotStakedValidator, k.SignalVersion(ctx, types.MsgSignalVersion{Address: getRandomValidatorAddress(), Version: "0.12.0"}).Code)
+	assert.True(t, k.SignalVersion(ctx, types.MsgSignalVersion{Address: v1, Version: "0.11.0"}).IsOK())
+	assert.True(t, k.SignalVersion(ctx, types.MsgSignalVersion{Address: v2, Version: "0.12.0"}).IsOK())
+	assert.True(t, k.SignalVersion(ctx, types.MsgSignalVersion{Address: v3, Version: "0.12.1"}).IsOK())
+	readiness := k.GetUpgradeReadiness(ctx, "")
+	assert.Equal(t, "0.12.0", readiness.Version)
+	assert.Equal(t, int64(1000), readiness.Height)
+	assert.True(t, readiness.ReadyTokens.Equal(sdk.NewInt(500)))
+	assert.True(t, readiness.TotalTokens.Equal(sdk.NewInt(600)))
+	assert.Equal(t, []types.VersionStake{
+		{Version: "0.11.0", Tokens: sdk.NewInt(100)},
+		{Version: "0.12.0", Tokens: sdk.NewInt(200)},
+		{Version: "0.12.1", Tokens: sdk.NewInt(300)},
+	}, readiness.Versions)
+	// a new signal replaces the previous one
+	assert.True(t, k.SignalVersion(ctx, types.MsgSignalVersion{Address: v1, Version: "0.12.0"}).IsOK())
+	assert.True(t, k.GetUpgradeReadiness(ctx, "").ReadyTokens.Equal(sdk.NewInt(600)))
+	// any other version can be measured
+	readiness = k.GetUpgradeReadiness(ctx, "0.12.1")
+	assert.Equal(t, int64(0), readiness.Height)
+	assert.True(t, readiness.ReadyTokens.Equal(sdk.NewInt(300)))
+	assert.Len(t, k.ExportGenesis(ctx).VersionSignals, 3)
+}
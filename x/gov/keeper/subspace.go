@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -92,7 +93,9 @@ func (k Keeper) HandleUpgrade(ctx sdk.Ctx, aclKey string, paramValue interface{}
 			k.Logger(ctx).Error(types.ErrSubspaceNotFound(types.ModuleName, subspaceName).Error())
 			os.Exit(1)
 		}
+		oldValue := space.GetIfExistsRaw(ctx, []byte(paramKey))
 		space.Set(ctx, []byte(paramKey), paramValue)
+		k.recordParamChange(ctx, aclKey, oldValue, space.GetIfExistsRaw(ctx, []byte(paramKey)), owner)
 		k.spaces[subspaceName] = space
 		// create the event
 		ctx.EventManager().EmitEvents(sdk.Events{
@@ -161,7 +164,9 @@ func handleUpgradeAfterUpdate(ctx sdk.Ctx, aclKey string, paramValue interface{}
 		newUpgrade.Features = codec.CleanUpgradeFeatureSlice(featureSet)
 	}
 
+	oldValue := space.GetIfExistsRaw(ctx, []byte(paramKey))
 	space.Set(ctx, []byte(paramKey), newUpgrade)
+	k.recordParamChange(ctx, aclKey, oldValue, space.GetIfExistsRaw(ctx, []byte(paramKey)), owner)
 	k.spaces[subspaceName] = space
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -213,8 +218,11 @@ func (k Keeper) ModifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner
 		k.Logger(ctx).Error(types.ErrSubspaceNotFound(types.ModuleName, subspaceName).Error())
 		os.Exit(1)
 	}
+	oldValue := space.GetIfExistsRaw(ctx, []byte(paramKey))
 	// TODO(#1617): Check the return value of `Update` and handle errors appropriately
-	_ = space.Update(ctx, []byte(paramKey), paramValue)
+	if err := space.Update(ctx, []byte(paramKey), paramValue); err == nil {
+		k.recordParamChange(ctx, aclKey, oldValue, space.GetIfExistsRaw(ctx, []byte(paramKey)), owner)
+	}
 	k.spaces[subspaceName] = space
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -232,3 +240,20 @@ func (k Keeper) ModifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner
 	})
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// UpdateParam - Update the param at aclKey with the json paramValue, without checking the ACL,
+// recording the change or emitting events; used to simulate param changes on a cached context of any height
+func (k Keeper) UpdateParam(ctx sdk.Ctx, aclKey string, paramValue []byte) sdk.Error {
+	if !strings.Contains(aclKey, types.ACLKeySep) {
+		return types.ErrUnknownSubspace(k.codespace, aclKey)
+	}
+	subspaceName, paramKey := types.SplitACLKey(aclKey)
+	space, ok := k.spaces[subspaceName]
+	if !ok {
+		return types.ErrUnknownSubspace(k.codespace, subspaceName)
+	}
+	if err := space.UpdateUntracked(ctx, []byte(paramKey), paramValue); err != nil {
+		return types.ErrSettingParameter(k.codespace, aclKey, "", string(paramValue), err.Error())
+	}
+	return nil
+}
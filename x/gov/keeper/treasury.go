@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// SetQueuedDAOTransfer - Store a dao action waiting for its execution height
+func (k Keeper) SetQueuedDAOTransfer(ctx sdk.Ctx, transfer types.QueuedDAOTransfer) {
+	store := ctx.KVStore(k.key)
+	bz, _ := k.cdc.MarshalBinaryLengthPrefixed(&transfer, ctx.BlockHeight())
+	_ = store.Set(types.KeyForDAOTransfer(transfer.ID), bz)
+	_ = store.Set(types.KeyForQueuedDAOTransfer(transfer.ExecutionHeight, transfer.ID), []byte{})
+}
+
+// GetQueuedDAOTransfer - Retrieve the queued dao action with id
+func (k Keeper) GetQueuedDAOTransfer(ctx sdk.Ctx, id uint64) (transfer types.QueuedDAOTransfer, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForDAOTransfer(id))
+	if bz == nil {
+		return
+	}
+	_ = k.cdc.UnmarshalBinaryLengthPrefixed(bz, &transfer, ctx.BlockHeight())
+	return transfer, true
+}
+
+// GetQueuedDAOTransfers - Retrieve the queued dao actions, ordered by execution height
+func (k Keeper) GetQueuedDAOTransfers(ctx sdk.Ctx) (transfers []types.QueuedDAOTransfer) {
+	transfers = make([]types.QueuedDAOTransfer, 0)
+	k.IterateAndExecuteOverQueuedDAOTransfers(ctx, func(transfer types.QueuedDAOTransfer) (stop bool) {
+		transfers = append(transfers, transfer)
+		return false
+	})
+	return
+}
+
+// IterateAndExecuteOverQueuedDAOTransfers - Goes over the queued dao actions and executes handler
+func (k Keeper) IterateAndExecuteOverQueuedDAOTransfers(ctx sdk.Ctx, handler func(transfer types.QueuedDAOTransfer) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.DAOTransferQueueKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		transfer, found := k.GetQueuedDAOTransfer(ctx, types.DAOTransferIDFromQueueKey(iter.Key()))
+		if !found {
+			continue
+		}
+		if handler(transfer) {
+			break
+		}
+	}
+}
+
+// getMatureQueuedDAOTransfers - Retrieve the dao actions queued for execution at or before height
+func (k Keeper) getMatureQueuedDAOTransfers(ctx sdk.Ctx, height int64) (transfers []types.QueuedDAOTransfer) {
+	store := ctx.KVStore(k.key)
+	iter, _ := store.Iterator(types.DAOTransferQueueKey, sdk.PrefixEndBytes(types.KeyForQueuedDAOTransfersAtHeight(height)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if transfer, found := k.GetQueuedDAOTransfer(ctx, types.DAOTransferIDFromQueueKey(iter.Key())); found {
+			transfers = append(transfers, transfer)
+		}
+	}
+	return
+}
+
+func (k Keeper) deleteQueuedDAOTransfer(ctx sdk.Ctx, transfer types.QueuedDAOTransfer) {
+	store := ctx.KVStore(k.key)
+	_ = store.Delete(types.KeyForDAOTransfer(transfer.ID))
+	_ = store.Delete(types.KeyForQueuedDAOTransfer(transfer.ExecutionHeight, transfer.ID))
+}
+
+// getNextDAOTransferID - Retrieve the id to be used by the next queued dao action
+func (k Keeper) getNextDAOTransferID(ctx sdk.Ctx) uint64 {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.NextDAOTransferKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextDAOTransferID(ctx sdk.Ctx, id uint64) {
+	store := ctx.KVStore(k.key)
+	_ = store.Set(types.NextDAOTransferKey, sdk.Uint64ToBigEndian(id))
+}
+
+// daoSpendPeriodStart - The first height of the spending period of the current height
+// (a single period since the activation when the period is disabled)
+func (k Keeper) daoSpendPeriodStart(ctx sdk.Ctx) int64 {
+	period := k.DAOSpendPeriod(ctx)
+	if period <= 0 {
+		return 0
+	}
+	return ctx.BlockHeight() - ctx.BlockHeight()%period
+}
+
+// getDAOSpending - Retrieve the stored dao spending, whichever its period
+func (k Keeper) getDAOSpending(ctx sdk.Ctx) (spending types.DAOSpending, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.DAOSpendingKey)
+	if bz == nil {
+		return
+	}
+	_ = k.cdc.UnmarshalBinaryLengthPrefixed(bz, &spending, ctx.BlockHeight())
+	return spending, true
+}
+
+// SetDAOSpending - Store the dao spending of a period
+func (k Keeper) SetDAOSpending(ctx sdk.Ctx, spending types.DAOSpending) {
+	store := ctx.KVStore(k.key)
+	bz, _ := k.cdc.MarshalBinaryLengthPrefixed(&spending, ctx.BlockHeight())
+	_ = store.Set(types.DAOSpendingKey, bz)
+}
+
+// GetDAOSpending - Retrieve the amount moved out of the dao in the current spending period
+func (k Keeper) GetDAOSpending(ctx sdk.Ctx) types.DAOSpending {
+	start := k.daoSpendPeriodStart(ctx)
+	spending, found := k.getDAOSpending(ctx)
+	if !found || spending.PeriodStart != start {
+		return types.DAOSpending{PeriodStart: start, Spent: sdk.ZeroInt()}
+	}
+	return spending
+}
+
+// GetDAOSpendingStatus - Retrieve the spending of the current period against the cap
+func (k Keeper) GetDAOSpendingStatus(ctx sdk.Ctx) types.DAOSpendingStatus {
+	spending := k.GetDAOSpending(ctx)
+	status := types.DAOSpendingStatus{
+		PeriodStart: spending.PeriodStart,
+		Spent:       spending.Spent,
+		Cap:         sdk.ZeroInt(),
+	}
+	if period := k.DAOSpendPeriod(ctx); period > 0 {
+		status.PeriodEnd = spending.PeriodStart + period - 1
+		status.Cap = sdk.NewInt(k.DAOSpendCap(ctx))
+	}
+	return status
+}
+
+// spendDAOTokens - Add amount to the dao spending of the period if it stays within the cap
+func (k Keeper) spendDAOTokens(ctx sdk.Ctx, amount sdk.BigInt) sdk.Error {
+	if !k.cdc.IsAfterDAOTreasuryUpgrade(ctx.BlockHeight()) {
+		return nil
+	}
+	spending := k.GetDAOSpending(ctx)
+	if k.DAOSpendPeriod(ctx) > 0 && k.DAOSpendCap(ctx) > 0 {
+		remaining := sdk.NewInt(k.DAOSpendCap(ctx)).Sub(spending.Spent)
+		if amount.GT(remaining) {
+			return types.ErrDAOSpendCapExceeded(k.codespace, amount, remaining)
+		}
+	}
+	spending.Spent = spending.Spent.Add(amount)
+	k.SetDAOSpending(ctx, spending)
+	return nil
+}
+
+// isDAOTimelocked - Returns if a dao action of amount must wait for the timelock period
+func (k Keeper) isDAOTimelocked(ctx sdk.Ctx, amount sdk.BigInt) bool {
+	if !k.cdc.IsAfterDAOTreasuryUpgrade(ctx.BlockHeight()) || k.DAOTimelockPeriod(ctx) <= 0 {
+		return false
+	}
+	return amount.GT(sdk.NewInt(k.DAOTimelockThreshold(ctx)))
+}
+
+// queueDAOAction - Store ops when a dao action above the timelock threshold is submitted
+func (k Keeper) queueDAOAction(ctx sdk.Ctx, action types.DAOAction, owner, to sdk.Address, amount sdk.BigInt) sdk.Result {
+	// an action larger than the whole cap could never be executed
+	if k.DAOSpendPeriod(ctx) > 0 && k.DAOSpendCap(ctx) > 0 && amount.GT(sdk.NewInt(k.DAOSpendCap(ctx))) {
+		return types.ErrDAOSpendCapExceeded(k.codespace, amount, sdk.NewInt(k.DAOSpendCap(ctx))).Result()
+	}
+	id := k.getNextDAOTransferID(ctx)
+	transfer := types.QueuedDAOTransfer{
+		ID:              id,
+		FromAddress:     owner,
+		ToAddress:       to,
+		Amount:          amount,
+		Action:          action.String(),
+		QueuedHeight:    ctx.BlockHeight(),
+		ExecutionHeight: ctx.BlockHeight() + k.DAOTimelockPeriod(ctx),
+	}
+	k.SetQueuedDAOTransfer(ctx, transfer)
+	k.setNextDAOTransferID(ctx, id+1)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventDAOQueue,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, transfer.Action),
+			sdk.NewAttribute(types.AttributeTransferID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeExecution, fmt.Sprintf("%d", transfer.ExecutionHeight)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// CancelDAOTransfer - Store ops when the dao owner cancels a queued dao action
+func (k Keeper) CancelDAOTransfer(ctx sdk.Ctx, msg types.MsgCancelDAOTransfer) sdk.Result {
+	if !k.cdc.IsAfterDAOTreasuryUpgrade(ctx.BlockHeight()) {
+		return sdk.ErrUnknownRequest("queued dao actions are not enabled").Result()
+	}
+	if !k.GetDAOOwner(ctx).Equals(msg.FromAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to cancel a dao action %s", msg.FromAddress.String())).Result()
+	}
+	transfer, found := k.GetQueuedDAOTransfer(ctx, msg.TransferID)
+	if !found {
+		return types.ErrDAOTransferNotFound(k.codespace, msg.TransferID).Result()
+	}
+	k.deleteQueuedDAOTransfer(ctx, transfer)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventDAOCancel,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeTransferID, fmt.Sprintf("%d", transfer.ID)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, transfer.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// executeQueuedDAOTransfers - Execute the dao actions whose timelock ended;
+// an action that exceeds the spending cap of the period stays queued until the next period,
+// an action that exceeds the dao balance at that time is dropped
+func (k Keeper) executeQueuedDAOTransfers(ctx sdk.Ctx) {
+	if !k.cdc.IsAfterDAOTreasuryUpgrade(ctx.BlockHeight()) {
+		return
+	}
+	for _, transfer := range k.getMatureQueuedDAOTransfers(ctx, ctx.BlockHeight()) {
+		k.deleteQueuedDAOTransfer(ctx, transfer)
+		cacheCtx, writeCache := ctx.CacheContext()
+		event := types.EventDAOTransfer
+		var err sdk.Error
+		switch transfer.Action {
+		case types.DAOBurnString:
+			event = types.EventDAOBurn
+			err = k.burnDAOTokens(cacheCtx, transfer.Amount)
+		default:
+			err = k.transferDAOTokens(cacheCtx, transfer.ToAddress, transfer.Amount)
+		}
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeTransferID, fmt.Sprintf("%d", transfer.ID)),
+			sdk.NewAttribute(types.AttributeStatus, fmt.Sprintf("%t", err == nil)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, transfer.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, transfer.FromAddress.String()),
+		}
+		switch {
+		case err == nil:
+			writeCache()
+		case err.Code() == types.CodeDAOSpendCapExceeded && k.DAOSpendPeriod(ctx) > 0:
+			// retry at the start of the next spending period
+			transfer.ExecutionHeight = k.daoSpendPeriodStart(ctx) + k.DAOSpendPeriod(ctx)
+			k.SetQueuedDAOTransfer(ctx, transfer)
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeExecution, fmt.Sprintf("%d", transfer.ExecutionHeight)))
+			k.Logger(ctx).Info(fmt.Sprintf("the queued dao action %d exceeds the spending cap, retrying at height %d", transfer.ID, transfer.ExecutionHeight))
+		default:
+			k.Logger(ctx).Error(fmt.Sprintf("unable to execute the queued dao action %d: %s", transfer.ID, err.Error()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(event, attributes...))
+	}
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDAOTreasury_SpendCap(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(105)
+	assert.Nil(t, k.AuthKeeper.MintCoins(ctx, types.DAOAccountName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(1000)))))
+	k.paramstore.Set(ctx, types.DAOSpendPeriodKey, int64(100))
+	k.paramstore.Set(ctx, types.DAOSpendCapKey, int64(300))
+	owner := k.GetDAOOwner(ctx)
+	to := getRandomValidatorAddress()
+	assert.True(t, k.DAOTransferFrom(ctx, owner, to, sdk.NewInt(200)).IsOK())
+	assert.True(t, k.DAOBurn(ctx, owner, sdk.NewInt(100)).IsOK())
+	// the cap of the period is reached
+	assert.Equal(t, types.CodeDAOSpendCapExceeded, k.DAOTransferFrom(ctx, owner, to, sdk.OneInt()).Code)
+	status := k.GetDAOSpendingStatus(ctx)
+	assert.Equal(t, int64(100), status.PeriodStart)
+	assert.Equal(t, int64(199), status.PeriodEnd)
+	assert.True(t, status.Spent.Equal(sdk.NewInt(300)))
+	assert.True(t, status.Cap.Equal(sdk.NewInt(300)))
+	// the next period starts from zero
+	ctx = ctx.WithBlockHeight(200)
+	assert.True(t, k.GetDAOSpending(ctx).Spent.IsZero())
+	assert.True(t, k.DAOTransferFrom(ctx, owner, to, sdk.NewInt(300)).IsOK())
+	assert.Equal(t, int64(400), k.GetDAOTokens(ctx).Int64())
+}
+
+func TestDAOTreasury_Timelock(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	assert.Nil(t, k.AuthKeeper.MintCoins(ctx, types.DAOAccountName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(1000)))))
+	k.paramstore.Set(ctx, types.DAOTimelockThresholdKey, int64(100))
+	k.paramstore.Set(ctx, types.DAOTimelockPeriodKey, int64(5))
+	owner := k.GetDAOOwner(ctx)
+	to := getRandomValidatorAddress()
+	// transfers up to the threshold are immediate
+	assert.True(t, k.DAOTransferFrom(ctx, owner, to, sdk.NewInt(100)).IsOK())
+	assert.Equal(t, int64(900), k.GetDAOTokens(ctx).Int64())
+	// larger ones are queued
+	assert.True(t, k.DAOTransferFrom(ctx, owner, to, sdk.NewInt(200)).IsOK())
+	assert.True(t, k.DAOBurn(ctx, owner, sdk.NewInt(300)).IsOK())
+	assert.Equal(t, int64(900), k.GetDAOTokens(ctx).Int64())
+	queue := k.GetQueuedDAOTransfers(ctx)
+	assert.Len(t, queue, 2)
+	assert.Equal(t, int64(15), queue[0].ExecutionHeight)
+	assert.Equal(t, types.DAOBurnString, queue[1].Action)
+	assert.Equal(t, queue, k.ExportGenesis(ctx).DAOTransferQueue)
+	// only the dao owner can cancel
+	assert.False(t, k.CancelDAOTransfer(ctx, types.MsgCancelDAOTransfer{FromAddress: to, TransferID: 2}).IsOK())
+	assert.True(t, k.CancelDAOTransfer(ctx, types.MsgCancelDAOTransfer{FromAddress: owner, TransferID: 2}).IsOK())
+	assert.Equal(t, types.CodeDAOTransferNotFound, k.CancelDAOTransfer(ctx, types.MsgCancelDAOTransfer{FromAddress: owner, TransferID: 2}).Code)
+	// the queued transfer is executed at the end of the timelock
+	k.EndBlocker(ctx.WithBlockHeight(14))
+	assert.Equal(t, int64(900), k.GetDAOTokens(ctx).Int64())
+	k.EndBlocker(ctx.WithBlockHeight(15))
+	assert.Equal(t, int64(700), k.GetDAOTokens(ctx).Int64())
+	assert.Empty(t, k.GetQueuedDAOTransfers(ctx))
+	assert.True(t, k.GetDAOSpending(ctx).Spent.Equal(sdk.NewInt(300)))
+}
+
+func TestDAOTreasury_TimelockOverSpendCap(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(105)
+	assert.Nil(t, k.AuthKeeper.MintCoins(ctx, types.DAOAccountName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(1000)))))
+	k.paramstore.Set(ctx, types.DAOSpendPeriodKey, int64(100))
+	k.paramstore.Set(ctx, types.DAOSpendCapKey, int64(300))
+	k.paramstore.Set(ctx, types.DAOTimelockThresholdKey, int64(100))
+	k.paramstore.Set(ctx, types.DAOTimelockPeriodKey, int64(5))
+	owner := k.GetDAOOwner(ctx)
+	to := getRandomValidatorAddress()
+	assert.True(t, k.DAOTransferFrom(ctx, owner, to, sdk.NewInt(200)).IsOK())
+	transfer, found := k.GetQueuedDAOTransfer(ctx, 1)
+	assert.True(t, found)
+	assert.Equal(t, int64(110), transfer.ExecutionHeight)
+	_, found = k.GetQueuedDAOTransfer(ctx, 2)
+	assert.False(t, found)
+	// the cap of the period is mostly spent before the timelock ends
+	assert.True(t, k.DAOTransferFrom(ctx, owner, to, sdk.NewInt(100)).IsOK())
+	assert.True(t, k.DAOBurn(ctx, owner, sdk.NewInt(100)).IsOK())
+	// the transfer stays queued until the next period
+	k.EndBlocker(ctx.WithBlockHeight(110))
+	assert.Equal(t, int64(800), k.GetDAOTokens(ctx).Int64())
+	transfer, found = k.GetQueuedDAOTransfer(ctx, 1)
+	assert.True(t, found)
+	assert.Equal(t, int64(200), transfer.ExecutionHeight)
+	assert.Equal(t, []types.QueuedDAOTransfer{transfer}, k.GetQueuedDAOTransfers(ctx))
+	k.EndBlocker(ctx.WithBlockHeight(199))
+	assert.Equal(t, int64(800), k.GetDAOTokens(ctx).Int64())
+	k.EndBlocker(ctx.WithBlockHeight(200))
+	assert.Equal(t, int64(600), k.GetDAOTokens(ctx).Int64())
+	assert.Empty(t, k.GetQueuedDAOTransfers(ctx))
+	_, found = k.GetQueuedDAOTransfer(ctx, 1)
+	assert.False(t, found)
+}
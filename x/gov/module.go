@@ -8,6 +8,7 @@ import (
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/types/module"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/pokt-network/pocket-core/x/gov/keeper"
 	"github.com/pokt-network/pocket-core/x/gov/types"
 	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
@@ -150,6 +151,13 @@ func (am AppModule) BeginBlock(ctx sdk.Ctx, req abci.RequestBeginBlock) {
 		ctx.Logger().Error("MUST UPGRADE TO NEXT VERSION: ", u.Version)
 		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventMustUpgrade, sdk.NewAttribute("VERSION:", u.UpgradeVersion())))
 
+		// the app skips the txs and the end blocker of the block, halts without committing it and exits with a non zero code
+		if am.keeper.HaltHandler != nil {
+			am.keeper.HaltHandler(fmt.Sprintf("this binary (version %s) does not support the upgrade to version %s scheduled at height %d; "+
+				"install version %s or later and restart the node", ctx.AppVersion(), u.Version, u.Height, u.Version))
+			return
+		}
+
 		ctx.Logger().Error(fmt.Sprintf("GRACEFULLY EXITING FOR UPGRADE, AT HEIGHT: %d", ctx.BlockHeight()))
 		p, err := os.FindProcess(os.Getpid())
 		if err != nil {
@@ -197,10 +205,39 @@ func (am AppModule) activateAdditionalParametersACL(ctx sdk.Ctx) {
 		)
 		am.keeper.SetParams(ctx, params)
 	}
+
+	// Activate proposal params
+	if am.keeper.GetCodec().IsOnNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovProposalKey) {
+		// GetParams returns the defaults for the proposal params until they are stored
+		params := am.keeper.GetParams(ctx)
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.ProposalVotingPeriodKey)), am.keeper.GetDAOOwner(ctx))
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.ProposalVoterSetKey)), am.keeper.GetDAOOwner(ctx))
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.ProposalQuorumKey)), am.keeper.GetDAOOwner(ctx))
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.ProposalThresholdKey)), am.keeper.GetDAOOwner(ctx))
+		am.keeper.SetParams(ctx, params)
+	}
+
+	// Activate dao treasury params
+	if am.keeper.GetCodec().IsOnNamedFeatureActivationHeight(ctx.BlockHeight(), codec.DAOTreasuryKey) {
+		params := am.keeper.GetParams(ctx)
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.DAOSpendPeriodKey)), am.keeper.GetDAOOwner(ctx))
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.DAOSpendCapKey)), am.keeper.GetDAOOwner(ctx))
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.DAOTimelockThresholdKey)), am.keeper.GetDAOOwner(ctx))
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.DAOTimelockPeriodKey)), am.keeper.GetDAOOwner(ctx))
+		am.keeper.SetParams(ctx, params)
+	}
+
+	// Activate the max messages param of multi message transactions
+	if am.keeper.GetCodec().IsOnNamedFeatureActivationHeight(ctx.BlockHeight(), codec.MultiMsgTxKey) {
+		params := am.keeper.GetParams(ctx)
+		params.ACL.SetOwner(types.NewACLKey(authTypes.ModuleName, string(authTypes.KeyMaxMsgsPerTx)), am.keeper.GetDAOOwner(ctx))
+		am.keeper.SetParams(ctx, params)
+	}
 }
 
 // EndBlock returns the end blocker for the staking module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Ctx, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.EndBlocker(ctx)
 	return []abci.ValidatorUpdate{}
 }
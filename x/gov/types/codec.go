@@ -20,10 +20,14 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgChangeParam{}, "gov/msg_change_param")
 	cdc.RegisterStructure(MsgDAOTransfer{}, "gov/msg_dao_transfer")
 	cdc.RegisterStructure(MsgUpgrade{}, "gov/msg_upgrade")
+	cdc.RegisterStructure(MsgSubmitProposal{}, "gov/msg_submit_proposal")
+	cdc.RegisterStructure(MsgVote{}, "gov/msg_vote")
+	cdc.RegisterStructure(MsgCancelDAOTransfer{}, "gov/msg_cancel_dao_transfer")
+	cdc.RegisterStructure(MsgSignalVersion{}, "gov/msg_signal_version")
 	cdc.RegisterInterface("x.interface.nil", (*interface{})(nil))
 	cdc.RegisterStructure(ACL{}, "gov/non_map_acl")
 	cdc.RegisterStructure(Upgrade{}, "gov/upgrade")
-	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{})
-	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{})
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{}, &MsgSubmitProposal{}, &MsgVote{}, &MsgCancelDAOTransfer{}, &MsgSignalVersion{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{}, &MsgSubmitProposal{}, &MsgVote{}, &MsgCancelDAOTransfer{}, &MsgSignalVersion{})
 	ModuleCdc = cdc
 }
@@ -34,3 +34,11 @@ func DAOActionFromString(s string) (DAOAction, sdk.Error) {
 		return 0, ErrUnrecognizedDAOAction(ModuleName, s)
 	}
 }
+
+// DAOSpendingStatus - The dao spending of the current period against its cap
+type DAOSpendingStatus struct {
+	PeriodStart int64      `json:"period_start"`
+	PeriodEnd   int64      `json:"period_end"` // zero when the spending period is disabled
+	Spent       sdk.BigInt `json:"spent"`
+	Cap         sdk.BigInt `json:"cap"` // zero when there is no cap
+}
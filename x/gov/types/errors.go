@@ -18,6 +18,15 @@ const (
 	CodeZeroHeightUpgrade             sdk.CodeType = 9
 	CodeEmptyVersionUpgrade           sdk.CodeType = 10
 	CodeUnauthorizedHeightParamChange sdk.CodeType = 11
+	CodeInvalidProposal               sdk.CodeType = 12
+	CodeProposalNotFound              sdk.CodeType = 13
+	CodeProposalNotActive             sdk.CodeType = 14
+	CodeUnauthorizedVoter             sdk.CodeType = 15
+	CodeInvalidActivationHeight       sdk.CodeType = 16
+	CodeDAOSpendCapExceeded           sdk.CodeType = 17
+	CodeDAOTransferNotFound           sdk.CodeType = 18
+	CodeInvalidVersion                sdk.CodeType = 19
+	CodeNotStakedValidator            sdk.CodeType = 20
 )
 
 func ErrZeroHeightUpgrade(codespace sdk.CodespaceType) sdk.Error {
@@ -50,6 +59,27 @@ func ErrUnauthorizedHeightParamChange(codespace sdk.CodespaceType, height int64,
 		fmt.Sprintf("the param change is unathorized: Wait For Upgrade Height %v to change param %s", height, param))
 }
 
+func ErrInvalidProposal(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposal, "invalid proposal: "+reason)
+}
+
+func ErrProposalNotFound(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalNotFound, fmt.Sprintf("the proposal %d cannot be found", id))
+}
+
+func ErrProposalNotActive(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalNotActive, fmt.Sprintf("the proposal %d is not open for votes", id))
+}
+
+func ErrUnauthorizedVoter(codespace sdk.CodespaceType, voter sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedVoter, fmt.Sprintf("the account %s is not in the voter set of proposals", voter))
+}
+
+func ErrInvalidActivationHeight(codespace sdk.CodespaceType, activationHeight, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidActivationHeight,
+		fmt.Sprintf("the activation height %d of the param change must be after the current height %d", activationHeight, height))
+}
+
 // ErrUnknownSubspace returns an unknown subspace error.
 func ErrUnknownSubspace(codespace sdk.CodespaceType, space string) sdk.Error {
 	return sdk.NewError(codespace, CodeUnknownSubspace, fmt.Sprintf("unknown subspace %s", space))
@@ -79,3 +109,20 @@ func ErrEmptyKey(codespace sdk.CodespaceType) sdk.Error {
 func ErrEmptyValue(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEmptyData, "parameter value is empty")
 }
+
+func ErrDAOSpendCapExceeded(codespace sdk.CodespaceType, amount, remaining sdk.BigInt) sdk.Error {
+	return sdk.NewError(codespace, CodeDAOSpendCapExceeded,
+		fmt.Sprintf("the dao action of %s exceeds the %s left to spend in this period", amount, remaining))
+}
+
+func ErrDAOTransferNotFound(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeDAOTransferNotFound, fmt.Sprintf("the queued dao action %d cannot be found", id))
+}
+
+func ErrInvalidVersion(codespace sdk.CodespaceType, version string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVersion, fmt.Sprintf("the version %q is not a dot-delimited version number", version))
+}
+
+func ErrNotStakedValidator(codespace sdk.CodespaceType, addr sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeNotStakedValidator, fmt.Sprintf("the account %s is not a staked validator", addr))
+}
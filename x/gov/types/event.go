@@ -7,5 +7,19 @@ const (
 	EventParamChange       = "param_change"
 	EventUpgrade           = "upgrade"
 	EventMustUpgrade       = "must_upgrade"
+	EventSubmitProposal    = "submit_proposal"
+	EventVote              = "proposal_vote"
+	EventProposalTally     = "proposal_tally"
+	AttributeProposalID    = "proposal_id"
+	AttributeStatus        = "status"
+	EventScheduleParam     = "schedule_param_change"
+	EventActivateParam     = "activate_param_change"
+	AttributeActivation    = "activation_height"
+	EventDAOQueue          = "dao_queue"
+	EventDAOCancel         = "dao_cancel"
+	AttributeTransferID    = "transfer_id"
+	AttributeExecution     = "execution_height"
+	EventSignalVersion     = "signal_version"
+	AttributeVersion       = "version"
 	AttributeValueCategory = ModuleName
 )
@@ -28,21 +28,12 @@ type AuthKeeper interface {
 	BurnCoins(ctx sdk.Ctx, name string, amt sdk.Coins) sdk.Error
 }
 
+// PosKeeper defines the expected validator Keeper used for stake weighted proposal votes (noalias)
 type PosKeeper interface {
-	RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address)
-	RewardForRelaysPerChain(
-		ctx sdk.Ctx,
-		chain string,
-		relays sdk.BigInt,
-		address sdk.Address,
-	)
+	// get the total staked tokens
 	GetStakedTokens(ctx sdk.Ctx) sdk.BigInt
+	// get a validator by address
 	Validator(ctx sdk.Ctx, addr sdk.Address) nodesExported.ValidatorI
-	TotalTokens(ctx sdk.Ctx) sdk.BigInt
-	BurnForChallenge(ctx sdk.Ctx, challenges sdk.BigInt, address sdk.Address)
-	JailValidator(ctx sdk.Ctx, addr sdk.Address)
-	AllValidators(ctx sdk.Ctx) (validators []nodesExported.ValidatorI)
-	GetStakedValidators(ctx sdk.Ctx) (validators []nodesExported.ValidatorI)
-	SessionBlockFrequency(ctx sdk.Ctx) (res int64)
-	StakeDenom(ctx sdk.Ctx) (res string)
+	// iterate through the staked validator set and perform the provided function
+	IterateAndExecuteOverStakedVals(ctx sdk.Ctx, fn func(index int64, validator nodesExported.ValidatorI) (stop bool))
 }
@@ -4,6 +4,10 @@ const (
 	DAOTransferFee    = 10000
 	MsgChangeParamFee = 10000
 	MsgUpgradeFee     = 10000
+	MsgProposalFee    = 10000
+	MsgVoteFee        = 10000
+	DAOCancelFee      = 10000
+	SignalVersionFee  = 10000
 )
 
 var (
@@ -11,5 +15,9 @@ var (
 		MsgDAOTransferName: DAOTransferFee,
 		MsgChangeParamName: MsgChangeParamFee,
 		MsgUpgradeName:     MsgUpgradeFee,
+		MsgProposalName:    MsgProposalFee,
+		MsgVoteName:        MsgVoteFee,
+		MsgDAOCancelName:   DAOCancelFee,
+		MsgSignalName:      SignalVersionFee,
 	}
 )
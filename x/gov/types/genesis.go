@@ -9,6 +9,19 @@ import (
 type GenesisState struct {
 	Params    Params     `json:"params" yaml:"params"`
 	DAOTokens sdk.BigInt `json:"DAO_Tokens"`
+	Proposals []Proposal `json:"proposals,omitempty" yaml:"proposals"`
+	Votes     []Vote     `json:"votes,omitempty" yaml:"votes"`
+	// voter sets of the active proposals, snapshotted at their submission
+	VoterPowers []VoterPower `json:"voter_powers,omitempty" yaml:"voter_powers"`
+	// param changes scheduled for a later height
+	PendingParamChanges []PendingParamChange `json:"pending_param_changes,omitempty" yaml:"pending_param_changes"`
+	// record of the applied param changes
+	ParamHistory []ParamChangeRecord `json:"param_history,omitempty" yaml:"param_history"`
+	// dao actions waiting for their timelock and the spending of the current period
+	DAOTransferQueue []QueuedDAOTransfer `json:"dao_transfer_queue,omitempty" yaml:"dao_transfer_queue"`
+	DAOSpending      *DAOSpending        `json:"dao_spending,omitempty" yaml:"dao_spending"`
+	// versions signalled by the validators
+	VersionSignals []VersionSignal `json:"version_signals,omitempty" yaml:"version_signals"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -30,5 +43,25 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.ACL == nil {
 		return ErrInvalidACL(ModuleName, fmt.Errorf("nil acl"))
 	}
+	ids := make(map[uint64]struct{}, len(data.Proposals))
+	for _, proposal := range data.Proposals {
+		if proposal.ID == 0 {
+			return ErrInvalidProposal(ModuleName, "zero proposal id in genesis")
+		}
+		if _, found := ids[proposal.ID]; found {
+			return ErrInvalidProposal(ModuleName, fmt.Sprintf("duplicate proposal %d in genesis", proposal.ID))
+		}
+		ids[proposal.ID] = struct{}{}
+	}
+	for _, vote := range data.Votes {
+		if _, found := ids[vote.ProposalID]; !found {
+			return ErrProposalNotFound(ModuleName, vote.ProposalID)
+		}
+	}
+	for _, power := range data.VoterPowers {
+		if _, found := ids[power.ProposalID]; !found {
+			return ErrProposalNotFound(ModuleName, power.ProposalID)
+		}
+	}
 	return nil
 }
@@ -25,9 +25,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgChangeParam struct {
-	FromAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
-	ParamKey    string                                            `protobuf:"bytes,2,opt,name=paramKey,proto3" json:"param_key"`
-	ParamVal    []byte                                            `protobuf:"bytes,3,opt,name=paramVal,proto3" json:"param_value"`
+	FromAddress      github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	ParamKey         string                                            `protobuf:"bytes,2,opt,name=paramKey,proto3" json:"param_key"`
+	ParamVal         []byte                                            `protobuf:"bytes,3,opt,name=paramVal,proto3" json:"param_value"`
+	ActivationHeight int64                                             `protobuf:"varint,4,opt,name=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
 func (m *MsgChangeParam) Reset()         { *m = MsgChangeParam{} }
@@ -84,6 +85,13 @@ func (m *MsgChangeParam) GetParamVal() []byte {
 	return nil
 }
 
+func (m *MsgChangeParam) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 func (*MsgChangeParam) XXX_MessageName() string {
 	return "x.gov.MsgChangeParam"
 }
@@ -329,399 +337,4006 @@ func (m *ACLPair) GetAddr() github_com_pokt_network_pocket_core_types.Address {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgChangeParam)(nil), "x.gov.MsgChangeParam")
-	proto.RegisterType((*MsgDAOTransfer)(nil), "x.gov.MsgDAOTransfer")
-	proto.RegisterType((*MsgUpgrade)(nil), "x.gov.MsgUpgrade")
-	proto.RegisterType((*Upgrade)(nil), "x.gov.Upgrade")
-	proto.RegisterType((*ACLPair)(nil), "x.gov.ACLPair")
+type ParamChange struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"param_key"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"param_value"`
 }
 
-func init() { proto.RegisterFile("x/gov/gov.proto", fileDescriptor_8366cfab811ef854) }
+func (m *ParamChange) Reset()         { *m = ParamChange{} }
+func (m *ParamChange) String() string { return proto.CompactTextString(m) }
+func (*ParamChange) ProtoMessage()    {}
+func (*ParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{5}
+}
+func (m *ParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChange.Merge(m, src)
+}
+func (m *ParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChange.DiscardUnknown(m)
+}
 
-var fileDescriptor_8366cfab811ef854 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6f, 0xd3, 0x3e,
-	0x14, 0x6e, 0xda, 0xfe, 0xda, 0xd5, 0xdd, 0x6f, 0x45, 0x16, 0x42, 0xd5, 0x24, 0xe2, 0xaa, 0x12,
-	0x52, 0x11, 0x2c, 0x81, 0x71, 0x82, 0x13, 0x4d, 0x01, 0x31, 0x60, 0xda, 0x14, 0x8d, 0x1d, 0x7a,
-	0x99, 0xdc, 0xd6, 0x75, 0xa3, 0x36, 0x71, 0xe4, 0xb8, 0x65, 0xb9, 0x70, 0xe6, 0xc8, 0xdf, 0xc0,
-	0x8d, 0xff, 0x64, 0xc7, 0x09, 0x71, 0x40, 0x1c, 0x2c, 0xd4, 0xde, 0xf2, 0x27, 0x70, 0x42, 0x71,
-	0x9c, 0x32, 0x01, 0x87, 0x49, 0xdb, 0x21, 0xb2, 0xf5, 0xbd, 0xe7, 0xef, 0x7b, 0xef, 0xf3, 0x8b,
-	0x41, 0xe3, 0xd4, 0xa6, 0x6c, 0x91, 0x7e, 0x56, 0xc8, 0x99, 0x60, 0xf0, 0xbf, 0x53, 0x8b, 0xb2,
-	0xc5, 0xf6, 0x4d, 0xca, 0x28, 0x53, 0x88, 0x9d, 0xee, 0xb2, 0x60, 0xfb, 0x8b, 0x01, 0xb6, 0xf6,
-	0x23, 0xda, 0x9b, 0xe0, 0x80, 0x92, 0x43, 0xcc, 0xb1, 0x0f, 0x07, 0xa0, 0x3e, 0xe6, 0xcc, 0xef,
-	0x8e, 0x46, 0x9c, 0x44, 0x51, 0xd3, 0x68, 0x19, 0x9d, 0x4d, 0xe7, 0x69, 0x22, 0x51, 0x15, 0x67,
-	0xd0, 0x4f, 0x89, 0x1e, 0x52, 0x4f, 0x4c, 0xe6, 0x03, 0x6b, 0xc8, 0x7c, 0x3b, 0x64, 0x53, 0xb1,
-	0x13, 0x10, 0xf1, 0x8e, 0xf1, 0xa9, 0x1d, 0xb2, 0xe1, 0x94, 0x88, 0x9d, 0x21, 0xe3, 0xc4, 0x16,
-	0x71, 0x48, 0x22, 0x4b, 0xf3, 0xb8, 0x17, 0x49, 0xe1, 0x5d, 0xb0, 0x11, 0xa6, 0x62, 0xaf, 0x49,
-	0xdc, 0x2c, 0xb6, 0x8c, 0x4e, 0xcd, 0xf9, 0x3f, 0x91, 0xa8, 0xa6, 0xb0, 0x93, 0x29, 0x89, 0xdd,
-	0x75, 0x18, 0xde, 0xd3, 0xa9, 0xc7, 0x78, 0xd6, 0x2c, 0xa9, 0x5a, 0x1a, 0x89, 0x44, 0xf5, 0x2c,
-	0x75, 0x81, 0x67, 0x73, 0xe2, 0xae, 0x13, 0x9e, 0x94, 0x3f, 0x7c, 0x42, 0x46, 0x7b, 0x59, 0x54,
-	0x4d, 0x3d, 0xeb, 0x1e, 0x1c, 0x71, 0x1c, 0x44, 0x63, 0xc2, 0x21, 0xfd, 0x57, 0x53, 0xcf, 0x13,
-	0x89, 0x36, 0x53, 0xf8, 0xe4, 0xfa, 0x3a, 0xc3, 0xa0, 0x26, 0x58, 0x2e, 0x53, 0x54, 0x32, 0xbd,
-	0x44, 0x22, 0x20, 0xd8, 0xd5, 0x44, 0x7e, 0xb3, 0xc2, 0x3e, 0xa8, 0x60, 0x9f, 0xcd, 0x03, 0xa1,
-	0xfc, 0xa8, 0x39, 0xce, 0x99, 0x44, 0x85, 0xef, 0x12, 0x3d, 0xb8, 0x3c, 0xab, 0xe3, 0xd1, 0xbd,
-	0x40, 0x24, 0x12, 0x69, 0x26, 0x57, 0xaf, 0xb0, 0x0d, 0x2a, 0x78, 0x28, 0x3c, 0x16, 0x34, 0xcb,
-	0x8a, 0x1b, 0xa8, 0x1c, 0x85, 0xb8, 0x7a, 0xd5, 0x26, 0x7f, 0x36, 0x00, 0xd8, 0x8f, 0xe8, 0xdb,
-	0x90, 0x72, 0x3c, 0x22, 0xb0, 0x0f, 0xf2, 0xf1, 0xb8, 0xb6, 0x89, 0xc9, 0x4f, 0xc3, 0xc7, 0xa0,
-	0x3a, 0xcf, 0x64, 0x94, 0xa3, 0xf5, 0xdd, 0x2d, 0x4b, 0xcd, 0xb4, 0xa5, 0xc5, 0x9d, 0x46, 0xea,
-	0x40, 0xaa, 0xa7, 0xd3, 0xdc, 0x7c, 0xa3, 0x6b, 0xfd, 0x6a, 0x80, 0x6a, 0x5e, 0x68, 0x1b, 0x54,
-	0x26, 0xc4, 0xa3, 0x13, 0xa1, 0xea, 0x2c, 0x65, 0x1d, 0xbe, 0x54, 0x88, 0xab, 0x23, 0xf0, 0x0e,
-	0xa8, 0x2e, 0x08, 0x8f, 0x52, 0x1b, 0xb2, 0xe9, 0xac, 0xa7, 0xe4, 0xc7, 0x19, 0xe4, 0xe6, 0x31,
-	0xf8, 0x0a, 0xdc, 0x60, 0xb3, 0x91, 0x26, 0xce, 0x28, 0xd4, 0x95, 0x94, 0x1c, 0x33, 0x91, 0x68,
-	0xfb, 0xe0, 0x8f, 0xd8, 0x7d, 0xe6, 0x7b, 0x82, 0xf8, 0xa1, 0x88, 0xdd, 0xbf, 0xce, 0xc1, 0x5d,
-	0xb0, 0x31, 0x26, 0x58, 0xcc, 0x39, 0x89, 0x9a, 0xe5, 0x56, 0xa9, 0x53, 0x73, 0x6e, 0x25, 0x12,
-	0xc1, 0x17, 0x1a, 0xbb, 0x70, 0x76, 0x9d, 0xd7, 0x7e, 0x0f, 0xaa, 0xdd, 0xde, 0x9b, 0x43, 0xec,
-	0x71, 0x78, 0x1b, 0x94, 0xa6, 0x24, 0x56, 0x2d, 0xe9, 0x6a, 0xf1, 0x70, 0xa6, 0xfe, 0xa4, 0x14,
-	0x87, 0x47, 0xa0, 0x9c, 0x9a, 0xa9, 0x07, 0xf2, 0xea, 0x57, 0xa3, 0xd8, 0x9c, 0xbd, 0xb3, 0xa5,
-	0x69, 0x9c, 0x2f, 0x4d, 0xe3, 0xc7, 0xd2, 0x34, 0x3e, 0xae, 0xcc, 0xc2, 0xf9, 0xca, 0x2c, 0x7c,
-	0x5b, 0x99, 0x85, 0xbe, 0x7d, 0x19, 0xca, 0xec, 0xa1, 0x52, 0xc4, 0x83, 0x8a, 0x7a, 0x8e, 0x1e,
-	0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x82, 0xb5, 0x6d, 0xea, 0xbe, 0x04, 0x00, 0x00,
+var xxx_messageInfo_ParamChange proto.InternalMessageInfo
+
+func (m *ParamChange) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *MsgChangeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ParamChange) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgChangeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitProposal struct {
+	Proposer github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"proposer"`
+	Changes  []ParamChange                                     `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	Upgrade  *Upgrade                                          `protobuf:"bytes,3,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
 }
 
-func (m *MsgChangeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ParamVal) > 0 {
-		i -= len(m.ParamVal)
-		copy(dAtA[i:], m.ParamVal)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamVal)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
+func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProposal) ProtoMessage()    {}
+func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{6}
+}
+func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ParamKey) > 0 {
-		i -= len(m.ParamKey)
-		copy(dAtA[i:], m.ParamKey)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgSubmitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProposal.Merge(m, src)
+}
+func (m *MsgSubmitProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitProposal) GetProposer() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Proposer
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgSubmitProposal) GetChanges() []ParamChange {
+	if m != nil {
+		return m.Changes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgDAOTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProposal) GetUpgrade() *Upgrade {
+	if m != nil {
+		return m.Upgrade
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDAOTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*MsgSubmitProposal) XXX_MessageName() string {
+	return "x.gov.MsgSubmitProposal"
 }
 
-func (m *MsgDAOTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Action) > 0 {
-		i -= len(m.Action)
-		copy(dAtA[i:], m.Action)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Action)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type MsgVote struct {
+	Voter      github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=voter,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"voter"`
+	ProposalID uint64                                            `protobuf:"varint,2,opt,name=proposalID,proto3" json:"proposal_id"`
+	Approve    bool                                              `protobuf:"varint,3,opt,name=approve,proto3" json:"approve"`
+}
+
+func (m *MsgVote) Reset()         { *m = MsgVote{} }
+func (m *MsgVote) String() string { return proto.CompactTextString(m) }
+func (*MsgVote) ProtoMessage()    {}
+func (*MsgVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{7}
+}
+func (m *MsgVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGov(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVote.Merge(m, src)
+}
+func (m *MsgVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVote proto.InternalMessageInfo
+
+func (m *MsgVote) GetVoter() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Voter
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgVote) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVote) GetApprove() bool {
+	if m != nil {
+		return m.Approve
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*MsgVote) XXX_MessageName() string {
+	return "x.gov.MsgVote"
 }
 
-func (m *MsgUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+type Proposal struct {
+	ID              uint64                                            `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Proposer        github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=proposer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"proposer"`
+	Changes         []ParamChange                                     `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	Upgrade         *Upgrade                                          `protobuf:"bytes,4,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	SubmitHeight    int64                                             `protobuf:"varint,5,opt,name=submitHeight,proto3" json:"submit_height"`
+	VotingEndHeight int64                                             `protobuf:"varint,6,opt,name=votingEndHeight,proto3" json:"voting_end_height"`
+	Status          string                                            `protobuf:"bytes,7,opt,name=status,proto3" json:"status"`
+	YesPower        github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,8,opt,name=yesPower,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"yes_power"`
+	NoPower         github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,9,opt,name=noPower,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"no_power"`
+	TotalPower      github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,10,opt,name=totalPower,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"total_power"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{8}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGov(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Upgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Proposal) GetProposer() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Proposer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Proposal) GetChanges() []ParamChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
 
-func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Features) > 0 {
-		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Features[iNdEx])
-			copy(dAtA[i:], m.Features[iNdEx])
-			i = encodeVarintGov(dAtA, i, uint64(len(m.Features[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func (m *Proposal) GetUpgrade() *Upgrade {
+	if m != nil {
+		return m.Upgrade
 	}
-	if m.OldUpgradeHeight != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.OldUpgradeHeight))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *Proposal) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Proposal) GetVotingEndHeight() int64 {
+	if m != nil {
+		return m.VotingEndHeight
 	}
-	if m.Height != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Proposal) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ACLPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type Vote struct {
+	ProposalID uint64                                            `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposal_id"`
+	Voter      github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"voter"`
+	Approve    bool                                              `protobuf:"varint,3,opt,name=approve,proto3" json:"approve"`
+	Height     int64                                             `protobuf:"varint,4,opt,name=height,proto3" json:"height"`
+}
+
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{9}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
 }
 
-func (m *ACLPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (m *Vote) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
 }
 
-func (m *ACLPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x12
+func (m *Vote) GetVoter() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Voter
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Vote) GetApprove() bool {
+	if m != nil {
+		return m.Approve
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Vote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *MsgChangeParam) Size() (n int) {
-	if m == nil {
-		return 0
+
+type PendingParamChange struct {
+	ParamKey         string                                            `protobuf:"bytes,1,opt,name=paramKey,proto3" json:"param_key"`
+	ParamVal         []byte                                            `protobuf:"bytes,2,opt,name=paramVal,proto3" json:"param_value"`
+	ActivationHeight int64                                             `protobuf:"varint,3,opt,name=activationHeight,proto3" json:"activation_height"`
+	Owner            github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,4,opt,name=owner,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	ScheduledHeight  int64                                             `protobuf:"varint,5,opt,name=scheduledHeight,proto3" json:"scheduled_height"`
+}
+
+func (m *PendingParamChange) Reset()         { *m = PendingParamChange{} }
+func (m *PendingParamChange) String() string { return proto.CompactTextString(m) }
+func (*PendingParamChange) ProtoMessage()    {}
+func (*PendingParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{10}
+}
+func (m *PendingParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *PendingParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingParamChange.Merge(m, src)
+}
+func (m *PendingParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingParamChange proto.InternalMessageInfo
+
+func (m *PendingParamChange) GetParamKey() string {
+	if m != nil {
+		return m.ParamKey
 	}
-	l = len(m.ParamKey)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *PendingParamChange) GetParamVal() []byte {
+	if m != nil {
+		return m.ParamVal
 	}
-	l = len(m.ParamVal)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *PendingParamChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
 	}
-	return n
+	return 0
 }
 
-func (m *MsgDAOTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingParamChange) GetOwner() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *PendingParamChange) GetScheduledHeight() int64 {
+	if m != nil {
+		return m.ScheduledHeight
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return 0
+}
+
+type ParamChangeRecord struct {
+	ParamKey string                                            `protobuf:"bytes,1,opt,name=paramKey,proto3" json:"param_key"`
+	OldValue []byte                                            `protobuf:"bytes,2,opt,name=oldValue,proto3" json:"old_value"`
+	NewValue []byte                                            `protobuf:"bytes,3,opt,name=newValue,proto3" json:"new_value"`
+	Height   int64                                             `protobuf:"varint,4,opt,name=height,proto3" json:"height"`
+	Signer   github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,5,opt,name=signer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"signer"`
+}
+
+func (m *ParamChangeRecord) Reset()         { *m = ParamChangeRecord{} }
+func (m *ParamChangeRecord) String() string { return proto.CompactTextString(m) }
+func (*ParamChangeRecord) ProtoMessage()    {}
+func (*ParamChangeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{11}
+}
+func (m *ParamChangeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *ParamChangeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeRecord.Merge(m, src)
+}
+func (m *ParamChangeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeRecord proto.InternalMessageInfo
+
+func (m *ParamChangeRecord) GetParamKey() string {
+	if m != nil {
+		return m.ParamKey
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamChangeRecord) GetOldValue() []byte {
+	if m != nil {
+		return m.OldValue
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *ParamChangeRecord) GetNewValue() []byte {
+	if m != nil {
+		return m.NewValue
 	}
-	l = m.Upgrade.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
+	return nil
 }
 
-func (m *Upgrade) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamChangeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovGov(uint64(m.Height))
+	return 0
+}
+
+func (m *ParamChangeRecord) GetSigner() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Signer
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+type MsgCancelDAOTransfer struct {
+	FromAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"from_address"`
+	TransferID  uint64                                            `protobuf:"varint,2,opt,name=transferID,proto3" json:"transfer_id"`
+}
+
+func (m *MsgCancelDAOTransfer) Reset()         { *m = MsgCancelDAOTransfer{} }
+func (m *MsgCancelDAOTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDAOTransfer) ProtoMessage()    {}
+func (*MsgCancelDAOTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{12}
+}
+func (m *MsgCancelDAOTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDAOTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDAOTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.OldUpgradeHeight != 0 {
-		n += 1 + sovGov(uint64(m.OldUpgradeHeight))
+}
+func (m *MsgCancelDAOTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDAOTransfer.Merge(m, src)
+}
+func (m *MsgCancelDAOTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDAOTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDAOTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDAOTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelDAOTransfer) GetFromAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.FromAddress
 	}
-	if len(m.Features) > 0 {
-		for _, s := range m.Features {
-			l = len(s)
-			n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *MsgCancelDAOTransfer) GetTransferID() uint64 {
+	if m != nil {
+		return m.TransferID
+	}
+	return 0
+}
+
+func (*MsgCancelDAOTransfer) XXX_MessageName() string {
+	return "x.gov.MsgCancelDAOTransfer"
+}
+
+type QueuedDAOTransfer struct {
+	ID              uint64                                            `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	FromAddress     github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=fromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"from_address"`
+	ToAddress       github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,3,opt,name=toAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"to_address"`
+	Amount          github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount"`
+	Action          string                                            `protobuf:"bytes,5,opt,name=action,proto3" json:"action"`
+	QueuedHeight    int64                                             `protobuf:"varint,6,opt,name=queuedHeight,proto3" json:"queued_height"`
+	ExecutionHeight int64                                             `protobuf:"varint,7,opt,name=executionHeight,proto3" json:"execution_height"`
+}
+
+func (m *QueuedDAOTransfer) Reset()         { *m = QueuedDAOTransfer{} }
+func (m *QueuedDAOTransfer) String() string { return proto.CompactTextString(m) }
+func (*QueuedDAOTransfer) ProtoMessage()    {}
+func (*QueuedDAOTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{13}
+}
+func (m *QueuedDAOTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedDAOTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedDAOTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedDAOTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedDAOTransfer.Merge(m, src)
+}
+func (m *QueuedDAOTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedDAOTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedDAOTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedDAOTransfer proto.InternalMessageInfo
+
+func (m *QueuedDAOTransfer) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *QueuedDAOTransfer) GetFromAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
+}
+
+func (m *QueuedDAOTransfer) GetToAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.ToAddress
+	}
+	return nil
+}
+
+func (m *QueuedDAOTransfer) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *QueuedDAOTransfer) GetQueuedHeight() int64 {
+	if m != nil {
+		return m.QueuedHeight
+	}
+	return 0
+}
+
+func (m *QueuedDAOTransfer) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
+type DAOSpending struct {
+	PeriodStart int64                                            `protobuf:"varint,1,opt,name=periodStart,proto3" json:"period_start"`
+	Spent       github_com_pokt_network_pocket_core_types.BigInt `protobuf:"bytes,2,opt,name=spent,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"spent"`
+}
+
+func (m *DAOSpending) Reset()         { *m = DAOSpending{} }
+func (m *DAOSpending) String() string { return proto.CompactTextString(m) }
+func (*DAOSpending) ProtoMessage()    {}
+func (*DAOSpending) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{14}
+}
+func (m *DAOSpending) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DAOSpending) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DAOSpending.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DAOSpending) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DAOSpending.Merge(m, src)
+}
+func (m *DAOSpending) XXX_Size() int {
+	return m.Size()
+}
+func (m *DAOSpending) XXX_DiscardUnknown() {
+	xxx_messageInfo_DAOSpending.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DAOSpending proto.InternalMessageInfo
+
+func (m *DAOSpending) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+type MsgSignalVersion struct {
+	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	Version string                                            `protobuf:"bytes,2,opt,name=version,proto3" json:"version"`
+}
+
+func (m *MsgSignalVersion) Reset()         { *m = MsgSignalVersion{} }
+func (m *MsgSignalVersion) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalVersion) ProtoMessage()    {}
+func (*MsgSignalVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{15}
+}
+func (m *MsgSignalVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalVersion.Merge(m, src)
+}
+func (m *MsgSignalVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalVersion proto.InternalMessageInfo
+
+func (m *MsgSignalVersion) GetAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *MsgSignalVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (*MsgSignalVersion) XXX_MessageName() string {
+	return "x.gov.MsgSignalVersion"
+}
+
+type VersionSignal struct {
+	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	Version string                                            `protobuf:"bytes,2,opt,name=version,proto3" json:"version"`
+	Height  int64                                             `protobuf:"varint,3,opt,name=height,proto3" json:"height"`
+}
+
+func (m *VersionSignal) Reset()         { *m = VersionSignal{} }
+func (m *VersionSignal) String() string { return proto.CompactTextString(m) }
+func (*VersionSignal) ProtoMessage()    {}
+func (*VersionSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8366cfab811ef854, []int{16}
+}
+func (m *VersionSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionSignal.Merge(m, src)
+}
+func (m *VersionSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionSignal proto.InternalMessageInfo
+
+func (m *VersionSignal) GetAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *VersionSignal) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *VersionSignal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgChangeParam)(nil), "x.gov.MsgChangeParam")
+	proto.RegisterType((*MsgDAOTransfer)(nil), "x.gov.MsgDAOTransfer")
+	proto.RegisterType((*MsgUpgrade)(nil), "x.gov.MsgUpgrade")
+	proto.RegisterType((*Upgrade)(nil), "x.gov.Upgrade")
+	proto.RegisterType((*ACLPair)(nil), "x.gov.ACLPair")
+	proto.RegisterType((*ParamChange)(nil), "x.gov.ParamChange")
+	proto.RegisterType((*MsgSubmitProposal)(nil), "x.gov.MsgSubmitProposal")
+	proto.RegisterType((*MsgVote)(nil), "x.gov.MsgVote")
+	proto.RegisterType((*Proposal)(nil), "x.gov.Proposal")
+	proto.RegisterType((*Vote)(nil), "x.gov.Vote")
+	proto.RegisterType((*PendingParamChange)(nil), "x.gov.PendingParamChange")
+	proto.RegisterType((*ParamChangeRecord)(nil), "x.gov.ParamChangeRecord")
+	proto.RegisterType((*MsgCancelDAOTransfer)(nil), "x.gov.MsgCancelDAOTransfer")
+	proto.RegisterType((*QueuedDAOTransfer)(nil), "x.gov.QueuedDAOTransfer")
+	proto.RegisterType((*DAOSpending)(nil), "x.gov.DAOSpending")
+	proto.RegisterType((*MsgSignalVersion)(nil), "x.gov.MsgSignalVersion")
+	proto.RegisterType((*VersionSignal)(nil), "x.gov.VersionSignal")
+}
+
+func init() { proto.RegisterFile("x/gov/gov.proto", fileDescriptor_8366cfab811ef854) }
+
+var fileDescriptor_8366cfab811ef854 = []byte{
+	// 1324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xee, 0xda, 0xb1, 0xfd, 0x9c, 0x36, 0xc9, 0xa8, 0xdf, 0xaf, 0x4c, 0x01, 0x6f, 0xb4,
+	0x52, 0xa5, 0x54, 0xd0, 0x18, 0x82, 0x38, 0xc0, 0xa1, 0xc5, 0xdb, 0x94, 0x12, 0x4a, 0xd4, 0xb0,
+	0x6d, 0x53, 0xa9, 0x12, 0x58, 0x13, 0xef, 0x74, 0xb3, 0x8a, 0xbd, 0xb3, 0xec, 0x8e, 0x9d, 0xfa,
+	0xc2, 0x81, 0x13, 0x47, 0xb8, 0xc2, 0x01, 0x89, 0x1b, 0xff, 0x40, 0x6f, 0x9c, 0xe9, 0xb1, 0x07,
+	0x0e, 0x85, 0xc3, 0x0a, 0xa5, 0xb7, 0x3d, 0x73, 0xe2, 0x84, 0xe6, 0xc7, 0xae, 0xd7, 0x6e, 0x4a,
+	0x43, 0x7e, 0x00, 0x87, 0xc8, 0xab, 0xf7, 0x3e, 0xf3, 0xde, 0x9b, 0xf7, 0x73, 0x5e, 0x60, 0xfe,
+	0x41, 0xcb, 0xa3, 0x43, 0xfe, 0xb7, 0x12, 0x46, 0x94, 0x51, 0x54, 0x7e, 0xb0, 0xe2, 0xd1, 0xe1,
+	0xf9, 0x73, 0x1e, 0xf5, 0xa8, 0xa0, 0xb4, 0xf8, 0x97, 0x64, 0x5a, 0xdf, 0xe9, 0x70, 0x76, 0x23,
+	0xf6, 0xae, 0xee, 0xe0, 0xc0, 0x23, 0x9b, 0x38, 0xc2, 0x7d, 0xb4, 0x0d, 0xf5, 0xfb, 0x11, 0xed,
+	0xb7, 0x5d, 0x37, 0x22, 0x71, 0xdc, 0xd0, 0x96, 0xb4, 0xe5, 0x39, 0xfb, 0xbd, 0x34, 0x31, 0x2b,
+	0x58, 0x92, 0xfe, 0x48, 0xcc, 0x37, 0x3d, 0x9f, 0xed, 0x0c, 0xb6, 0x57, 0xba, 0xb4, 0xdf, 0x0a,
+	0xe9, 0x2e, 0xbb, 0x14, 0x10, 0xb6, 0x47, 0xa3, 0xdd, 0x56, 0x48, 0xbb, 0xbb, 0x84, 0x5d, 0xea,
+	0xd2, 0x88, 0xb4, 0xd8, 0x28, 0x24, 0xf1, 0x8a, 0x92, 0xe3, 0x14, 0x85, 0xa2, 0x8b, 0x50, 0x0d,
+	0xb9, 0xb2, 0x1b, 0x64, 0xd4, 0xd0, 0x97, 0xb4, 0xe5, 0x9a, 0x7d, 0x26, 0x4d, 0xcc, 0x9a, 0xa0,
+	0x75, 0x76, 0xc9, 0xc8, 0xc9, 0xd9, 0xe8, 0x35, 0x05, 0xdd, 0xc2, 0xbd, 0x86, 0x21, 0x6c, 0x99,
+	0x4f, 0x13, 0xb3, 0x2e, 0xa1, 0x43, 0xdc, 0x1b, 0x10, 0x27, 0x07, 0xa0, 0x1b, 0xb0, 0x80, 0xbb,
+	0xcc, 0x1f, 0x62, 0xe6, 0xd3, 0xe0, 0x03, 0xe2, 0x7b, 0x3b, 0xac, 0x51, 0x5a, 0xd2, 0x96, 0x0d,
+	0xdb, 0x4c, 0x13, 0xf3, 0xe5, 0x31, 0xaf, 0xb3, 0x23, 0x98, 0xaf, 0xd3, 0xbe, 0xcf, 0x48, 0x3f,
+	0x64, 0x23, 0xe7, 0x99, 0x83, 0xef, 0x96, 0xbe, 0xfc, 0xde, 0xd4, 0xac, 0x7d, 0xe9, 0xa1, 0xb5,
+	0xf6, 0xcd, 0xdb, 0x11, 0x0e, 0xe2, 0xfb, 0x24, 0x42, 0xde, 0x41, 0x1e, 0xba, 0x96, 0x26, 0xe6,
+	0x1c, 0x27, 0x77, 0x4e, 0xce, 0x4d, 0x18, 0x6a, 0x8c, 0x66, 0x6a, 0x74, 0xa1, 0xe6, 0x6a, 0x9a,
+	0x98, 0xc0, 0xe8, 0xf1, 0x94, 0x8c, 0xa5, 0xa2, 0x7b, 0x30, 0x8b, 0xfb, 0x74, 0x10, 0x30, 0xe1,
+	0xdc, 0x9a, 0x6d, 0x3f, 0x4a, 0xcc, 0x99, 0x5f, 0x13, 0xf3, 0x8d, 0xc3, 0x4b, 0xb5, 0x7d, 0x6f,
+	0x3d, 0x60, 0x69, 0x62, 0x2a, 0x49, 0x8e, 0xfa, 0x45, 0x16, 0xcc, 0x72, 0xa7, 0xd2, 0x40, 0xc4,
+	0xa0, 0x66, 0x83, 0xc0, 0x08, 0x8a, 0xa3, 0x7e, 0x95, 0x93, 0x7f, 0xd0, 0x00, 0x36, 0x62, 0xef,
+	0x4e, 0xe8, 0x45, 0xd8, 0x25, 0xe8, 0x1e, 0x54, 0xf0, 0x84, 0x73, 0x8f, 0x9f, 0x7e, 0xd9, 0x69,
+	0xf4, 0x0e, 0x54, 0x06, 0x52, 0x8d, 0xf0, 0x68, 0x7d, 0xf5, 0xec, 0x8a, 0x28, 0x90, 0x15, 0xa5,
+	0xdc, 0x9e, 0xe7, 0x1e, 0xe0, 0xfa, 0x14, 0xcc, 0xc9, 0x3e, 0x94, 0xad, 0x3f, 0x6b, 0x50, 0xc9,
+	0x0c, 0xb5, 0x60, 0x56, 0x26, 0x92, 0xb0, 0xd3, 0x90, 0x37, 0x94, 0xe9, 0xe3, 0x28, 0x0e, 0xba,
+	0x00, 0x95, 0x21, 0x89, 0x62, 0xee, 0x06, 0x99, 0xea, 0x75, 0x2e, 0x7c, 0x4b, 0x92, 0x9c, 0x8c,
+	0x87, 0x3e, 0x84, 0x05, 0xda, 0x73, 0x95, 0x60, 0x95, 0xba, 0x86, 0x10, 0xda, 0x4c, 0x13, 0xf3,
+	0xfc, 0xcd, 0x29, 0x5e, 0x31, 0x73, 0xa7, 0xcf, 0xa1, 0x55, 0xa8, 0xde, 0x27, 0x98, 0x0d, 0x22,
+	0x12, 0x37, 0x4a, 0x4b, 0xc6, 0x72, 0xcd, 0xfe, 0x7f, 0x9a, 0x98, 0xe8, 0x7d, 0x45, 0x2b, 0x9c,
+	0xcd, 0x71, 0xd6, 0xe7, 0x50, 0x69, 0x5f, 0xfd, 0x68, 0x13, 0xfb, 0x11, 0x7a, 0x15, 0x8c, 0x5d,
+	0x32, 0x6a, 0x68, 0x63, 0x6b, 0x71, 0xb7, 0x27, 0xca, 0x92, 0xd3, 0xd1, 0x6d, 0x28, 0x71, 0x67,
+	0x36, 0xf4, 0x13, 0x0a, 0x8d, 0x90, 0x66, 0xdd, 0x81, 0xba, 0xe8, 0x3f, 0xb2, 0x15, 0x21, 0xb3,
+	0x68, 0xc3, 0x54, 0x73, 0x10, 0x56, 0x5c, 0x80, 0xb2, 0xa8, 0xfe, 0x86, 0x7e, 0x70, 0x53, 0x90,
+	0x5c, 0xeb, 0x0b, 0x1d, 0x16, 0x37, 0x62, 0xef, 0xd6, 0x60, 0xbb, 0xef, 0xb3, 0xcd, 0x88, 0x86,
+	0x34, 0xc6, 0x3d, 0xf4, 0x09, 0x54, 0x43, 0xf1, 0x4d, 0x22, 0x95, 0x61, 0xed, 0x34, 0x31, 0x73,
+	0xda, 0xd1, 0xee, 0x91, 0x1f, 0x47, 0xd7, 0xa1, 0xd2, 0x15, 0xd7, 0xe0, 0x55, 0x6b, 0x2c, 0xd7,
+	0x57, 0x91, 0xca, 0xb1, 0xc2, 0x0d, 0xed, 0x97, 0x54, 0x9e, 0x2d, 0x2a, 0x68, 0x21, 0x2a, 0xd9,
+	0x69, 0x74, 0x65, 0x9c, 0xac, 0xc6, 0x81, 0xc9, 0xfa, 0x3f, 0x2e, 0x40, 0x41, 0x8a, 0x02, 0x26,
+	0x53, 0xf6, 0x47, 0x0d, 0x2a, 0x1b, 0xb1, 0xb7, 0x45, 0x19, 0x41, 0xb7, 0xa1, 0x3c, 0xa4, 0x2c,
+	0xbf, 0xf7, 0xe5, 0x34, 0x31, 0x25, 0xe1, 0x68, 0x97, 0x96, 0x67, 0x51, 0x0b, 0x20, 0x54, 0xce,
+	0x5d, 0x5f, 0x13, 0x21, 0x29, 0xa9, 0x90, 0x28, 0x6a, 0xc7, 0x77, 0x9d, 0x02, 0x84, 0x57, 0x05,
+	0x0e, 0xc3, 0x88, 0x0e, 0xe5, 0xcd, 0xaa, 0x2a, 0xcf, 0x24, 0xc9, 0xc9, 0x3e, 0x94, 0xfd, 0xbf,
+	0x94, 0xa1, 0x9a, 0xc7, 0xee, 0x15, 0xd0, 0x7d, 0x57, 0x58, 0x5f, 0xb2, 0xe7, 0xf6, 0x13, 0x53,
+	0x5f, 0x5f, 0x4b, 0x13, 0x53, 0xf7, 0x5d, 0x47, 0xf7, 0xdd, 0x89, 0xc8, 0xea, 0xa7, 0x1a, 0x59,
+	0xe3, 0xa4, 0x22, 0x5b, 0x3a, 0x4a, 0x64, 0xd1, 0xdb, 0x30, 0x17, 0x8b, 0xa4, 0x56, 0xbd, 0xa2,
+	0x2c, 0x7a, 0xc5, 0x62, 0x9a, 0x98, 0x67, 0x24, 0x5d, 0x8d, 0x38, 0x67, 0x02, 0x86, 0xae, 0xc0,
+	0xfc, 0x90, 0x32, 0x3f, 0xf0, 0xae, 0x05, 0xae, 0x3a, 0x39, 0x2b, 0x4e, 0x0a, 0x7d, 0x92, 0xd5,
+	0x21, 0x81, 0x9b, 0x9d, 0x9e, 0x46, 0xf3, 0x96, 0x17, 0x33, 0xcc, 0x06, 0x71, 0xa3, 0x32, 0x6e,
+	0xea, 0x92, 0xe2, 0xa8, 0x5f, 0x84, 0xa1, 0x3a, 0x22, 0xf1, 0x26, 0xdd, 0x23, 0x51, 0xa3, 0x2a,
+	0x50, 0xd7, 0x8e, 0x31, 0x56, 0x6a, 0x23, 0x12, 0x77, 0x42, 0x2e, 0xcc, 0xc9, 0xc5, 0xa2, 0x4f,
+	0xa1, 0x12, 0x50, 0xa9, 0xa1, 0x26, 0x34, 0xac, 0x1d, 0x43, 0x43, 0x35, 0xa0, 0x4a, 0x41, 0x26,
+	0x14, 0x79, 0x00, 0x8c, 0x32, 0xdc, 0x93, 0x2a, 0x40, 0xa8, 0xb8, 0x7e, 0x0c, 0x15, 0x75, 0x21,
+	0x4d, 0x69, 0x29, 0x88, 0xb6, 0x9e, 0x68, 0x50, 0x12, 0x85, 0x39, 0x59, 0x42, 0xda, 0x8b, 0x4b,
+	0x28, 0xaf, 0x64, 0xfd, 0x24, 0x2b, 0xf9, 0x70, 0x85, 0x59, 0x98, 0x7c, 0xa5, 0xf1, 0xe4, 0xdb,
+	0x99, 0x98, 0x7c, 0xd6, 0x4f, 0x3a, 0xa0, 0x4d, 0x12, 0xb8, 0x7e, 0xe0, 0x15, 0x5b, 0x7b, 0xf1,
+	0xf1, 0xa7, 0x1d, 0xfe, 0xf1, 0xa7, 0xbf, 0xe8, 0xf1, 0xd7, 0x3e, 0xe0, 0xf1, 0x67, 0x8c, 0x73,
+	0xfb, 0x99, 0xc7, 0xdf, 0xb3, 0x4f, 0x3e, 0xb4, 0x05, 0x65, 0xba, 0x17, 0x90, 0xa8, 0x51, 0x3a,
+	0xa1, 0xd9, 0x26, 0xc5, 0xa1, 0xcb, 0x30, 0x1f, 0x77, 0x77, 0x88, 0x3b, 0xe8, 0x11, 0x77, 0xa2,
+	0x5e, 0xcf, 0xa5, 0x89, 0xb9, 0x90, 0xb3, 0xf2, 0xa2, 0x9b, 0x02, 0x5b, 0x5f, 0xeb, 0xb0, 0x58,
+	0x70, 0xa1, 0x43, 0xba, 0x34, 0x72, 0xff, 0x8e, 0x23, 0x2f, 0x42, 0x95, 0xf6, 0xdc, 0xad, 0xc2,
+	0xc0, 0x14, 0x50, 0xda, 0x73, 0x33, 0x37, 0x66, 0x6c, 0x0e, 0x0d, 0xc8, 0x9e, 0x84, 0x1a, 0x63,
+	0x68, 0x40, 0xf6, 0x32, 0x68, 0xc6, 0x3e, 0x4c, 0x12, 0xa0, 0xbb, 0x30, 0x1b, 0xfb, 0x1e, 0xf7,
+	0x69, 0x59, 0x08, 0xbb, 0xc2, 0x31, 0x92, 0x72, 0x34, 0x97, 0xaa, 0xc3, 0xd6, 0x43, 0x0d, 0xce,
+	0xf1, 0xd5, 0x05, 0x07, 0x5d, 0xd2, 0xfb, 0x57, 0x9e, 0xe7, 0x2d, 0x00, 0xa6, 0x94, 0x4e, 0x0e,
+	0xbd, 0x8c, 0x2a, 0x2a, 0x76, 0x0c, 0x51, 0xd3, 0xec, 0x77, 0x03, 0x16, 0x3f, 0x1e, 0x90, 0x01,
+	0x71, 0x8b, 0x56, 0xff, 0xf5, 0x58, 0x9b, 0xba, 0x93, 0xfe, 0xcf, 0xac, 0x1c, 0xc6, 0x29, 0xaf,
+	0x1c, 0xa5, 0x53, 0x5c, 0x39, 0xca, 0xcf, 0x5b, 0x39, 0xf8, 0xe4, 0xfc, 0x4c, 0xb8, 0x7f, 0x62,
+	0xfe, 0x89, 0xc9, 0x29, 0xe9, 0xf9, 0xe4, 0x2c, 0xc2, 0x78, 0x0d, 0x93, 0x07, 0xa4, 0x3b, 0x28,
+	0x74, 0x97, 0xca, 0xb8, 0x86, 0x73, 0x56, 0x5e, 0xc3, 0x53, 0x60, 0xeb, 0x1b, 0x0d, 0xea, 0x6b,
+	0xed, 0x9b, 0xb7, 0x42, 0xd9, 0x11, 0xd1, 0x2a, 0xd4, 0x43, 0x12, 0xf9, 0xd4, 0xbd, 0xc5, 0x70,
+	0x94, 0x2d, 0x10, 0x0b, 0x3c, 0xa4, 0x92, 0xdc, 0x89, 0x39, 0xdd, 0x29, 0x82, 0xd0, 0x5d, 0x28,
+	0xc7, 0x21, 0x09, 0x98, 0xda, 0x24, 0xda, 0xc7, 0xf0, 0x9c, 0x14, 0xe4, 0xc8, 0x1f, 0xeb, 0x5b,
+	0x0d, 0x16, 0xf8, 0x33, 0xd9, 0xf7, 0x02, 0xdc, 0x53, 0xbb, 0xc9, 0xa9, 0xae, 0x61, 0x07, 0x6f,
+	0x45, 0xc3, 0xa9, 0xad, 0x48, 0x55, 0xcc, 0x43, 0x0d, 0xce, 0x28, 0xa3, 0xa4, 0x85, 0xff, 0x01,
+	0xd3, 0x0a, 0xcd, 0xcf, 0x78, 0x5e, 0xf3, 0xb3, 0xd7, 0x1f, 0xed, 0x37, 0xb5, 0xc7, 0xfb, 0x4d,
+	0xed, 0xb7, 0xfd, 0xa6, 0xf6, 0xd5, 0xd3, 0xe6, 0xcc, 0xe3, 0xa7, 0xcd, 0x99, 0x27, 0x4f, 0x9b,
+	0x33, 0xf7, 0x5a, 0x87, 0x31, 0x50, 0xfe, 0x2b, 0x47, 0x98, 0xb9, 0x3d, 0x2b, 0xfe, 0x61, 0xf3,
+	0xd6, 0x9f, 0x03, 0x00, 0x03, 0xb3, 0x47, 0xaf, 0xe0, 0x11, 0x00, 0x00,
+}
+
+func (m *MsgChangeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ParamVal) > 0 {
+		i -= len(m.ParamVal)
+		copy(dAtA[i:], m.ParamVal)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamVal)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParamKey) > 0 {
+		i -= len(m.ParamKey)
+		copy(dAtA[i:], m.ParamKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDAOTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDAOTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDAOTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Upgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.OldUpgradeHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.OldUpgradeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ACLPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ACLPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ACLPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approve {
+		i--
+		if m.Approve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.NoPower.Size()
+		i -= size
+		if _, err := m.NoPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.YesPower.Size()
+		i -= size
+		if _, err := m.YesPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.VotingEndHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.VotingEndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Approve {
+		i--
+		if m.Approve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ParamVal) > 0 {
+		i -= len(m.ParamVal)
+		copy(dAtA[i:], m.ParamVal)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamVal)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParamKey) > 0 {
+		i -= len(m.ParamKey)
+		copy(dAtA[i:], m.ParamKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChangeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParamKey) > 0 {
+		i -= len(m.ParamKey)
+		copy(dAtA[i:], m.ParamKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ParamKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDAOTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDAOTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDAOTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransferID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TransferID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedDAOTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedDAOTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedDAOTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.QueuedHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.QueuedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DAOSpending) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAOSpending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAOSpending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PeriodStart != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PeriodStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgChangeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamVal)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovGov(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgDAOTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Upgrade.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.OldUpgradeHeight != 0 {
+		n += 1 + sovGov(uint64(m.OldUpgradeHeight))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ACLPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ProposalID != 0 {
+		n += 1 + sovGov(uint64(m.ProposalID))
+	}
+	if m.Approve {
+		n += 2
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovGov(uint64(m.ID))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovGov(uint64(m.SubmitHeight))
+	}
+	if m.VotingEndHeight != 0 {
+		n += 1 + sovGov(uint64(m.VotingEndHeight))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.YesPower.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.NoPower.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.TotalPower.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovGov(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Approve {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PendingParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParamKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ParamVal)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovGov(uint64(m.ActivationHeight))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ScheduledHeight != 0 {
+		n += 1 + sovGov(uint64(m.ScheduledHeight))
+	}
+	return n
+}
+
+func (m *ParamChangeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParamKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDAOTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.TransferID != 0 {
+		n += 1 + sovGov(uint64(m.TransferID))
+	}
+	return n
+}
+
+func (m *QueuedDAOTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovGov(uint64(m.ID))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.QueuedHeight != 0 {
+		n += 1 + sovGov(uint64(m.QueuedHeight))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovGov(uint64(m.ExecutionHeight))
+	}
+	return n
+}
+
+func (m *DAOSpending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodStart != 0 {
+		n += 1 + sovGov(uint64(m.PeriodStart))
+	}
+	l = m.Spent.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *MsgSignalVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VersionSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamVal = append(m.ParamVal[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParamVal == nil {
+				m.ParamVal = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDAOTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDAOTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldUpgradeHeight", wireType)
+			}
+			m.OldUpgradeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldUpgradeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ACLPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ACLPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ACLPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = append(m.Addr[:0], dAtA[iNdEx:postIndex]...)
+			if m.Addr == nil {
+				m.Addr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approve = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndHeight", wireType)
+			}
+			m.VotingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YesPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approve = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamVal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamVal = append(m.ParamVal[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParamVal == nil {
+				m.ParamVal = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			}
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *ACLPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
+func (m *ParamChangeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -744,15 +4359,47 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamChangeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamChangeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -779,16 +4426,156 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.FromAddress == nil {
-				m.FromAddress = []byte{}
+			m.OldValue = append(m.OldValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldValue == nil {
+				m.OldValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = append(m.NewValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewValue == nil {
+				m.NewValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDAOTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDAOTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDAOTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -798,29 +4585,31 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParamKey = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamVal", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferID", wireType)
 			}
-			var byteLen int
+			m.TransferID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -830,26 +4619,11 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TransferID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParamVal = append(m.ParamVal[:0], dAtA[iNdEx:postIndex]...)
-			if m.ParamVal == nil {
-				m.ParamVal = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -874,7 +4648,7 @@ func (m *MsgChangeParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
+func (m *QueuedDAOTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -897,13 +4671,32 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDAOTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueuedDAOTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDAOTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueuedDAOTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
@@ -937,7 +4730,7 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 				m.FromAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
@@ -971,7 +4764,7 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 				m.ToAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1005,7 +4798,7 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
@@ -1037,6 +4830,44 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedHeight", wireType)
+			}
+			m.QueuedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1061,7 +4892,7 @@ func (m *MsgDAOTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
+func (m *DAOSpending) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1084,17 +4915,17 @@ func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAOSpending: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAOSpending: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
 			}
-			var byteLen int
+			m.PeriodStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1104,31 +4935,16 @@ func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PeriodStart |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1138,22 +4954,23 @@ func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1181,7 +4998,7 @@ func (m *MsgUpgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Upgrade) Unmarshal(dAtA []byte) error {
+func (m *MsgSignalVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1204,36 +5021,17 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignalVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignalVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1243,46 +5041,29 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldUpgradeHeight", wireType)
-			}
-			m.OldUpgradeHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OldUpgradeHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
-		case 4:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1310,7 +5091,7 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1336,7 +5117,7 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ACLPair) Unmarshal(dAtA []byte) error {
+func (m *VersionSignal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1359,17 +5140,17 @@ func (m *ACLPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ACLPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionSignal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ACLPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionSignal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1379,29 +5160,31 @@ func (m *ACLPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1411,26 +5194,43 @@ func (m *ACLPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = append(m.Addr[:0], dAtA[iNdEx:postIndex]...)
-			if m.Addr == nil {
-				m.Addr = []byte{}
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+var (
+	ProposalsKey           = []byte{0x01} // prefix for each key to a proposal
+	VotesKey               = []byte{0x02} // prefix for each key to a vote on a proposal
+	ActiveProposalsKey     = []byte{0x03} // prefix for the proposals open for votes, sorted by voting end height
+	NextProposalIDKey      = []byte{0x04} // key for the id of the next proposal
+	PendingParamsKey       = []byte{0x05} // prefix for the scheduled param changes, sorted by activation height
+	ParamHistoryKey        = []byte{0x06} // prefix for the record of the applied param changes, sorted by height
+	DAOTransferQueueKey    = []byte{0x07} // prefix for the ids of the queued dao actions, sorted by execution height
+	NextDAOTransferKey     = []byte{0x08} // key for the id of the next queued dao action
+	DAOSpendingKey         = []byte{0x09} // key for the dao spending of the current period
+	VersionSignalsKey      = []byte{0x0A} // prefix for the version signalled by each validator
+	VoterPowersKey         = []byte{0x0B} // prefix for the voting power of the voter set of each proposal at its submission
+	DAOTransfersKey        = []byte{0x0C} // prefix for each key to a queued dao action
+	daoTransferIDByteCount = 8
+	proposalIDByteCount    = 8
+)
+
+// generates the key for the proposal with id
+func KeyForProposal(id uint64) []byte {
+	return append(ProposalsKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// generates the prefix for the votes of the proposal with id
+func KeyForVotes(id uint64) []byte {
+	return append(VotesKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// generates the key for the vote of the voter on the proposal with id
+func KeyForVote(id uint64, voter sdk.Address) []byte {
+	return append(KeyForVotes(id), voter.Bytes()...)
+}
+
+// generates the prefix for the voting powers of the voter set of the proposal with id
+func KeyForVoterPowers(id uint64) []byte {
+	return append(VoterPowersKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// generates the key for the voting power of the voter on the proposal with id
+func KeyForVoterPower(id uint64, voter sdk.Address) []byte {
+	return append(KeyForVoterPowers(id), voter.Bytes()...)
+}
+
+// generates the prefix for the active proposals ending at height
+func KeyForActiveProposalsAtHeight(height int64) []byte {
+	return append(ActiveProposalsKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// generates the key for the active proposal with id ending at height
+func KeyForActiveProposal(height int64, id uint64) []byte {
+	return append(KeyForActiveProposalsAtHeight(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ProposalIDFromActiveProposalKey - Returns the proposal id of an active proposal key
+func ProposalIDFromActiveProposalKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-proposalIDByteCount:])
+}
+
+// generates the prefix for the param changes scheduled at height
+func KeyForPendingParamChangesAtHeight(height int64) []byte {
+	return append(PendingParamsKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// generates the key for the change of the param with aclKey scheduled at height
+func KeyForPendingParamChange(height int64, aclKey string) []byte {
+	return append(KeyForPendingParamChangesAtHeight(height), []byte(aclKey)...)
+}
+
+// generates the prefix for the param changes applied at height
+func KeyForParamChangeRecordsAtHeight(height int64) []byte {
+	return append(ParamHistoryKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// generates the key for the index-th param change applied at height
+func KeyForParamChangeRecord(height int64, index uint64) []byte {
+	return append(KeyForParamChangeRecordsAtHeight(height), sdk.Uint64ToBigEndian(index)...)
+}
+
+// generates the prefix for the dao actions queued for execution at height
+func KeyForQueuedDAOTransfersAtHeight(height int64) []byte {
+	return append(DAOTransferQueueKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// generates the key for the id of the dao action queued for execution at height
+func KeyForQueuedDAOTransfer(height int64, id uint64) []byte {
+	return append(KeyForQueuedDAOTransfersAtHeight(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// DAOTransferIDFromQueueKey - Returns the id of a queued dao action from its key in the queue
+func DAOTransferIDFromQueueKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-daoTransferIDByteCount:])
+}
+
+// generates the key for the queued dao action with id
+func KeyForDAOTransfer(id uint64) []byte {
+	return append(DAOTransfersKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// generates the key for the version signalled by the validator with addr
+func KeyForVersionSignal(addr sdk.Address) []byte {
+	return append(VersionSignalsKey, addr.Bytes()...)
+}
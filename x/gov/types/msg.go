@@ -9,20 +9,29 @@ var (
 	_ sdk.ProtoMsg = &MsgChangeParam{}
 	_ sdk.ProtoMsg = &MsgDAOTransfer{}
 	_ sdk.ProtoMsg = &MsgUpgrade{}
+	_ sdk.ProtoMsg = &MsgSubmitProposal{}
+	_ sdk.ProtoMsg = &MsgVote{}
+	_ sdk.ProtoMsg = &MsgCancelDAOTransfer{}
+	_ sdk.ProtoMsg = &MsgSignalVersion{}
 )
 
 const (
 	MsgDAOTransferName = "dao_tranfer"
 	MsgChangeParamName = "change_param"
 	MsgUpgradeName     = "upgrade"
+	MsgProposalName    = "submit_proposal"
+	MsgVoteName        = "vote"
+	MsgDAOCancelName   = "dao_cancel"
+	MsgSignalName      = "signal_version"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
 // MsgChangeParam structure for changing governance parameters
 // type MsgChangeParam struct {
-// 	FromAddress sdk.Address `json:"address"`
-// 	ParamKey    string      `json:"param_key"`
-// 	ParamVal    []byte      `json:"param_value"`
+// 	FromAddress      sdk.Address `json:"address"`
+// 	ParamKey         string      `json:"param_key"`
+// 	ParamVal         []byte      `json:"param_value"`
+// 	ActivationHeight int64       `json:"activation_height,omitempty"` // zero applies the change immediately
 // }
 
 // Route provides router key for msg
@@ -63,6 +72,9 @@ func (msg MsgChangeParam) ValidateBasic() sdk.Error {
 	if msg.ParamVal == nil {
 		return ErrEmptyValue(ModuleName)
 	}
+	if msg.ActivationHeight < 0 {
+		return ErrInvalidActivationHeight(ModuleName, msg.ActivationHeight, 0)
+	}
 	return nil
 }
 
@@ -169,3 +181,186 @@ func (msg MsgUpgrade) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgSubmitProposal structure for proposing governance parameter changes and/or an upgrade
+// type MsgSubmitProposal struct {
+// 	Proposer sdk.Address   `json:"proposer"`
+// 	Changes  []ParamChange `json:"changes,omitempty"`
+// 	Upgrade  *Upgrade      `json:"upgrade,omitempty"`
+// }
+
+// Route provides router key for msg
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgSubmitProposal) Type() string { return MsgProposalName }
+
+// GetFee get fee for msg
+func (msg MsgSubmitProposal) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSubmitProposal) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Proposer}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSubmitProposal) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
+	if msg.Proposer == nil {
+		return sdk.ErrInvalidAddress("nil proposer address")
+	}
+	return ValidateProposalContent(msg.Changes, msg.Upgrade)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgVote structure for voting on a governance proposal
+// type MsgVote struct {
+// 	Voter      sdk.Address `json:"voter"`
+// 	ProposalID uint64      `json:"proposal_id"`
+// 	Approve    bool        `json:"approve"`
+// }
+
+// Route provides router key for msg
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgVote) Type() string { return MsgVoteName }
+
+// GetFee get fee for msg
+func (msg MsgVote) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgVote) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Voter}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgVote) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgVote) ValidateBasic() sdk.Error {
+	if msg.Voter == nil {
+		return sdk.ErrInvalidAddress("nil voter address")
+	}
+	if msg.ProposalID == 0 {
+		return ErrProposalNotFound(ModuleName, msg.ProposalID)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgCancelDAOTransfer structure for cancelling a queued dao action
+// type MsgCancelDAOTransfer struct {
+// 	FromAddress sdk.Address `json:"from_address"`
+// 	TransferID  uint64      `json:"transfer_id"`
+// }
+
+// Route provides router key for msg
+func (msg MsgCancelDAOTransfer) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgCancelDAOTransfer) Type() string { return MsgDAOCancelName }
+
+// GetFee get fee for msg
+func (msg MsgCancelDAOTransfer) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCancelDAOTransfer) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetRecipient returns the recipient of the msg, none for a cancellation
+func (msg MsgCancelDAOTransfer) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelDAOTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgCancelDAOTransfer) ValidateBasic() sdk.Error {
+	if msg.FromAddress == nil {
+		return sdk.ErrInvalidAddress("nil from address")
+	}
+	if msg.TransferID == 0 {
+		return ErrDAOTransferNotFound(ModuleName, msg.TransferID)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgSignalVersion structure for a validator signalling the version of its binary
+// type MsgSignalVersion struct {
+// 	Address sdk.Address `json:"address"`
+// 	Version string      `json:"version"`
+// }
+
+// Route provides router key for msg
+func (msg MsgSignalVersion) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgSignalVersion) Type() string { return MsgSignalName }
+
+// GetFee get fee for msg
+func (msg MsgSignalVersion) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSignalVersion) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Address}
+}
+
+// GetRecipient returns the recipient of the msg, none for a signal
+func (msg MsgSignalVersion) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSignalVersion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgSignalVersion) ValidateBasic() sdk.Error {
+	if msg.Address == nil {
+		return sdk.ErrInvalidAddress("nil address")
+	}
+	if !IsValidVersion(msg.Version) {
+		return ErrInvalidVersion(ModuleName, msg.Version)
+	}
+	return nil
+}
@@ -110,3 +110,86 @@ func TestMsgUpgrade_ValidateBasic(t *testing.T) {
 	}
 	assert.NotNil(t, m.ValidateBasic())
 }
+
+func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
+	cdc := makeTestCodec()
+	bytes, _ := cdc.MarshalJSON(false)
+	change := ParamChange{Key: "bank/sendenabled", Value: bytes}
+	upgrade := NewUpgrade(100, "2.0.0")
+	m := MsgSubmitProposal{
+		Proposer: getRandomValidatorAddress(),
+		Changes:  []ParamChange{change},
+		Upgrade:  &upgrade,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Proposer = nil
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgSubmitProposal{Proposer: getRandomValidatorAddress()}
+	assert.NotNil(t, m.ValidateBasic())
+	m.Changes = []ParamChange{change, change}
+	assert.NotNil(t, m.ValidateBasic())
+	m.Changes = []ParamChange{{Key: "sendenabled", Value: bytes}}
+	assert.NotNil(t, m.ValidateBasic())
+	m.Changes = []ParamChange{{Key: "gov/upgrade", Value: bytes}}
+	assert.NotNil(t, m.ValidateBasic())
+	m.Changes = nil
+	m.Upgrade = &Upgrade{Version: "2.0.0"}
+	err := m.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeZeroHeightUpgrade, err.Code())
+	m.Upgrade = &Upgrade{Height: 100}
+	err = m.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeInvalidProposal, err.Code())
+}
+
+func TestProposal_Passes(t *testing.T) {
+	p := Proposal{YesPower: types.NewInt(3), NoPower: types.NewInt(1), TotalPower: types.NewInt(8)}
+	assert.True(t, p.Passes(50, 50))
+	assert.False(t, p.Passes(60, 50))
+	assert.False(t, p.Passes(50, 75))
+	p.TotalPower = types.ZeroInt()
+	assert.False(t, p.Passes(0, 0))
+}
+
+func TestMsgChangeParam_ValidateBasicActivationHeight(t *testing.T) {
+	cdc := makeTestCodec()
+	bytes, _ := cdc.MarshalJSON(false)
+	m := MsgChangeParam{
+		FromAddress:      getRandomValidatorAddress(),
+		ParamKey:         "bank/sendenabled",
+		ParamVal:         bytes,
+		ActivationHeight: 100,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.ActivationHeight = -1
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgCancelDAOTransfer_ValidateBasic(t *testing.T) {
+	m := MsgCancelDAOTransfer{
+		FromAddress: getRandomValidatorAddress(),
+		TransferID:  1,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.TransferID = 0
+	assert.NotNil(t, m.ValidateBasic())
+	m.TransferID = 1
+	m.FromAddress = nil
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgSignalVersion_ValidateBasic(t *testing.T) {
+	m := MsgSignalVersion{
+		Address: getRandomValidatorAddress(),
+		Version: "0.12.0",
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Version = "RC-0.12.0"
+	assert.NotNil(t, m.ValidateBasic())
+	m.Version = ""
+	assert.NotNil(t, m.ValidateBasic())
+	m.Version = "0.12.0"
+	m.Address = nil
+	assert.NotNil(t, m.ValidateBasic())
+}
@@ -12,22 +12,49 @@ import (
 const DefaultParamspace = ModuleName
 
 // Default parameter values
-const ()
+const (
+	DefaultProposalVotingPeriod = int64(96)
+	DefaultProposalVoterSet     = ACLVoterSet
+	DefaultProposalQuorum       = int64(50)
+	DefaultProposalThreshold    = int64(50)
+	DefaultDAOSpendPeriod       = int64(0)
+	DefaultDAOSpendCap          = int64(0)
+	DefaultDAOTimelockThreshold = int64(0)
+	DefaultDAOTimelockPeriod    = int64(0)
+)
 
 // Parameter keys
 var (
 	ACLKey      = []byte("acl")
 	DAOOwnerKey = []byte("daoOwner")
 	UpgradeKey  = []byte("upgrade")
+	// proposal parameters, activated with the GovProposal feature
+	ProposalVotingPeriodKey = []byte("proposalVotingPeriod")
+	ProposalVoterSetKey     = []byte("proposalVoterSet")
+	ProposalQuorumKey       = []byte("proposalQuorum")
+	ProposalThresholdKey    = []byte("proposalThreshold")
+	// dao treasury parameters, activated with the DAOTreasury feature
+	DAOSpendPeriodKey       = []byte("daoSpendPeriod")
+	DAOSpendCapKey          = []byte("daoSpendCap")
+	DAOTimelockThresholdKey = []byte("daoTimelockThreshold")
+	DAOTimelockPeriodKey    = []byte("daoTimelockPeriod")
 )
 
 var _ sdk.ParamSet = (*Params)(nil)
 
 // Params defines the parameters for the auth module.
 type Params struct {
-	ACL      ACL         `json:"acl"`
-	DAOOwner sdk.Address `json:"dao_owner"`
-	Upgrade  Upgrade     `json:"upgrade"`
+	ACL                  ACL         `json:"acl"`
+	DAOOwner             sdk.Address `json:"dao_owner"`
+	Upgrade              Upgrade     `json:"upgrade"`
+	ProposalVotingPeriod int64       `json:"proposal_voting_period"` // number of blocks a proposal is open for votes
+	ProposalVoterSet     string      `json:"proposal_voter_set"`     // "acl" (one vote per ACL owner) or "stake" (staked validators weighted by stake)
+	ProposalQuorum       int64       `json:"proposal_quorum"`        // percentage of the voting power that must vote
+	ProposalThreshold    int64       `json:"proposal_threshold"`     // percentage of the cast voting power that must approve
+	DAOSpendPeriod       int64       `json:"dao_spend_period"`       // number of blocks of a dao spending period, zero disables the cap
+	DAOSpendCap          int64       `json:"dao_spend_cap"`          // max amount of uPOKT moved out of the dao in a spending period, zero disables the cap
+	DAOTimelockThreshold int64       `json:"dao_timelock_threshold"` // amount of uPOKT above which dao actions are queued
+	DAOTimelockPeriod    int64       `json:"dao_timelock_period"`    // number of blocks a queued dao action waits, zero disables the timelock
 }
 
 // NewParams creates a new Params object
@@ -51,6 +78,14 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: ACLKey, Value: &p.ACL},
 		{Key: DAOOwnerKey, Value: &p.DAOOwner},
 		{Key: UpgradeKey, Value: &p.Upgrade},
+		{Key: ProposalVotingPeriodKey, Value: &p.ProposalVotingPeriod},
+		{Key: ProposalVoterSetKey, Value: &p.ProposalVoterSet},
+		{Key: ProposalQuorumKey, Value: &p.ProposalQuorum},
+		{Key: ProposalThresholdKey, Value: &p.ProposalThreshold},
+		{Key: DAOSpendPeriodKey, Value: &p.DAOSpendPeriod},
+		{Key: DAOSpendCapKey, Value: &p.DAOSpendCap},
+		{Key: DAOTimelockThresholdKey, Value: &p.DAOTimelockThreshold},
+		{Key: DAOTimelockPeriodKey, Value: &p.DAOTimelockPeriod},
 	}
 }
 
@@ -64,9 +99,17 @@ func DefaultParams() Params {
 	acl := ACL(make([]ACLPair, 0))
 	u := NewUpgrade(0, "")
 	return Params{
-		ACL:      acl,
-		DAOOwner: sdk.Address{},
-		Upgrade:  u,
+		ACL:                  acl,
+		DAOOwner:             sdk.Address{},
+		Upgrade:              u,
+		ProposalVotingPeriod: DefaultProposalVotingPeriod,
+		ProposalVoterSet:     DefaultProposalVoterSet,
+		ProposalQuorum:       DefaultProposalQuorum,
+		ProposalThreshold:    DefaultProposalThreshold,
+		DAOSpendPeriod:       DefaultDAOSpendPeriod,
+		DAOSpendCap:          DefaultDAOSpendCap,
+		DAOTimelockThreshold: DefaultDAOTimelockThreshold,
+		DAOTimelockPeriod:    DefaultDAOTimelockPeriod,
 	}
 }
 
@@ -77,5 +120,13 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("ACLKey: %v\n", p.ACL))
 	sb.WriteString(fmt.Sprintf("DAOOwnerKey: %s\n", p.DAOOwner))
 	sb.WriteString(fmt.Sprintf("UpgradeKey: %v\n", p.Upgrade))
+	sb.WriteString(fmt.Sprintf("ProposalVotingPeriod: %d\n", p.ProposalVotingPeriod))
+	sb.WriteString(fmt.Sprintf("ProposalVoterSet: %s\n", p.ProposalVoterSet))
+	sb.WriteString(fmt.Sprintf("ProposalQuorum: %d\n", p.ProposalQuorum))
+	sb.WriteString(fmt.Sprintf("ProposalThreshold: %d\n", p.ProposalThreshold))
+	sb.WriteString(fmt.Sprintf("DAOSpendPeriod: %d\n", p.DAOSpendPeriod))
+	sb.WriteString(fmt.Sprintf("DAOSpendCap: %d\n", p.DAOSpendCap))
+	sb.WriteString(fmt.Sprintf("DAOTimelockThreshold: %d\n", p.DAOTimelockThreshold))
+	sb.WriteString(fmt.Sprintf("DAOTimelockPeriod: %d\n", p.DAOTimelockPeriod))
 	return sb.String()
 }
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	// voter sets of proposals
+	ACLVoterSet   = "acl"   // every owner of an ACL key has one vote
+	StakeVoterSet = "stake" // every staked validator votes with its staked tokens
+	// statuses of proposals
+	ProposalStatusVoting   = "voting"
+	ProposalStatusPassed   = "passed"
+	ProposalStatusRejected = "rejected"
+	ProposalStatusFailed   = "failed" // passed but could not be executed
+	// max number of param changes bundled in a proposal
+	MaxProposalChanges = 20
+)
+
+// VoterPower - The voting power of a voter on a proposal, snapshotted when the proposal is submitted
+type VoterPower struct {
+	ProposalID uint64      `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.Address `json:"voter" yaml:"voter"`
+	Power      sdk.BigInt  `json:"power" yaml:"power"`
+}
+
+// NewProposal - Create a new proposal open for votes until votingEndHeight
+func NewProposal(id uint64, proposer sdk.Address, changes []ParamChange, upgrade *Upgrade, submitHeight, votingEndHeight int64) Proposal {
+	return Proposal{
+		ID:              id,
+		Proposer:        proposer,
+		Changes:         changes,
+		Upgrade:         upgrade,
+		SubmitHeight:    submitHeight,
+		VotingEndHeight: votingEndHeight,
+		Status:          ProposalStatusVoting,
+		YesPower:        sdk.ZeroInt(),
+		NoPower:         sdk.ZeroInt(),
+		TotalPower:      sdk.ZeroInt(),
+	}
+}
+
+// IsActive - Returns if the proposal is open for votes
+func (p Proposal) IsActive() bool {
+	return p.Status == ProposalStatusVoting
+}
+
+// Passes - Returns if the tally of the proposal reaches the quorum and threshold (percentages)
+func (p Proposal) Passes(quorum, threshold int64) bool {
+	cast := p.YesPower.Add(p.NoPower)
+	if p.TotalPower.IsZero() || cast.IsZero() {
+		return false
+	}
+	hundred := sdk.NewInt(100)
+	if cast.Mul(hundred).LT(p.TotalPower.Mul(sdk.NewInt(quorum))) {
+		return false
+	}
+	return p.YesPower.Mul(hundred).GT(cast.Mul(sdk.NewInt(threshold)))
+}
+
+// ValidateProposalContent - Basic checks over the changes and upgrade bundled in a proposal
+func ValidateProposalContent(changes []ParamChange, upgrade *Upgrade) sdk.Error {
+	if len(changes) == 0 && upgrade == nil {
+		return ErrEmptyChanges(ModuleName)
+	}
+	if len(changes) > MaxProposalChanges {
+		return ErrInvalidProposal(ModuleName, fmt.Sprintf("more than %d param changes", MaxProposalChanges))
+	}
+	keys := make(map[string]struct{}, len(changes))
+	for _, change := range changes {
+		if change.Key == "" {
+			return ErrEmptyKey(ModuleName)
+		}
+		if len(strings.Split(change.Key, ACLKeySep)) != 2 {
+			return ErrInvalidProposal(ModuleName, "malformed param key "+change.Key)
+		}
+		if change.Key == NewACLKey(ModuleName, string(UpgradeKey)) {
+			return ErrInvalidProposal(ModuleName, "upgrades must use the upgrade field")
+		}
+		if change.Value == nil {
+			return ErrEmptyValue(ModuleName)
+		}
+		if _, found := keys[change.Key]; found {
+			return ErrInvalidProposal(ModuleName, "duplicate param key "+change.Key)
+		}
+		keys[change.Key] = struct{}{}
+	}
+	if upgrade != nil {
+		if upgrade.UpgradeHeight() == 0 {
+			return ErrZeroHeightUpgrade(ModuleName)
+		}
+		if upgrade.UpgradeVersion() == "" {
+			return ErrInvalidProposal(ModuleName, "empty upgrade version")
+		}
+	}
+	return nil
+}
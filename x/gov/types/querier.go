@@ -16,6 +16,14 @@ const (
 	QueryDAO                           = "dao"
 	QueryUpgrade                       = "upgrade"
 	QueryDAOOwner                      = "daoOwner"
+	QueryProposals                     = "proposals"
+	QueryProposal                      = "proposal"
+	QueryVotes                         = "votes"
+	QueryParamQueue                    = "pendingParams"
+	QueryHistory                       = "paramHistory"
+	QueryDAOQueue                      = "daoQueue"
+	QueryDAOSpending                   = "daoSpending"
+	QueryReadiness                     = "upgradeReadiness"
 )
 
 type QueryACLParams struct{}
@@ -23,3 +31,19 @@ type QueryACLParams struct{}
 type QueryDAOParams struct{}
 
 type QueryUpgradeParams struct{}
+
+type QueryProposalParams struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+// QueryParamHistoryParams - filters of the param history; a zero ToHeight has no upper bound
+type QueryParamHistoryParams struct {
+	Key        string `json:"key"`
+	FromHeight int64  `json:"from_height"`
+	ToHeight   int64  `json:"to_height"`
+}
+
+// QueryReadinessParams - the version to measure the readiness for; the scheduled upgrade version if empty
+type QueryReadinessParams struct {
+	Version string `json:"version"`
+}
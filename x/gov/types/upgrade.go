@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
 //type Upgrade struct {
 //	Height  int64  `json:"Height"`
 //	Version string `json:"Version"`
@@ -19,3 +23,24 @@ func (u Upgrade) UpgradeHeight() int64 {
 func (u Upgrade) UpgradeVersion() string {
 	return u.Version
 }
+
+// IsValidVersion - Returns if version is a dot-delimited version number that can be compared
+func IsValidVersion(version string) bool {
+	_, err := sdk.CompareVersionStrings(version, version)
+	return version != "" && err == nil
+}
+
+// VersionStake - The staked tokens of the validators that signalled a version
+type VersionStake struct {
+	Version string     `json:"version"`
+	Tokens  sdk.BigInt `json:"tokens"`
+}
+
+// UpgradeReadiness - The share of the stake whose validators signalled a version at least equal to the target
+type UpgradeReadiness struct {
+	Version     string         `json:"version"`
+	Height      int64          `json:"height"` // height of the scheduled upgrade, zero for another version
+	ReadyTokens sdk.BigInt     `json:"ready_tokens"`
+	TotalTokens sdk.BigInt     `json:"total_tokens"`
+	Versions    []VersionStake `json:"versions"` // staked tokens by signalled version
+}
@@ -112,6 +112,11 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			keeper.SetValidatorMissedAt(ctx, address, missed.Index, missed.Missed)
 		}
 	}
+	// update the slash history from genesis state
+	if err := keeper.SetSlashHistory(ctx, data.SlashHistory); err != nil {
+		keeper.Logger(ctx).Error(fmt.Sprintf("unable to set the slash history in genesis: %v", err))
+		os.Exit(1)
+	}
 	// set the params set in the keeper
 	keeper.Paramstore.SetParamSet(ctx, &data.Params)
 	if data.PreviousProposer != nil {
@@ -138,6 +143,11 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		signingInfos[addrstring] = info
 		return false
 	})
+	var slashHistory []types.SlashRecord
+	keeper.IterateAndExecuteOverSlashHistory(ctx, func(record types.SlashRecord) (stop bool) {
+		slashHistory = append(slashHistory, record)
+		return false
+	})
 	prevProposer := keeper.GetPreviousProposer(ctx)
 
 	return types.GenesisState{
@@ -149,6 +159,7 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		SlashHistory:             slashHistory,
 	}
 }
 
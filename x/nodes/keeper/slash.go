@@ -36,12 +36,12 @@ func (k Keeper) BurnForChallenge(ctx sdk.Ctx, challenges sdk.BigInt, address sdk
 	} else {
 		coins = k.RelaysToTokensMultiplier(ctx).Mul(challenges)
 	}
-	k.simpleSlash(ctx, address, coins)
+	k.simpleSlash(ctx, address, coins, types.AttributeValueChallengeBurn)
 }
 
 // simpleSlash - Slash validator for an infraction committed at a known height
 // Find the contributing stake at that height and burn the specified slashFactor
-func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.BigInt) {
+func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.BigInt, reason string) {
 	// error check slash
 	validator := k.validateSimpleSlash(ctx, addr, amount)
 	if validator.Address.Empty() {
@@ -60,6 +60,7 @@ func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.BigInt) {
 		k.Logger(ctx).Error("could not burn staked tokens in simpleSlash: " + err.Error() + "\nfor validator " + addr.String())
 		return
 	}
+	k.recordSlashHistory(ctx, validator, types.SlashRecordTypeSlash, reason, tokensToBurn)
 	// if falls below minimum force burn all of the stake
 	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		var err error
@@ -102,7 +103,7 @@ func (k Keeper) validateSimpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.Bi
 
 // slash - Slash a validator for an infraction committed at a known height
 // Find the contributing stake at that height and burn the specified slashFactor
-func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int64, slashFactor sdk.BigDec) {
+func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int64, slashFactor sdk.BigDec, reason string) {
 	// error check slash
 	validator := k.validateSlash(ctx, addr, infractionHeight, power, slashFactor)
 	if validator.Address == nil {
@@ -127,6 +128,7 @@ func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int
 		k.Logger(ctx).Error("could not burn staked tokens in slash: " + err.Error() + "\nfor validator " + addr.String())
 		return
 	}
+	k.recordSlashHistory(ctx, validator, types.SlashRecordTypeSlash, reason, tokensToBurn)
 	// if falls below minimum force burn all of the stake
 	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		var err error
@@ -194,7 +196,7 @@ func (k Keeper) handleDoubleSign(ctx sdk.Ctx, addr crypto.Address, infractionHei
 			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
 		),
 	)
-	k.slash(ctx, address, distributionHeight, power, fraction)
+	k.slash(ctx, address, distributionHeight, power, fraction, types.AttributeValueDoubleSign)
 	// todo fix once tendermint is patched
 }
 
@@ -278,7 +280,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, addr sdk.Address, power in
 		// height where the infraction occured
 		slashHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
 		// slash them based on their power
-		k.slash(ctx, addr, slashHeight, power, slashFractionDowtime)
+		k.slash(ctx, addr, slashHeight, power, slashFractionDowtime, types.AttributeValueMissingSignature)
 		// reset the signing info
 		signInfo.ResetSigningInfo()
 		// clear the validator missed at
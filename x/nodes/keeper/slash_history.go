@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+)
+
+// SetSlashRecord - Store a slash record in the history of the validator
+func (k Keeper) SetSlashRecord(ctx sdk.Ctx, record types.SlashRecord) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	// the next free index for the height follows the last record stored at it
+	index := uint16(0)
+	iter, _ := sdk.KVStoreReversePrefixIterator(store, types.KeyForSlashHistoryAtHeight(record.Address, record.Height))
+	if iter.Valid() {
+		last := binary.BigEndian.Uint16(iter.Key()[len(iter.Key())-2:])
+		if last == math.MaxUint16 {
+			iter.Close()
+			return types.ErrSlashHistoryFull(types.ModuleName, record.Address, record.Height)
+		}
+		index = last + 1
+	}
+	iter.Close()
+	bz, _ := k.Cdc.MarshalBinaryLengthPrefixed(&record, ctx.BlockHeight())
+	_ = store.Set(types.KeyForSlashRecord(record.Address, record.Height, index), bz)
+	return nil
+}
+
+// SetSlashHistory - Store a list of slash records
+func (k Keeper) SetSlashHistory(ctx sdk.Ctx, records []types.SlashRecord) sdk.Error {
+	for _, record := range records {
+		if err := k.SetSlashRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSlashHistory - Retrieve the slash history of the validator, ordered by height
+func (k Keeper) GetSlashHistory(ctx sdk.Ctx, addr sdk.Address) (records []types.SlashRecord) {
+	records = make([]types.SlashRecord, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.KeyForSlashHistory(addr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashRecord
+		_ = k.Cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &record, ctx.BlockHeight())
+		records = append(records, record)
+	}
+	return
+}
+
+// IterateAndExecuteOverSlashHistory - Goes over the slash history of all validators and executes handler
+func (k Keeper) IterateAndExecuteOverSlashHistory(ctx sdk.Ctx, handler func(record types.SlashRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.SlashHistoryKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashRecord
+		_ = k.Cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &record, ctx.BlockHeight())
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// recordSlashHistory - Append a slash or jail to the history of the validator
+func (k Keeper) recordSlashHistory(ctx sdk.Ctx, validator types.Validator, recordType, reason string, amount sdk.BigInt) {
+	if !k.Cdc.IsAfterSlashHistoryUpgrade(ctx.BlockHeight()) {
+		return
+	}
+	err := k.SetSlashRecord(ctx, types.SlashRecord{
+		Address:        validator.Address,
+		Height:         ctx.BlockHeight(),
+		Type:           recordType,
+		Reason:         reason,
+		Amount:         amount,
+		ResultingStake: validator.StakedTokens,
+	})
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("unable to record the %s of validator %s: %s", recordType, validator.Address, err.Error()))
+	}
+}
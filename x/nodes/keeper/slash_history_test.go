@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlashHistory(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	codec.TestMode = -3
+	stakedValidator := getStakedValidator()
+	address := stakedValidator.GetAddress()
+	context, _, keeper := createTestInput(t, true)
+	keeper.SetValidator(context, stakedValidator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	keeper.simpleSlash(context, address, sdk.NewInt(100), types.AttributeValueChallengeBurn)
+	keeper.JailValidator(context, address)
+	history := keeper.GetSlashHistory(context, address)
+	assert.Len(t, history, 2)
+	assert.Equal(t, types.SlashRecordTypeSlash, history[0].Type)
+	assert.Equal(t, types.AttributeValueChallengeBurn, history[0].Reason)
+	assert.True(t, history[0].Amount.Equal(sdk.NewInt(100)))
+	assert.True(t, history[0].ResultingStake.Equal(stakedValidator.StakedTokens.Sub(sdk.NewInt(100))))
+	assert.Equal(t, types.SlashRecordTypeJail, history[1].Type)
+	assert.Equal(t, types.AttributeValueMissingSignature, history[1].Reason)
+	assert.True(t, history[1].Amount.IsZero())
+	// records of other validators are not included
+	assert.Empty(t, keeper.GetSlashHistory(context, getRandomValidatorAddress()))
+	var all []types.SlashRecord
+	keeper.IterateAndExecuteOverSlashHistory(context, func(record types.SlashRecord) (stop bool) {
+		all = append(all, record)
+		return false
+	})
+	assert.Equal(t, history, all)
+}
+
+func TestSetSlashRecord_Full(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	record := types.SlashRecord{
+		Address:        getRandomValidatorAddress(),
+		Height:         context.BlockHeight(),
+		Type:           types.SlashRecordTypeJail,
+		Reason:         types.AttributeValueMissingSignature,
+		Amount:         sdk.ZeroInt(),
+		ResultingStake: sdk.ZeroInt(),
+	}
+	assert.Nil(t, keeper.SetSlashRecord(context, record))
+	// fill the last index of the height
+	bz, _ := keeper.Cdc.MarshalBinaryLengthPrefixed(&record, context.BlockHeight())
+	_ = context.KVStore(keeper.storeKey).Set(types.KeyForSlashRecord(record.Address, record.Height, math.MaxUint16), bz)
+	err := keeper.SetSlashRecord(context, record)
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeSlashHistoryFull, err.Code())
+	assert.Len(t, keeper.GetSlashHistory(context, record.Address), 2)
+	// other heights are unaffected
+	record.Height++
+	assert.Nil(t, keeper.SetSlashRecord(context, record))
+	assert.Len(t, keeper.GetSlashHistory(context, record.Address), 3)
+}
+
+func TestForceValidatorUnstake_JailReason(t *testing.T) {
+	originalTestMode := codec.TestMode
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+	})
+	for _, tc := range []struct {
+		testMode int64
+		reason   string
+	}{
+		{0, types.AttributeValueMissingSignature},
+		{-3, types.AttributeValueBelowMinimumStake},
+	} {
+		codec.TestMode = tc.testMode
+		stakedValidator := getStakedValidator()
+		context, _, keeper := createTestInput(t, true)
+		keeper.SetValidator(context, stakedValidator)
+		keeper.SetStakedValidatorByChains(context, stakedValidator)
+		addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+		assert.Nil(t, keeper.ForceValidatorUnstake(context, stakedValidator))
+		var reason string
+		for _, event := range context.EventManager().Events() {
+			if event.Type != types.EventTypeJail {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyReason {
+					reason = string(attr.Value)
+				}
+			}
+		}
+		assert.Equal(t, tc.reason, reason)
+	}
+}
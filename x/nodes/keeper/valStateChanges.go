@@ -9,6 +9,7 @@ import (
 	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
+	authexported "github.com/pokt-network/pocket-core/x/auth/exported"
 	"github.com/pokt-network/pocket-core/x/nodes/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/strings"
@@ -146,6 +147,9 @@ func (k Keeper) ValidateValidatorStaking(
 			return types.ErrNilOutputAddr(k.codespace)
 		}
 	}
+	if err := k.validateVestingOutputAddress(ctx, signerAddress, validatorNew); err != nil {
+		return err
+	}
 
 	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
 
@@ -198,6 +202,23 @@ func (k Keeper) ValidateValidatorStaking(
 	return nil
 }
 
+// validateVestingOutputAddress - The unstaked coins go to the output address, so a vesting account that funds
+// the stake must be the output address or its locked coins would be unlocked by unstaking
+func (k Keeper) validateVestingOutputAddress(ctx sdk.Ctx, signerAddress sdk.Address, validator types.Validator) sdk.Error {
+	vacc, ok := k.AccountKeeper.GetAccount(ctx, signerAddress).(authexported.VestingAccount)
+	if !ok || vacc.GetVestingCoins(ctx.BlockHeader().Time).IsZero() {
+		return nil
+	}
+	output := validator.OutputAddress
+	if output == nil {
+		output = validator.Address
+	}
+	if !output.Equals(signerAddress) {
+		return types.ErrVestingOutputAddress(k.codespace)
+	}
+	return nil
+}
+
 // ValidateValidatorMsgSigner Check Validator Signature
 func ValidateValidatorMsgSigner(validator types.Validator, signerAddress sdk.Address, k Keeper) (sdk.Error, bool) {
 	//check if outputAddress is defined, if not only the operator/node signature is valid
@@ -550,7 +571,11 @@ func (k Keeper) LegacyForceValidatorUnstake(ctx sdk.Ctx, validator types.Validat
 func (k Keeper) ForceValidatorUnstake(ctx sdk.Ctx, validator types.Validator) sdk.Error {
 	k.ClearSessionCache()
 	// send validator to jail || if already jailed, do nothing
-	k.JailValidator(ctx, validator.Address)
+	reason := types.AttributeValueMissingSignature
+	if k.Cdc.IsAfterSlashHistoryUpgrade(ctx.BlockHeight()) {
+		reason = types.AttributeValueBelowMinimumStake
+	}
+	k.jailValidator(ctx, validator.Address, reason)
 	ctx.Logger().Info("Sent Validator to Jail for falling below minimum stake" + validator.Address.String())
 	k.SetWaitingValidator(ctx, validator)
 	ctx.Logger().Info("Validator is waiting to begin unstaking" + validator.Address.String())
@@ -573,6 +598,11 @@ func (k Keeper) ForceValidatorUnstake(ctx sdk.Ctx, validator types.Validator) sd
 
 // JailValidator - Send a validator to jail
 func (k Keeper) JailValidator(ctx sdk.Ctx, addr sdk.Address) {
+	k.jailValidator(ctx, addr, types.AttributeValueMissingSignature)
+}
+
+// jailValidator - Send a validator to jail for the given reason
+func (k Keeper) jailValidator(ctx sdk.Ctx, addr sdk.Address, reason string) {
 	validator, found := k.GetValidator(ctx, addr)
 	if !found {
 		ctx.Logger().Error(fmt.Errorf("cannot find jailed validator: %v at height: %d\n", addr, ctx.BlockHeight()).Error())
@@ -591,13 +621,14 @@ func (k Keeper) JailValidator(ctx sdk.Ctx, addr sdk.Address) {
 	k.deleteValidatorFromStakingSet(ctx, validator)
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
+	k.recordSlashHistory(ctx, validator, types.SlashRecordTypeJail, reason, sdk.ZeroInt())
 	logger := k.Logger(ctx)
 	logger.Debug(fmt.Sprintf("validator %s jailed", addr))
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeJail,
 			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
-			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
 		),
 	)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
 	"github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -493,6 +494,70 @@ func TestValidatorStateChange_OutputAddressEdit(t *testing.T) {
 	assert.Equal(t, validatorCur.OutputAddress, outputAddress)
 }
 
+func TestValidatorStateChange_VestingOutputAddress(t *testing.T) {
+	ctx, _, k := createTestInput(t, true)
+
+	originalUpgradeHeight := codec.UpgradeHeight
+	originalNCUST := codec.UpgradeFeatureMap[codec.NonCustodialUpdateKey]
+	originalOEDIT := codec.UpgradeFeatureMap[codec.OutputAddressEditKey]
+	t.Cleanup(func() {
+		codec.UpgradeHeight = originalUpgradeHeight
+		codec.UpgradeFeatureMap[codec.NonCustodialUpdateKey] = originalNCUST
+		codec.UpgradeFeatureMap[codec.OutputAddressEditKey] = originalOEDIT
+	})
+	codec.UpgradeHeight = -1
+	codec.UpgradeFeatureMap[codec.NonCustodialUpdateKey] = -1
+	codec.UpgradeFeatureMap[codec.OutputAddressEditKey] = -1
+
+	start := time.Unix(1000, 0)
+	ctx = ctx.WithBlockTime(start.Add(100 * time.Second))
+	stakeAmount := sdk.NewCoin(k.StakeDenom(ctx), sdk.NewInt(k.MinimumStake(ctx)))
+	// all of the coins of the vesting account are still vesting
+	vestingPubKey := getRandomPubKey()
+	vestingAddr := sdk.Address(vestingPubKey.Address())
+	baseAccount := auth.NewBaseAccountWithAddress(vestingAddr)
+	baseAccount.Coins = sdk.NewCoins(stakeAmount)
+	ak := k.AccountKeeper.(auth.Keeper)
+	ak.SetAccount(ctx, auth.NewContinuousVestingAccount(&baseAccount, start.Unix(), start.Unix()+1000))
+
+	runStake := func(operatorPubKey crypto.PublicKey, outputAddress sdk.Address) sdk.Error {
+		msgStake := types.MsgStake{
+			Chains:     []string{"0021", "0040"},
+			ServiceUrl: "https://www.pokt.network:443",
+			Value:      stakeAmount.Amount,
+			PublicKey:  operatorPubKey,
+			Output:     outputAddress,
+		}
+		return handleStakeForTesting(ctx, k, msgStake, vestingPubKey)
+	}
+
+	// the vesting account can't stake coins that would be unstaked to another address
+	err := runStake(vestingPubKey, sdk.Address(getRandomPubKey().Address()))
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeVestingOutputAddress, err.Code())
+
+	// it is the output address of the node it funds
+	operatorPubKey := getRandomPubKey()
+	operatorAddr := sdk.Address(operatorPubKey.Address())
+	assert.Nil(t, runStake(operatorPubKey, vestingAddr))
+	vacc := ak.GetAccount(ctx, vestingAddr).(auth.VestingAccount)
+	assert.Equal(t, vacc.GetVestingCoins(ctx.BlockTime()), vacc.GetDelegatedVesting())
+
+	// and can't move the output address while its coins are vesting
+	err = runStake(operatorPubKey, sdk.Address(getRandomPubKey().Address()))
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeVestingOutputAddress, err.Code())
+
+	// the unstaked coins are locked again
+	validator, found := k.GetValidator(ctx, operatorAddr)
+	assert.True(t, found)
+	assert.Nil(t, k.coinsFromStakedToUnstaked(ctx, validator))
+	vacc = ak.GetAccount(ctx, vestingAddr).(auth.VestingAccount)
+	assert.True(t, vacc.GetDelegatedVesting().IsZero())
+	assert.Equal(t, sdk.NewCoins(stakeAmount), vacc.GetCoins())
+	assert.Equal(t, vacc.GetVestedCoins(ctx.BlockTime()), vacc.SpendableCoins(ctx.BlockTime()))
+}
+
 func TestValidatorStateChange_Delegators(t *testing.T) {
 	ctx, _, k := createTestInput(t, true)
 
@@ -99,6 +99,27 @@ func (k Keeper) getAllUnstakingValidators(ctx sdk.Ctx) (validators []types.Valid
 
 }
 
+// GetUnstakingValidatorsInWindow - Retrieve the validators who will finish unstaking between start and end (inclusive)
+func (k Keeper) GetUnstakingValidatorsInWindow(ctx sdk.Ctx, start, end time.Time) (validators []types.Validator) {
+	validators = make([]types.Validator, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := store.Iterator(types.KeyForUnstakingValidators(start), sdk.InclusiveEndBytes(types.KeyForUnstakingValidators(end)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var addrs sdk.Addresses
+		_ = k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &addrs, ctx.BlockHeight())
+		for _, addr := range addrs {
+			validator, found := k.GetValidator(ctx, addr)
+			if !found {
+				ctx.Logger().Error(fmt.Errorf("cannot find validator from unstaking set: %v, at height %d\n", addr, ctx.BlockHeight()).Error())
+				continue
+			}
+			validators = append(validators, validator)
+		}
+	}
+	return validators
+}
+
 // getUnstakingValidators - Retrieve all of the validators who will be unstaked at exactly this time
 func (k Keeper) getUnstakingValidators(ctx sdk.Ctx, unstakingTime time.Time) (valAddrs sdk.Addresses) {
 	store := ctx.KVStore(k.storeKey)
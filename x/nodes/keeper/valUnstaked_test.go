@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/nodes/types"
@@ -260,3 +261,22 @@ func TestUnstakingValidatorsIterator(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUnstakingValidatorsInWindow(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	now := context.BlockTime()
+	early := getUnstakingValidator()
+	early.UnstakingCompletionTime = now.Add(time.Hour)
+	late := getUnstakingValidator()
+	late.UnstakingCompletionTime = now.Add(3 * time.Hour)
+	keeper.SetValidator(context, early)
+	keeper.SetValidator(context, late)
+	validators := keeper.GetUnstakingValidatorsInWindow(context, now, now.Add(2*time.Hour))
+	assert.Len(t, validators, 1)
+	assert.Equal(t, early.Address, validators[0].Address)
+	validators = keeper.GetUnstakingValidatorsInWindow(context, now, now.Add(3*time.Hour))
+	assert.Len(t, validators, 2)
+	validators = keeper.GetUnstakingValidatorsInWindow(context, now.Add(2*time.Hour), now.Add(4*time.Hour))
+	assert.Len(t, validators, 1)
+	assert.Equal(t, late.Address, validators[0].Address)
+}
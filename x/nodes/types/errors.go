@@ -39,6 +39,8 @@ const (
 	CodeDisallowedOutputAddressEdit   CodeType          = 127
 	CodeInvalidRewardDelegators       CodeType          = 128
 	CodeDisallowedRewardDelegatorEdit CodeType          = 129
+	CodeVestingOutputAddress          CodeType          = 130
+	CodeSlashHistoryFull              CodeType          = 131
 )
 
 func ErrTooManyChains(codespace sdk.CodespaceType) sdk.Error {
@@ -172,3 +174,13 @@ func ErrDisallowedRewardDelegatorEdit(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeDisallowedRewardDelegatorEdit,
 		"Only the node operator address can edit reward delegators")
 }
+
+func ErrVestingOutputAddress(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeVestingOutputAddress,
+		"a vesting account can only stake with itself as the output address while its coins are vesting")
+}
+
+func ErrSlashHistoryFull(codespace sdk.CodespaceType, addr sdk.Address, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeSlashHistoryFull,
+		fmt.Sprintf("the slash history of %s is full at height %d", addr, height))
+}
@@ -21,6 +21,8 @@ const (
 	AttributeKeyMissedBlocks         = "missed_blocks"
 	AttributeValueDoubleSign         = "double_sign"
 	AttributeValueMissingSignature   = "missing_signature"
+	AttributeValueChallengeBurn      = "challenge_burn"
+	AttributeValueBelowMinimumStake  = "below_minimum_stake"
 	AttributeKeyValidator            = "validator"
 	AttributeValueCategory           = ModuleName
 )
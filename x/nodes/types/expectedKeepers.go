@@ -16,6 +16,8 @@ type AuthKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Ctx, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
 	SendCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string, recipientAddr sdk.Address, amt sdk.Coins) sdk.Error
 	SendCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address, recipientModule string, amt sdk.Coins) sdk.Error
+	DelegateCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address, recipientModule string, amt sdk.Coins) sdk.Error
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string, recipientAddr sdk.Address, amt sdk.Coins) sdk.Error
 	MintCoins(ctx sdk.Ctx, moduleName string, amt sdk.Coins) sdk.Error
 	BurnCoins(ctx sdk.Ctx, name string, amt sdk.Coins) sdk.Error
 	IterateAccounts(ctx sdk.Ctx, process func(authexported.Account) (stop bool))
@@ -14,6 +14,7 @@ type GenesisState struct {
 	SigningInfos             map[string]ValidatorSigningInfo `json:"signing_infos" yaml:"signing_infos"`
 	MissedBlocks             map[string][]MissedBlock        `json:"missed_blocks" yaml:"missed_blocks"`
 	PreviousProposer         sdk.Address                     `json:"previous_proposer" yaml:"previous_proposer"`
+	SlashHistory             []SlashRecord                   `json:"slash_history,omitempty" yaml:"slash_history"`
 }
 
 // PrevState validator power, needed for validator set update logic
@@ -29,6 +29,7 @@ var ( // Keys for store prefixes
 	AwardValidatorKey               = []byte{0x51} // prefix for awarding validators
 	BurnValidatorKey                = []byte{0x52} // prefix for awarding validators
 	WaitingToBeginUnstakingKey      = []byte{0x43} // prefix for waiting validators
+	SlashHistoryKey                 = []byte{0x61} // prefix for the slash and jail history of validators
 )
 
 func KeyForValidatorByNetworkID(addr sdk.Address, networkID []byte) []byte {
@@ -135,3 +136,22 @@ func GetValMissedBlockKey(v sdk.Address, i int64) []byte {
 	binary.LittleEndian.PutUint64(b, uint64(i))
 	return append(GetValMissedBlockPrefixKey(v), b...)
 }
+
+// generates the prefix key for the slash history of a validator
+func KeyForSlashHistory(addr sdk.Address) []byte {
+	return append(SlashHistoryKey, addr.Bytes()...)
+}
+
+// generates the prefix key for the slash records of a validator at a height
+func KeyForSlashHistoryAtHeight(addr sdk.Address, height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(KeyForSlashHistory(addr), b...)
+}
+
+// generates the key for a slash record; index orders multiple records at the same height
+func KeyForSlashRecord(addr sdk.Address, height int64, index uint16) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, index)
+	return append(KeyForSlashHistoryAtHeight(addr, height), b...)
+}
@@ -204,71 +204,154 @@ func (m *ValidatorSigningInfo) GetJailedBlocksCounter() int64 {
 	return 0
 }
 
+// SlashRecord defines a single slash or jail entry in the history of a validator
+type SlashRecord struct {
+	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+	// height at which the slash or jail occurred
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height"`
+	// either slash or jail
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
+	// the infraction that caused the slash or jail
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`
+	// the amount of tokens burned
+	Amount github_com_pokt_network_pocket_core_types.BigInt `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount"`
+	// the staked tokens of the validator after the slash or jail
+	ResultingStake github_com_pokt_network_pocket_core_types.BigInt `protobuf:"bytes,6,opt,name=resulting_stake,json=resultingStake,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"resulting_stake" yaml:"resulting_stake"`
+}
+
+func (m *SlashRecord) Reset()      { *m = SlashRecord{} }
+func (*SlashRecord) ProtoMessage() {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63cb49073b61e33a, []int{3}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ProtoValidator)(nil), "x.nodes.ProtoValidator")
 	proto.RegisterMapType((map[string]uint32)(nil), "x.nodes.ProtoValidator.RewardDelegatorsEntry")
 	proto.RegisterType((*LegacyProtoValidator)(nil), "x.nodes.LegacyProtoValidator")
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "x.nodes.ValidatorSigningInfo")
+	proto.RegisterType((*SlashRecord)(nil), "x.nodes.SlashRecord")
 }
 
 func init() { proto.RegisterFile("x/nodes/nodes.proto", fileDescriptor_63cb49073b61e33a) }
 
 var fileDescriptor_63cb49073b61e33a = []byte{
-	// 864 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x4d, 0xd3, 0x34, 0x93, 0x6c, 0x29, 0x6e, 0x2b, 0xac, 0x82, 0x32, 0x91, 0x39,
-	0x90, 0x03, 0xb5, 0x61, 0xf7, 0x02, 0x41, 0x48, 0xac, 0x0b, 0x12, 0x65, 0x57, 0x62, 0x35, 0x6d,
-	0x39, 0xec, 0xc5, 0x72, 0xec, 0x89, 0x3b, 0xeb, 0x1f, 0x63, 0xd9, 0xe3, 0xdd, 0xe6, 0x3f, 0x80,
-	0x5b, 0x4f, 0xa8, 0xc7, 0xf2, 0xdf, 0xec, 0x71, 0x2f, 0x2b, 0x21, 0x0e, 0x03, 0x6a, 0x25, 0x84,
-	0x7c, 0xcc, 0x91, 0x13, 0xf2, 0x8c, 0xb3, 0x89, 0x77, 0x03, 0x5a, 0xad, 0x38, 0xf6, 0x12, 0x7b,
-	0xbe, 0xef, 0xcd, 0xfb, 0xbe, 0xf1, 0x7c, 0x9e, 0x14, 0xb0, 0x7d, 0x66, 0xc6, 0xd4, 0xc3, 0x99,
-	0xfc, 0x35, 0x92, 0x94, 0x32, 0xaa, 0xb6, 0xcf, 0x0c, 0xb1, 0xdc, 0xdb, 0xf1, 0xa9, 0x4f, 0x85,
-	0x66, 0x96, 0x6f, 0x32, 0xbc, 0x07, 0x7d, 0x4a, 0xfd, 0x10, 0x9b, 0x62, 0x35, 0xce, 0x27, 0x26,
-	0x23, 0x11, 0xce, 0x98, 0x13, 0x25, 0x32, 0x41, 0x7f, 0xd1, 0x06, 0x9b, 0x0f, 0xcb, 0xb7, 0x1f,
-	0x9c, 0x90, 0x78, 0x0e, 0xa3, 0xa9, 0x1a, 0x82, 0xf6, 0x3d, 0xcf, 0x4b, 0x71, 0x96, 0x69, 0xca,
-	0x40, 0x19, 0xf6, 0x2c, 0x54, 0x70, 0xd8, 0x76, 0xa4, 0x34, 0xe3, 0x70, 0x73, 0xea, 0x44, 0xe1,
-	0x48, 0xaf, 0x04, 0xfd, 0x6f, 0x0e, 0x3f, 0xf5, 0x09, 0x3b, 0xcd, 0xc7, 0x86, 0x4b, 0x23, 0x33,
-	0xa1, 0x01, 0xdb, 0x8f, 0x31, 0x7b, 0x4a, 0xd3, 0xc0, 0x4c, 0xa8, 0x1b, 0x60, 0xb6, 0xef, 0xd2,
-	0x14, 0x9b, 0x6c, 0x9a, 0xe0, 0xcc, 0xa8, 0x2a, 0xa3, 0xb9, 0x85, 0x7a, 0x0f, 0x74, 0x1e, 0xe6,
-	0xe3, 0x90, 0xb8, 0xf7, 0xf1, 0x54, 0xbb, 0x25, 0xfc, 0x3e, 0x2c, 0x38, 0x04, 0x89, 0x10, 0xed,
-	0x00, 0x4f, 0x67, 0x1c, 0xbe, 0x2b, 0x2d, 0x17, 0x9a, 0x8e, 0x16, 0xbb, 0x54, 0x1d, 0xac, 0x3f,
-	0x76, 0x48, 0x88, 0x3d, 0xad, 0x39, 0x50, 0x86, 0x1b, 0x16, 0x28, 0x38, 0xac, 0x14, 0x54, 0x3d,
-	0xcb, 0x9c, 0x8c, 0x39, 0x2c, 0xcf, 0xb4, 0xb5, 0x81, 0x32, 0x6c, 0xc9, 0x1c, 0xa9, 0xa0, 0xea,
-	0x59, 0xe6, 0x1c, 0x9c, 0x3a, 0x24, 0xce, 0xb4, 0xd6, 0xa0, 0x39, 0xec, 0xc8, 0x1c, 0x57, 0x28,
-	0xa8, 0x8a, 0xa8, 0x26, 0x00, 0x47, 0x38, 0x7d, 0x42, 0x5c, 0x7c, 0x82, 0x1e, 0x68, 0xeb, 0x03,
-	0x65, 0xd8, 0xb1, 0xde, 0x29, 0x38, 0xec, 0x66, 0x52, 0xb5, 0xf3, 0x34, 0x44, 0x4b, 0x29, 0xea,
-	0x04, 0xf4, 0x8e, 0x98, 0x13, 0x60, 0xef, 0x98, 0x06, 0x38, 0xce, 0xb4, 0xb6, 0xd8, 0x62, 0x3d,
-	0xe3, 0xb0, 0xf1, 0x1b, 0x87, 0x9f, 0xbc, 0xf9, 0x97, 0xb3, 0x88, 0x7f, 0x18, 0xb3, 0xb2, 0x25,
-	0x26, 0x2a, 0xa1, 0x5a, 0x5d, 0xf5, 0x27, 0x05, 0xbc, 0x77, 0x12, 0x67, 0xcc, 0x09, 0x48, 0xec,
-	0x1f, 0xd0, 0x28, 0x09, 0x31, 0x23, 0x34, 0x3e, 0x26, 0x11, 0xd6, 0x36, 0x06, 0xca, 0xb0, 0x7b,
-	0x67, 0xcf, 0x90, 0x30, 0x18, 0x73, 0x18, 0x8c, 0xe3, 0x39, 0x0c, 0xd6, 0xdd, 0xb2, 0x9f, 0x82,
-	0xc3, 0xcd, 0x7c, 0x5e, 0xc2, 0x2e, 0x49, 0x99, 0x71, 0xb8, 0x2b, 0x3f, 0x7d, 0x5d, 0xd7, 0xcf,
-	0x7f, 0x87, 0x0a, 0xfa, 0x37, 0x3f, 0xf5, 0x5c, 0x01, 0xb7, 0xbf, 0xcf, 0x59, 0x92, 0xb3, 0x39,
-	0x48, 0x1d, 0x71, 0xb1, 0x8f, 0x0b, 0x0e, 0x35, 0x2a, 0x02, 0x76, 0x85, 0xcf, 0xc7, 0x34, 0x22,
-	0x0c, 0x47, 0x09, 0x9b, 0x2e, 0xbc, 0xea, 0x19, 0x6f, 0x09, 0x58, 0xbd, 0x01, 0xf5, 0x67, 0x05,
-	0x6c, 0x21, 0xfc, 0xd4, 0x49, 0xbd, 0xaf, 0x71, 0x88, 0xfd, 0x12, 0xf4, 0x4c, 0x03, 0x83, 0xe6,
-	0xb0, 0x7b, 0x67, 0xdf, 0xa8, 0x66, 0xc8, 0xa8, 0x0f, 0x82, 0xf1, 0x6a, 0xfe, 0x37, 0x31, 0x4b,
-	0xa7, 0xd6, 0x17, 0x05, 0x87, 0xef, 0xa7, 0x22, 0x64, 0x7b, 0x2f, 0x63, 0xb5, 0x73, 0x68, 0xf2,
-	0x1c, 0xaf, 0x25, 0xe9, 0xe8, 0xb5, 0x1e, 0xf6, 0x0e, 0xc0, 0xee, 0x4a, 0x1f, 0x75, 0x0b, 0x34,
-	0x03, 0x3c, 0x15, 0x23, 0xd8, 0x41, 0xe5, 0xab, 0xba, 0x03, 0x5a, 0x4f, 0x9c, 0x30, 0xc7, 0x62,
-	0x4c, 0x6e, 0x23, 0xb9, 0x18, 0xdd, 0xfa, 0x4c, 0x19, 0x6d, 0xfd, 0x78, 0x09, 0x1b, 0x17, 0x97,
-	0x50, 0xf9, 0xeb, 0x12, 0x2a, 0x17, 0xbf, 0x40, 0x45, 0xff, 0x73, 0x0d, 0xec, 0x3c, 0xc0, 0xbe,
-	0xe3, 0x4e, 0x6f, 0xa6, 0xfb, 0x66, 0xba, 0xff, 0xcf, 0xe9, 0x1e, 0xf5, 0x96, 0x61, 0xd3, 0x5f,
-	0xac, 0x81, 0x9d, 0x97, 0x74, 0x1d, 0x11, 0x3f, 0x26, 0xb1, 0x7f, 0x18, 0x4f, 0xa8, 0xfa, 0x08,
-	0xcc, 0xa9, 0xaa, 0x40, 0xfb, 0x6a, 0x09, 0xb4, 0xb7, 0xc4, 0xaa, 0xda, 0xad, 0x7e, 0x07, 0x7a,
-	0x19, 0x73, 0x52, 0x66, 0x9f, 0x62, 0xe2, 0x9f, 0x32, 0x41, 0x56, 0xd3, 0xfa, 0xa8, 0xe0, 0xb0,
-	0xa6, 0xcf, 0x38, 0xdc, 0x96, 0x07, 0x5c, 0x56, 0x75, 0xd4, 0x15, 0xcb, 0x6f, 0xc5, 0x4a, 0xfd,
-	0x12, 0xb4, 0x0e, 0x63, 0x0f, 0x9f, 0x09, 0xbc, 0xaa, 0x22, 0xa4, 0x14, 0x6c, 0x3a, 0x99, 0x64,
-	0x78, 0xa9, 0xc8, 0xb2, 0xaa, 0x23, 0xb9, 0x4b, 0x8d, 0x41, 0x4f, 0x42, 0x68, 0xe7, 0x31, 0x23,
-	0xa1, 0x00, 0xf0, 0xbf, 0x6f, 0xc3, 0xac, 0x6e, 0xa3, 0xb6, 0x6f, 0xe1, 0xb2, 0xac, 0xca, 0x9b,
-	0xe8, 0x4a, 0xe9, 0xa4, 0x54, 0xd4, 0x08, 0xec, 0x46, 0x24, 0xcb, 0xb0, 0x67, 0x8f, 0x43, 0xea,
-	0x06, 0x99, 0xed, 0xd2, 0x3c, 0x66, 0x38, 0xd5, 0x5a, 0xa2, 0xfd, 0xcf, 0x0b, 0x0e, 0x57, 0x27,
-	0xcc, 0x38, 0xfc, 0x40, 0x3a, 0xac, 0x0c, 0xeb, 0x68, 0x5b, 0xea, 0x96, 0x90, 0x0f, 0xa4, 0x5a,
-	0xda, 0x55, 0x0d, 0xbd, 0x62, 0xb7, 0xbe, 0xb0, 0x5b, 0x99, 0xb0, 0xb0, 0x5b, 0x19, 0xd6, 0xd1,
-	0xb6, 0xd4, 0x6b, 0x76, 0xa3, 0x8d, 0x8b, 0x4b, 0xd8, 0x28, 0xb9, 0xb2, 0xee, 0x3f, 0xbb, 0xea,
-	0x2b, 0xcf, 0xaf, 0xfa, 0xca, 0x1f, 0x57, 0x7d, 0xe5, 0xfc, 0xba, 0xdf, 0x78, 0x7e, 0xdd, 0x6f,
-	0xfc, 0x7a, 0xdd, 0x6f, 0x3c, 0x7a, 0x23, 0x70, 0xe6, 0xff, 0x95, 0x04, 0x40, 0xe3, 0x75, 0x71,
-	0x0d, 0x77, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x65, 0x4f, 0x26, 0x43, 0x09, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x37, 0xbf, 0x9a, 0x49, 0xb6, 0x5b, 0xdc, 0x16, 0xac, 0xb2, 0xca, 0x44, 0xe6, 0x40,
+	0x0e, 0xd4, 0x81, 0xdd, 0x0b, 0x04, 0x21, 0xb1, 0x2e, 0x48, 0x94, 0x5d, 0x89, 0xd5, 0xb4, 0xe5,
+	0xd0, 0x8b, 0xe5, 0xd8, 0x13, 0xc7, 0x1b, 0xdb, 0x13, 0x79, 0xc6, 0xbb, 0xcd, 0x7f, 0x00, 0x17,
+	0xd4, 0x13, 0xea, 0xb1, 0xfc, 0x33, 0x68, 0x8f, 0x7b, 0x59, 0x09, 0x71, 0x18, 0x50, 0x2b, 0x21,
+	0xe4, 0x63, 0x8e, 0x9c, 0x90, 0x67, 0x9c, 0x26, 0xee, 0x06, 0xb1, 0xda, 0x85, 0xdb, 0x5e, 0x3c,
+	0x9e, 0xef, 0xfd, 0xf8, 0xde, 0xf8, 0x7d, 0x6f, 0x12, 0xb0, 0x79, 0xd2, 0x8b, 0x88, 0x8b, 0xa9,
+	0x7c, 0x1a, 0x93, 0x98, 0x30, 0xa2, 0xd6, 0x4f, 0x0c, 0xb1, 0xdd, 0xd9, 0xf2, 0x88, 0x47, 0x04,
+	0xd6, 0xcb, 0xde, 0xa4, 0x79, 0x07, 0x7a, 0x84, 0x78, 0x01, 0xee, 0x89, 0xdd, 0x20, 0x19, 0xf6,
+	0x98, 0x1f, 0x62, 0xca, 0xec, 0x70, 0x22, 0x1d, 0xf4, 0xe7, 0x75, 0xb0, 0xfe, 0x30, 0x7b, 0xfb,
+	0xd6, 0x0e, 0x7c, 0xd7, 0x66, 0x24, 0x56, 0x03, 0x50, 0xbf, 0xe7, 0xba, 0x31, 0xa6, 0x54, 0x53,
+	0x3a, 0x4a, 0xb7, 0x65, 0xa2, 0x94, 0xc3, 0xba, 0x2d, 0xa1, 0x19, 0x87, 0xeb, 0x53, 0x3b, 0x0c,
+	0xfa, 0x7a, 0x0e, 0xe8, 0x7f, 0x71, 0xf8, 0x91, 0xe7, 0xb3, 0x51, 0x32, 0x30, 0x1c, 0x12, 0xf6,
+	0x26, 0x64, 0xcc, 0x76, 0x23, 0xcc, 0x9e, 0x90, 0x78, 0xdc, 0x9b, 0x10, 0x67, 0x8c, 0xd9, 0xae,
+	0x43, 0x62, 0xdc, 0x63, 0xd3, 0x09, 0xa6, 0x46, 0x9e, 0x19, 0xcd, 0x29, 0xd4, 0x7b, 0xa0, 0xf1,
+	0x30, 0x19, 0x04, 0xbe, 0x73, 0x1f, 0x4f, 0xb5, 0x1b, 0x82, 0xef, 0xbd, 0x94, 0x43, 0x30, 0x11,
+	0xa0, 0x35, 0xc6, 0xd3, 0x19, 0x87, 0x6f, 0x49, 0xca, 0x05, 0xa6, 0xa3, 0x45, 0x94, 0xaa, 0x83,
+	0xda, 0x23, 0xdb, 0x0f, 0xb0, 0xab, 0x95, 0x3b, 0x4a, 0x77, 0xcd, 0x04, 0x29, 0x87, 0x39, 0x82,
+	0xf2, 0x35, 0xf3, 0xa1, 0xcc, 0x66, 0x09, 0xd5, 0x2a, 0x1d, 0xa5, 0x5b, 0x95, 0x3e, 0x12, 0x41,
+	0xf9, 0x9a, 0xf9, 0xec, 0x8d, 0x6c, 0x3f, 0xa2, 0x5a, 0xb5, 0x53, 0xee, 0x36, 0xa4, 0x8f, 0x23,
+	0x10, 0x94, 0x5b, 0xd4, 0x1e, 0x00, 0x07, 0x38, 0x7e, 0xec, 0x3b, 0xf8, 0x08, 0x3d, 0xd0, 0x6a,
+	0x1d, 0xa5, 0xdb, 0x30, 0x6f, 0xa5, 0x1c, 0x36, 0xa9, 0x44, 0xad, 0x24, 0x0e, 0xd0, 0x92, 0x8b,
+	0x3a, 0x04, 0xad, 0x03, 0x66, 0x8f, 0xb1, 0x7b, 0x48, 0xc6, 0x38, 0xa2, 0x5a, 0x5d, 0x84, 0x98,
+	0x4f, 0x39, 0x2c, 0xfd, 0xca, 0xe1, 0x87, 0x2f, 0xff, 0xe5, 0x4c, 0xdf, 0xdb, 0x8f, 0x58, 0x56,
+	0x12, 0x13, 0x99, 0x50, 0x21, 0xaf, 0xfa, 0xbd, 0x02, 0xde, 0x39, 0x8a, 0x28, 0xb3, 0xc7, 0x7e,
+	0xe4, 0xed, 0x91, 0x70, 0x12, 0x60, 0xe6, 0x93, 0xe8, 0xd0, 0x0f, 0xb1, 0xb6, 0xd6, 0x51, 0xba,
+	0xcd, 0x3b, 0x3b, 0x86, 0x14, 0x83, 0x31, 0x17, 0x83, 0x71, 0x38, 0x17, 0x83, 0x79, 0x37, 0xab,
+	0x27, 0xe5, 0x70, 0x3d, 0x99, 0xa7, 0xb0, 0x32, 0xa5, 0xcc, 0x38, 0xdc, 0x96, 0x9f, 0xbe, 0x88,
+	0xeb, 0xa7, 0xbf, 0x41, 0x05, 0xfd, 0x13, 0x9f, 0x7a, 0xaa, 0x80, 0x9b, 0xdf, 0x24, 0x6c, 0x92,
+	0xb0, 0xb9, 0x90, 0x1a, 0xa2, 0xb1, 0x8f, 0x52, 0x0e, 0x35, 0x22, 0x0c, 0x56, 0x2e, 0x9f, 0x0f,
+	0x48, 0xe8, 0x33, 0x1c, 0x4e, 0xd8, 0x74, 0xc1, 0x55, 0xf4, 0x78, 0x45, 0x81, 0x15, 0x0b, 0x50,
+	0x7f, 0x54, 0xc0, 0x06, 0xc2, 0x4f, 0xec, 0xd8, 0xfd, 0x02, 0x07, 0xd8, 0xcb, 0x84, 0x4e, 0x35,
+	0xd0, 0x29, 0x77, 0x9b, 0x77, 0x76, 0x8d, 0x7c, 0x86, 0x8c, 0xe2, 0x20, 0x18, 0xd7, 0xfd, 0xbf,
+	0x8c, 0x58, 0x3c, 0x35, 0x3f, 0x4d, 0x39, 0x7c, 0x37, 0x16, 0x26, 0xcb, 0xbd, 0xb2, 0x15, 0xce,
+	0xa1, 0xc9, 0x73, 0xbc, 0xe0, 0xa4, 0xa3, 0x17, 0x6a, 0xd8, 0xd9, 0x03, 0xdb, 0x2b, 0x79, 0xd4,
+	0x0d, 0x50, 0x1e, 0xe3, 0xa9, 0x18, 0xc1, 0x06, 0xca, 0x5e, 0xd5, 0x2d, 0x50, 0x7d, 0x6c, 0x07,
+	0x09, 0x16, 0x63, 0x72, 0x13, 0xc9, 0x4d, 0xff, 0xc6, 0xc7, 0x4a, 0x7f, 0xe3, 0xbb, 0x73, 0x58,
+	0x3a, 0x3b, 0x87, 0xca, 0x9f, 0xe7, 0x50, 0x39, 0xfb, 0x09, 0x2a, 0xfa, 0x1f, 0x15, 0xb0, 0xf5,
+	0x00, 0x7b, 0xb6, 0x33, 0x7d, 0x33, 0xdd, 0x6f, 0xa6, 0xfb, 0xbf, 0x9c, 0xee, 0x7e, 0x6b, 0x59,
+	0x6c, 0xfa, 0xf3, 0x0a, 0xd8, 0xba, 0x52, 0xd7, 0x81, 0xef, 0x45, 0x7e, 0xe4, 0xed, 0x47, 0x43,
+	0xa2, 0x1e, 0x83, 0xba, 0x5d, 0x10, 0xda, 0xe7, 0x4b, 0x42, 0x7b, 0x45, 0x59, 0xe5, 0xd1, 0xea,
+	0xd7, 0xa0, 0x45, 0x99, 0x1d, 0x33, 0x6b, 0x84, 0x7d, 0x6f, 0xc4, 0x84, 0xb2, 0xca, 0xe6, 0xfb,
+	0x29, 0x87, 0x05, 0x7c, 0xc6, 0xe1, 0xa6, 0x3c, 0xe0, 0x32, 0xaa, 0xa3, 0xa6, 0xd8, 0x7e, 0x25,
+	0x76, 0xea, 0x67, 0xa0, 0xba, 0x1f, 0xb9, 0xf8, 0x44, 0x2b, 0x2f, 0x92, 0xf8, 0x19, 0x60, 0x91,
+	0xe1, 0x90, 0xe2, 0xa5, 0x24, 0xcb, 0xa8, 0x8e, 0x64, 0x94, 0x1a, 0x81, 0x96, 0x14, 0xa1, 0x95,
+	0x44, 0xcc, 0x0f, 0xb4, 0xca, 0xbf, 0x76, 0xa3, 0x97, 0x77, 0xa3, 0x10, 0xb7, 0x60, 0x59, 0x46,
+	0x65, 0x27, 0x9a, 0x12, 0x3a, 0xca, 0x10, 0x35, 0x04, 0xdb, 0xa1, 0x4f, 0x29, 0x76, 0xad, 0x41,
+	0x40, 0x9c, 0x31, 0xb5, 0x1c, 0x92, 0x44, 0x0c, 0xc7, 0x5a, 0x55, 0x94, 0xff, 0x49, 0xca, 0xe1,
+	0x6a, 0x87, 0x19, 0x87, 0xb7, 0x25, 0xc3, 0x4a, 0xb3, 0x8e, 0x36, 0x25, 0x6e, 0x0a, 0x78, 0x4f,
+	0xa2, 0x19, 0x5d, 0x5e, 0xd0, 0x35, 0xba, 0xda, 0x82, 0x6e, 0xa5, 0xc3, 0x82, 0x6e, 0xa5, 0x59,
+	0x47, 0x9b, 0x12, 0x2f, 0xd0, 0xf5, 0xd7, 0xce, 0xce, 0x61, 0x49, 0xe8, 0xea, 0xe7, 0x32, 0x68,
+	0x1e, 0x04, 0x36, 0x1d, 0x21, 0xec, 0x90, 0xd8, 0xfd, 0x5f, 0xe5, 0xa4, 0x83, 0x5a, 0x41, 0x48,
+	0xe2, 0x6a, 0x90, 0x08, 0xca, 0x57, 0xf5, 0x36, 0xa8, 0x64, 0xd1, 0x42, 0x25, 0x0d, 0x73, 0x2d,
+	0xe5, 0x50, 0xec, 0x91, 0x78, 0x66, 0x19, 0x62, 0x6c, 0x53, 0x12, 0x89, 0xfe, 0xe7, 0x97, 0x8b,
+	0x44, 0x50, 0xbe, 0xaa, 0xc7, 0xa0, 0x66, 0x87, 0xd9, 0x39, 0xb5, 0xea, 0xeb, 0xdf, 0x12, 0x32,
+	0x13, 0xca, 0x57, 0xf5, 0x07, 0x05, 0xdc, 0x8a, 0x31, 0x4d, 0x02, 0x96, 0x0d, 0x71, 0x36, 0xb7,
+	0x38, 0xbf, 0xbe, 0xf0, 0x6b, 0xb0, 0x5c, 0x4f, 0x39, 0xe3, 0xf0, 0xed, 0xf9, 0x4f, 0x5b, 0xc1,
+	0xa0, 0xa3, 0xf5, 0x2b, 0x44, 0xdc, 0x5b, 0x8b, 0x46, 0x9a, 0xf7, 0x9f, 0x5e, 0xb4, 0x95, 0x67,
+	0x17, 0x6d, 0xe5, 0xf7, 0x8b, 0xb6, 0x72, 0x7a, 0xd9, 0x2e, 0x3d, 0xbb, 0x6c, 0x97, 0x7e, 0xb9,
+	0x6c, 0x97, 0x8e, 0x5f, 0xaa, 0x65, 0xf3, 0x3f, 0xbd, 0xa2, 0xb4, 0x41, 0x4d, 0xcc, 0xd3, 0xdd,
+	0xbf, 0x07, 0x00, 0xc2, 0xc5, 0x0b, 0x9b, 0x0c, 0x0b, 0x00, 0x00,
 }
 
 func (this *ProtoValidator) Equal(that interface{}) bool {
@@ -421,6 +504,45 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SlashRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashRecord)
+	if !ok {
+		that2, ok := that.(SlashRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.ResultingStake.Equal(that1.ResultingStake) {
+		return false
+	}
+	return true
+}
 func (m *ProtoValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -680,6 +802,75 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ResultingStake.Size()
+		i -= size
+		if _, err := m.ResultingStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNodes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNodes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintNodes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintNodes(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintNodes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNodes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNodes(v)
 	base := offset
@@ -804,6 +995,34 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovNodes(uint64(m.Height))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovNodes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovNodes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovNodes(uint64(l))
+	l = m.ResultingStake.Size()
+	n += 1 + l + sovNodes(uint64(l))
+	return n
+}
+
 func sovNodes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1726,6 +1945,241 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultingStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResultingStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNodes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	SlashRecordTypeSlash = "slash"
+	SlashRecordTypeJail  = "jail"
+)
+
+// Return human readable slash record
+func (r SlashRecord) String() string {
+	return fmt.Sprintf(`Slash Record:
+  Address:         %s
+  Height:          %d
+  Type:            %s
+  Reason:          %s
+  Amount:          %s
+  Resulting Stake: %s`,
+		r.Address, r.Height, r.Type, r.Reason, r.Amount, r.ResultingStake)
+}
@@ -14,12 +14,33 @@ import (
 // - appPubKey and clientPubKey may or may not be the same.
 func AATGeneration(appPubKey, clientPubKey string, appPrivKey crypto.PrivateKey) (pc.AAT, sdk.Error) {
 	aat := pc.AAT{
-		Version:              pc.SupportedTokenVersions[0],
+		Version:              pc.UnscopedTokenVersion,
 		ApplicationPublicKey: appPubKey,
 		ClientPublicKey:      clientPubKey,
 		ApplicationSignature: "",
 	}
+	return signAAT(aat, appPrivKey)
+}
+
+// ScopedAATGeneration generates an AAT that expires after expirationHeight and,
+// if chains is not empty, may only be used to relay to the listed chains.
+func ScopedAATGeneration(appPubKey, clientPubKey string, expirationHeight int64, chains []string, appPrivKey crypto.PrivateKey) (pc.AAT, sdk.Error) {
+	aat := pc.AAT{
+		Version:              pc.ScopedTokenVersion,
+		ApplicationPublicKey: appPubKey,
+		ClientPublicKey:      clientPubKey,
+		ApplicationSignature: "",
+		ExpirationHeight:     expirationHeight,
+		Chains:               chains,
+	}
+	if err := aat.ValidateMessage(); err != nil {
+		return pc.AAT{}, pc.NewInvalidTokenError(pc.ModuleName, err)
+	}
+	return signAAT(aat, appPrivKey)
+}
 
+// "signAAT" - Signs the AAT with the application private key
+func signAAT(aat pc.AAT, appPrivKey crypto.PrivateKey) (pc.AAT, sdk.Error) {
 	// marshal the AAT structure
 	aatBytes := aat.Hash()
 
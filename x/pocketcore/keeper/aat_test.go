@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/pokt-network/pocket-core/crypto/keys/mintkey"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,3 +21,20 @@ func TestAATGeneration(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Nil(t, res.Validate())
 }
+
+func TestScopedAATGeneration(t *testing.T) {
+	passphrase := "test"
+	kb := NewTestKeybase()
+	kp, err := kb.Create(passphrase)
+	assert.Nil(t, err)
+	privkey, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, passphrase)
+	assert.Nil(t, err)
+	appPubKey := kp.PublicKey
+	res, err := ScopedAATGeneration(appPubKey.RawString(), appPubKey.RawString(), 100, []string{"0001"}, privkey)
+	assert.Nil(t, err)
+	assert.Equal(t, pc.ScopedTokenVersion, res.Version)
+	assert.Equal(t, int64(100), res.ExpirationHeight)
+	assert.Nil(t, res.Validate())
+	_, err = ScopedAATGeneration(appPubKey.RawString(), appPubKey.RawString(), 0, nil, privkey)
+	assert.NotNil(t, err)
+}
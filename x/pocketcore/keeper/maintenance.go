@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	"github.com/pokt-network/pocket-core/x/auth/util"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// "MaintainNode" - Automatically tops up the stake of a slashed node and unjails it once its jail time has been served
+func (k Keeper) MaintainNode(
+	ctx sdk.Ctx,
+	n client.Client,
+	node *pc.PocketNode,
+	signer crypto.PrivateKey,
+	unjailTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, address sdk.Address) (*sdk.TxResponse, error),
+	stakeTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, validator nodesTypes.Validator, amount sdk.BigInt) (*sdk.TxResponse, error),
+) {
+	address := node.GetAddress()
+	validator, found := k.posKeeper.GetValidator(ctx, address)
+	// only staked nodes can be maintained
+	if !found || !validator.IsStaked() {
+		return
+	}
+	// the node key signs when there is no dedicated maintenance key
+	if signer == nil {
+		signer = node.PrivateKey
+	}
+	topUpPending := k.topUpStake(ctx, n, node, signer, validator, stakeTx)
+	if !pc.GlobalPocketConfig.AutoUnjail || !validator.IsJailed() {
+		return
+	}
+	// cannot be unjailed below the minimum stake
+	if validator.StakedTokens.LT(sdk.NewInt(k.posKeeper.MinimumStake(ctx))) {
+		if topUpPending {
+			ctx.Logger().Debug(fmt.Sprintf("%s is below the minimum stake, will not send the unjail-tx before the stake top up %s is committed", address, node.PendingTopUpTx))
+			return
+		}
+		ctx.Logger().Error(fmt.Sprintf("unable to auto unjail %s: the stake is below the minimum stake", address))
+		return
+	}
+	info, found := k.posKeeper.GetValidatorSigningInfo(ctx, address)
+	if !found {
+		ctx.Logger().Error(fmt.Sprintf("unable to auto unjail %s: no signing info found", address))
+		return
+	}
+	// wait until the downtime jail duration has elapsed
+	if ctx.BlockHeader().Time.Before(info.JailedUntil) {
+		ctx.Logger().Debug(fmt.Sprintf("%s is jailed until %s, will not send the unjail-tx yet", address, info.JailedUntil))
+		return
+	}
+	txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &nodesTypes.MsgUnjail{}, n, signer, k)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the auto unjail tx:\n%s", err.Error()))
+		return
+	}
+	ctx.Logger().Info(fmt.Sprintf("auto unjailing %s", address))
+	if _, err := unjailTx(cliCtx, txBuilder, address); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured executing the auto unjail transaction: \n%s", err.Error()))
+	}
+}
+
+// "topUpStake" - Tops up the stake if slashing brought it under the configured floor; returns whether a top up is pending
+func (k Keeper) topUpStake(
+	ctx sdk.Ctx,
+	n client.Client,
+	node *pc.PocketNode,
+	signer crypto.PrivateKey,
+	validator nodesTypes.Validator,
+	stakeTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, validator nodesTypes.Validator, amount sdk.BigInt) (*sdk.TxResponse, error),
+) bool {
+	floor := sdk.NewInt(pc.GlobalPocketConfig.AutoStakeFloor)
+	if !floor.IsPositive() || validator.StakedTokens.GTE(floor) {
+		node.PendingTopUpTx = ""
+		return false
+	}
+	address := validator.Address
+	if node.PendingTopUpTx != "" && isTxPending(n, node.PendingTopUpTx) {
+		ctx.Logger().Debug(fmt.Sprintf("the stake top up %s of %s is still pending, will not send another one yet", node.PendingTopUpTx, address))
+		return true
+	}
+	// it was either committed without raising the stake or dropped
+	node.PendingTopUpTx = ""
+	// the signer of the stake pays for it, so only the output address can top it up
+	output := validator.OutputAddress
+	if output == nil {
+		output = validator.Address
+	}
+	signerAddress := sdk.Address(signer.PublicKey().Address())
+	if !signerAddress.Equals(output) {
+		ctx.Logger().Error(fmt.Sprintf("unable to top up the stake of %s: the stake is paid by the output address %s, but the maintenance key is %s", address, output, signerAddress))
+		return false
+	}
+	// don't pay the fee of a top up that is bound to fail, e.g. for a lack of funds or a floor in the current stake bin
+	if err := k.posKeeper.ValidateEditStake(ctx, validator, validator, floor, signerAddress); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("unable to top up the stake of %s to %s: %s", address, floor, err.Error()))
+		return false
+	}
+	txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &nodesTypes.MsgStake{}, n, signer, k)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the auto stake tx:\n%s", err.Error()))
+		return false
+	}
+	ctx.Logger().Info(fmt.Sprintf("topping up the stake of %s from %s to %s", address, validator.StakedTokens, floor))
+	res, err := stakeTx(cliCtx, txBuilder, validator, floor)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured executing the auto stake transaction: \n%s", err.Error()))
+		return false
+	}
+	if res == nil || res.Code != 0 {
+		if res != nil {
+			ctx.Logger().Error(fmt.Sprintf("the auto stake transaction of %s was rejected: %s", address, res.RawLog))
+		}
+		return false
+	}
+	node.PendingTopUpTx = res.TxHash
+	return true
+}
+
+// ValidateAutoStakeFloor - Check the configured stake floor can be reached with a single edit stake from below it
+func (k Keeper) ValidateAutoStakeFloor(ctx sdk.Ctx) error {
+	floor := sdk.NewInt(pc.GlobalPocketConfig.AutoStakeFloor)
+	if !floor.IsPositive() {
+		return nil
+	}
+	if minimum := sdk.NewInt(k.posKeeper.MinimumStake(ctx)); floor.LT(minimum) {
+		return fmt.Errorf("the auto stake floor %s is below the minimum stake %s", floor, minimum)
+	}
+	// an edit stake must reach a new bin, which a top up to the start of a bin always does
+	if k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.RSCALKey) &&
+		k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.VEDITKey) &&
+		floor.LT(k.posKeeper.ServicerStakeWeightCeiling(ctx)) {
+		if bin := k.posKeeper.ServicerStakeFloorMultiplier(ctx); !floor.Mod(bin).IsZero() {
+			return fmt.Errorf("the auto stake floor %s is not a multiple of the stake bin size %s, so a top up within its bin would be rejected", floor, bin)
+		}
+	}
+	return nil
+}
+
+// "isTxPending" - Whether the transaction is still waiting in the mempool; when the mempool can't be read it is assumed
+// to be, so the transaction isn't sent twice
+func isTxPending(n client.Client, txHash string) bool {
+	num, err := n.NumUnconfirmedTxs()
+	if err != nil {
+		return true
+	}
+	res, err := n.UnconfirmedTxs(num.Count)
+	if err != nil {
+		return true
+	}
+	for _, tx := range res.Txs {
+		if strings.EqualFold(hex.EncodeToString(tx.Hash()), txHash) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	"github.com/pokt-network/pocket-core/x/auth/util"
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestKeeper_MaintainNode(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Now())
+	node := types.GetPocketNode()
+	address := node.GetAddress()
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	mempool := &mockMempoolClient{}
+	// fund the node to pay for the fees
+	err := nk.AccountKeeper.SetCoins(ctx, address, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(100000000))))
+	assert.Nil(t, err)
+	validator, found := nk.GetValidator(ctx, address)
+	assert.True(t, found)
+	minimumStake := sdk.NewInt(nk.MinimumStake(ctx))
+	validator.StakedTokens = minimumStake
+	validator.Jailed = true
+	nk.SetValidator(ctx, validator)
+	var unjailed bool
+	var staked sdk.BigInt
+	var stakeCode uint32
+	topUpTx := tmTypes.Tx("top up")
+	unjailTx := func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, addr sdk.Address) (*sdk.TxResponse, error) {
+		assert.Equal(t, address, addr)
+		unjailed = true
+		return nil, nil
+	}
+	stakeTx := func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, v nodesTypes.Validator, amount sdk.BigInt) (*sdk.TxResponse, error) {
+		assert.Equal(t, address, v.Address)
+		staked = amount
+		return &sdk.TxResponse{TxHash: hex.EncodeToString(topUpTx.Hash()), Code: stakeCode}, nil
+	}
+	reset := func() {
+		unjailed = false
+		staked = sdk.ZeroInt()
+	}
+	defer func() {
+		types.GlobalPocketConfig.AutoUnjail = false
+		types.GlobalPocketConfig.AutoStakeFloor = 0
+	}()
+	// disabled
+	keeper.MaintainNode(ctx, mempool, node, nil, unjailTx, stakeTx)
+	assert.False(t, unjailed)
+	// still serving the jail time
+	types.GlobalPocketConfig.AutoUnjail = true
+	info, _ := nk.GetValidatorSigningInfo(ctx, address)
+	info.JailedUntil = ctx.BlockHeader().Time.Add(time.Hour)
+	nk.SetValidatorSigningInfo(ctx, address, info)
+	keeper.MaintainNode(ctx, mempool, node, nil, unjailTx, stakeTx)
+	assert.False(t, unjailed)
+	// jail time served
+	info.JailedUntil = ctx.BlockHeader().Time.Add(-time.Hour)
+	nk.SetValidatorSigningInfo(ctx, address, info)
+	keeper.MaintainNode(ctx, mempool, node, nil, unjailTx, stakeTx)
+	assert.True(t, unjailed)
+	// below the stake floor the stake is topped up, and at the minimum stake the node is unjailed meanwhile
+	reset()
+	floor := minimumStake.AddRaw(1000000)
+	types.GlobalPocketConfig.AutoStakeFloor = floor.Int64()
+	keeper.MaintainNode(ctx, mempool, node, nil, unjailTx, stakeTx)
+	assert.True(t, staked.Equal(floor))
+	assert.True(t, unjailed)
+	assert.Equal(t, hex.EncodeToString(topUpTx.Hash()), node.PendingTopUpTx)
+	// not sent again while the first one is in the mempool
+	reset()
+	mempool.txs = tmTypes.Txs{topUpTx}
+	keeper.MaintainNode(ctx, mempool, node, nil, unjailTx, stakeTx)
+	assert.True(t, staked.IsZero())
+	assert.True(t, unjailed)
+	// below the minimum stake the unjail waits for the pending top up
+	reset()
+	validator.StakedTokens = minimumStake.SubRaw(1)
+	nk.SetValidator(ctx, validator)
+	keeper.MaintainNode(ctx, mempool, node, nil, unjailTx, stakeTx)
+	assert.True(t, staked.IsZero())
+	assert.False(t, unjailed)
+	// sent again once it left the mempool without raising the stake
+	mempool.txs = nil
+	keeper.MaintainNode(ctx, mempool, node, nil, unjailTx, stakeTx)
+	assert.True(t, staked.Equal(floor))
+	// a rejected top up is not pending, so it doesn't hold back the unjail
+	reset()
+	stakeCode = 1
+	validator.StakedTokens = minimumStake
+	nk.SetValidator(ctx, validator)
+	node.PendingTopUpTx = ""
+	keeper.MaintainNode(ctx, mempool, node, nil, unjailTx, stakeTx)
+	assert.True(t, staked.Equal(floor))
+	assert.Empty(t, node.PendingTopUpTx)
+	assert.True(t, unjailed)
+	stakeCode = 0
+	// the output address pays for the top up, so the node key can't sign it for a non custodial node
+	reset()
+	validator.OutputAddress = getRandomValidatorAddress()
+	nk.SetValidator(ctx, validator)
+	keeper.MaintainNode(ctx, mempool, node, nil, unjailTx, stakeTx)
+	assert.True(t, staked.IsZero())
+	assert.True(t, unjailed)
+	// a maintenance key holding the output address can, as long as it has the funds
+	reset()
+	maintenanceKey := getRandomPrivateKey()
+	validator.OutputAddress = sdk.Address(maintenanceKey.PublicKey().Address())
+	nk.SetValidator(ctx, validator)
+	keeper.MaintainNode(ctx, mempool, node, maintenanceKey, unjailTx, stakeTx)
+	assert.True(t, staked.IsZero())
+	assert.True(t, unjailed)
+	err = nk.AccountKeeper.SetCoins(ctx, validator.OutputAddress, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(100000000000))))
+	assert.Nil(t, err)
+	node.PendingTopUpTx = ""
+	keeper.MaintainNode(ctx, mempool, node, maintenanceKey, unjailTx, stakeTx)
+	assert.True(t, staked.Equal(floor))
+}
+
+func TestKeeper_MaintainNodeStakeBins(t *testing.T) {
+	originalRSCAL, originalVEDIT := codec.UpgradeFeatureMap[codec.RSCALKey], codec.UpgradeFeatureMap[codec.VEDITKey]
+	t.Cleanup(func() {
+		codec.UpgradeFeatureMap[codec.RSCALKey], codec.UpgradeFeatureMap[codec.VEDITKey] = originalRSCAL, originalVEDIT
+		types.GlobalPocketConfig.AutoStakeFloor = 0
+	})
+	codec.UpgradeFeatureMap[codec.RSCALKey], codec.UpgradeFeatureMap[codec.VEDITKey] = -1, -1
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Now())
+	node := types.GetPocketNode()
+	address := node.GetAddress()
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	err := nk.AccountKeeper.SetCoins(ctx, address, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(100000000000))))
+	assert.Nil(t, err)
+	validator, found := nk.GetValidator(ctx, address)
+	assert.True(t, found)
+	validator.StakedTokens = sdk.NewInt(nk.MinimumStake(ctx))
+	nk.SetValidator(ctx, validator)
+	var staked sdk.BigInt
+	stakeTx := func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, v nodesTypes.Validator, amount sdk.BigInt) (*sdk.TxResponse, error) {
+		staked = amount
+		return &sdk.TxResponse{TxHash: hex.EncodeToString(tmTypes.Tx("top up").Hash())}, nil
+	}
+	unjailTx := func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, addr sdk.Address) (*sdk.TxResponse, error) {
+		return nil, nil
+	}
+	// a floor within the bin of the current stake is rejected as an edit stake, so it is not sent
+	staked = sdk.ZeroInt()
+	types.GlobalPocketConfig.AutoStakeFloor = validator.StakedTokens.AddRaw(1000000).Int64()
+	assert.NotNil(t, keeper.ValidateAutoStakeFloor(ctx))
+	keeper.MaintainNode(ctx, &mockMempoolClient{}, node, nil, unjailTx, stakeTx)
+	assert.True(t, staked.IsZero())
+	assert.Empty(t, node.PendingTopUpTx)
+	// the start of a bin is always reachable
+	types.GlobalPocketConfig.AutoStakeFloor = nk.ServicerStakeFloorMultiplier(ctx).Int64()
+	assert.Nil(t, keeper.ValidateAutoStakeFloor(ctx))
+	keeper.MaintainNode(ctx, &mockMempoolClient{}, node, nil, unjailTx, stakeTx)
+	assert.True(t, staked.Equal(nk.ServicerStakeFloorMultiplier(ctx)))
+	// a floor below the minimum stake is never enough to be unjailed
+	types.GlobalPocketConfig.AutoStakeFloor = nk.MinimumStake(ctx) - 1
+	assert.NotNil(t, keeper.ValidateAutoStakeFloor(ctx))
+}
+
+// mockMempoolClient - A tendermint client that only reads its mempool
+type mockMempoolClient struct {
+	client.Client
+	txs tmTypes.Txs
+}
+
+func (m *mockMempoolClient) NumUnconfirmedTxs() (*ctypes.ResultUnconfirmedTxs, error) {
+	return &ctypes.ResultUnconfirmedTxs{Count: len(m.txs)}, nil
+}
+
+func (m *mockMempoolClient) UnconfirmedTxs(limit int) (*ctypes.ResultUnconfirmedTxs, error) {
+	return &ctypes.ResultUnconfirmedTxs{Count: len(m.txs), Txs: m.txs}, nil
+}
@@ -161,6 +161,15 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.
 	if !found {
 		return servicerAddr, claim, pc.NewAppNotFoundError(pc.ModuleName)
 	}
+	// scoped tokens are not accepted before the upgrade
+	if rp, ok := proof.GetLeaf().(pc.RelayProof); ok && rp.Token.IsScoped() && !k.Cdc.IsAfterScopedAATUpgrade(ctx.BlockHeight()) {
+		return servicerAddr, claim, pc.NewInvalidTokenError(pc.ModuleName, pc.UnsupportedTokenVersionError)
+	}
+	// relays of a client revoked by the app are not rewarded, even if served before the revocation
+	if rp, ok := proof.GetLeaf().(pc.RelayProof); ok && k.Cdc.IsAfterAppRevocationUpgrade(ctx.BlockHeight()) &&
+		k.appKeeper.IsClientRevoked(ctx, application.GetAddress(), rp.Token.ClientPublicKey) {
+		return servicerAddr, claim, pc.NewRevokedClientError(pc.ModuleName)
+	}
 	// validate the proof depending on the type of proof it is
 	er := proof.GetLeaf().Validate(application.GetChains(), int(k.SessionNodeCount(sessionCtx)), claim.SessionHeader.SessionBlockHeight)
 	if er != nil {
@@ -7,7 +7,10 @@ import (
 
 	"time"
 
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
+	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/stretchr/testify/assert"
@@ -65,6 +68,20 @@ func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
 	if err != nil {
 		t.Fatalf(err.Error())
 	}
+	// a client revoked by the app after serving the relays is not rewarded
+	original := codec.UpgradeFeatureMap[codec.AppRevocationKey]
+	t.Cleanup(func() {
+		codec.UpgradeFeatureMap[codec.AppRevocationKey] = original
+	})
+	codec.UpgradeFeatureMap[codec.AppRevocationKey] = -1
+	appPubKey, _ := crypto.NewPublicKey(header.ApplicationPubKey)
+	keeper.appKeeper.(appsKeeper.Keeper).RevokeClients(ctx, appsTypes.MsgRevokeClients{
+		AppAddr:       sdk.Address(appPubKey.Address()),
+		ClientPubKeys: []string{leafNode.(types.RelayProof).Token.ClientPublicKey},
+	})
+	_, _, sdkErr := keeper.ValidateProof(mockCtx, proofMsg)
+	assert.NotNil(t, sdkErr)
+	assert.Equal(t, sdk.CodeType(types.CodeRevokedClientError), sdkErr.Code())
 }
 
 func TestKeeper_GetPsuedorandomIndex(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	"github.com/pokt-network/pocket-core/crypto/keys"
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -281,3 +282,45 @@ func TestKeeper_HandleRelay(t *testing.T) {
 	assert.Equal(t, err.Codespace(), sdk.CodespaceType(types.ModuleName))
 	assert.Equal(t, err.Code(), sdk.CodeType(types.CodeInvalidBlockHeightError))
 }
+
+func TestKeeper_HandleRelayRevokedClient(t *testing.T) {
+	originalTestMode := codec.TestMode
+	originalClientBlockSyncAllowance := types.GlobalPocketConfig.ClientBlockSyncAllowance
+	t.Cleanup(func() {
+		codec.TestMode = originalTestMode
+		types.GlobalPocketConfig.ClientBlockSyncAllowance = originalClientBlockSyncAllowance
+		gock.Off()
+	})
+	codec.TestMode = -3
+	ctx, keeper, kvkeys, clientPrivateKey, appPrivateKey, nodePubKey, chain :=
+		setupHandleRelayTest(t)
+	types.GlobalPocketConfig.ClientBlockSyncAllowance = 10000
+	nodeBlockHeight := ctx.BlockHeight()
+
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", kvkeys["pos"]).Return(ctx.KVStore(kvkeys["pos"]))
+	mockCtx.On("KVStore", kvkeys["params"]).Return(ctx.KVStore(kvkeys["params"]))
+	mockCtx.On("KVStore", kvkeys["application"]).Return(ctx.KVStore(kvkeys["application"]))
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	mockCtx.On("PrevCtx", nodeBlockHeight).Return(ctx, nil)
+	for i := int64(1); i <= keeper.BlocksPerSession(ctx); i++ {
+		mockCtx.On("PrevCtx", nodeBlockHeight-i).Return(ctx, nil)
+	}
+
+	// revoke the client public key
+	ak := keeper.appKeeper.(appsKeeper.Keeper)
+	ak.RevokeClients(ctx, appsTypes.MsgRevokeClients{
+		AppAddr:       sdk.Address(appPrivateKey.PublicKey().Address()),
+		ClientPubKeys: []string{clientPrivateKey.PublicKey().RawString()},
+	})
+	resp, err := testRelayAt(t, mockCtx, keeper, nodeBlockHeight, clientPrivateKey, appPrivateKey, nodePubKey, chain)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeRevokedClientError), err.Code())
+
+	// other clients of the app are not affected
+	resp, err = testRelayAt(t, mockCtx, keeper, nodeBlockHeight, getRandomPrivateKey(), appPrivateKey, nodePubKey, chain)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+}
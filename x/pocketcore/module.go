@@ -146,6 +146,10 @@ func (am AppModule) EndBlock(ctx sdk.Ctx, _ abci.RequestEndBlock) []abci.Validat
 				am.keeper.SendProofTx(ctx, am.keeper.TmNode, node, ProofTx)
 				// clear session cache and db
 				types.ClearSessionCache(node.SessionStore)
+				// auto unjail and top up the stake
+				if types.GlobalPocketConfig.IsNodeMaintenanceEnabled() {
+					am.keeper.MaintainNode(ctx, am.keeper.TmNode, node, types.GlobalMaintenanceKey, UnjailTx, StakeTx)
+				}
 			}
 		}
 	}()
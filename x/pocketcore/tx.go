@@ -6,6 +6,7 @@ import (
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/auth"
 	"github.com/pokt-network/pocket-core/x/auth/util"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
 
@@ -47,3 +48,33 @@ func ProofTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, merkleProof types
 	}
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
 }
+
+// "UnjailTx" - A transaction to unjail a node once its jail time has been served
+func UnjailTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, address sdk.Address) (*sdk.TxResponse, error) {
+	msg := nodesTypes.MsgUnjail{
+		ValidatorAddr: address,
+		Signer:        cliCtx.FromAddress,
+	}
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, false)
+}
+
+// "StakeTx" - A transaction that raises the stake of a node to amount, keeping the rest of the node's stake unchanged
+func StakeTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, validator nodesTypes.Validator, amount sdk.BigInt) (*sdk.TxResponse, error) {
+	msg := nodesTypes.MsgStake{
+		PublicKey:        validator.PublicKey,
+		Chains:           validator.Chains,
+		Value:            amount,
+		ServiceUrl:       validator.ServiceURL,
+		Output:           validator.OutputAddress,
+		RewardDelegators: validator.RewardDelegators,
+	}
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, false)
+}
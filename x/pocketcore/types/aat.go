@@ -7,10 +7,17 @@ import (
 	"log"
 )
 
+const (
+	// The original token version, binding an app key to a client key without expiry or scope
+	UnscopedTokenVersion = "0.0.1"
+	// The token version that adds an expiration height and an optional allow-list of relay chains
+	ScopedTokenVersion = "0.0.2"
+)
+
 var (
 	// A list of supported token versions
 	// Requires major (semantic) upgrade to update this list
-	SupportedTokenVersions = []string{"0.0.1"}
+	SupportedTokenVersions = []string{UnscopedTokenVersion, ScopedTokenVersion}
 )
 
 // "VersionIsIncluded" - Returns if the version is included
@@ -29,6 +36,11 @@ func (a AAT) VersionIsSupported() bool {
 	return false
 }
 
+// "IsScoped" - Returns if the AAT carries an expiration height and a chain allow-list
+func (a AAT) IsScoped() bool {
+	return a.Version == ScopedTokenVersion
+}
+
 // Validate validates the AAT's metadata, message and signature.
 func (a AAT) Validate() error {
 	// check the version of the AAT
@@ -48,13 +60,19 @@ func (a AAT) Validate() error {
 
 // "Bytes" - Returns the bytes representation of the AAT
 func (a AAT) Bytes() []byte {
-	// using standard json bz
-	b, err := json.Marshal(AAT{
+	aat := AAT{
 		ApplicationSignature: "",
 		ApplicationPublicKey: a.ApplicationPublicKey,
 		ClientPublicKey:      a.ClientPublicKey,
 		Version:              a.Version,
-	})
+	}
+	// only scoped tokens sign over the expiration height and chains
+	if a.IsScoped() {
+		aat.ExpirationHeight = a.ExpirationHeight
+		aat.Chains = a.Chains
+	}
+	// using standard json bz
+	b, err := json.Marshal(aat)
 	if err != nil {
 		log.Fatal(fmt.Sprintf("an error occured hashing the aat:\n%v", err))
 	}
@@ -101,9 +119,42 @@ func (a AAT) ValidateMessage() error {
 	if err := PubKeyVerification(a.ClientPublicKey); err != nil {
 		return err
 	}
+	if a.IsScoped() {
+		// check for a valid expiration height
+		if a.ExpirationHeight < 1 {
+			return InvalidTokenExpirationError
+		}
+		// check the format of the allowed chains
+		for _, chain := range a.Chains {
+			if err := NetworkIdentifierVerification(chain); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// "ValidateScope" - Confirms the AAT may be used for the chain at the session block height
+func (a AAT) ValidateScope(chain string, sessionBlockHeight int64) error {
+	if !a.IsScoped() {
+		return nil
+	}
+	// check the expiration of the AAT
+	if sessionBlockHeight > a.ExpirationHeight {
+		return ExpiredTokenError
+	}
+	// an empty allow-list permits all chains
+	if len(a.Chains) == 0 {
+		return nil
+	}
+	for _, c := range a.Chains {
+		if c == chain {
+			return nil
+		}
+	}
+	return UnauthorizedTokenChainError
+}
+
 // ValidateSignature confirms that the ApplicationSignature of the AAT is correct
 func (a AAT) ValidateSignature() error {
 	aatBytesHex := a.HashString()
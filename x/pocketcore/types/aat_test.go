@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -271,3 +272,50 @@ func TestAAT_Validate(t *testing.T) {
 	AAT.ApplicationSignature = hex.EncodeToString(applicationSignature)
 	assert.Nil(t, AAT.Validate())
 }
+
+func TestAAT_BytesUnscoped(t *testing.T) {
+	appPrivKey := GetRandomPrivateKey()
+	clientPrivKey := GetRandomPrivateKey()
+	var unscoped = AAT{
+		Version:              UnscopedTokenVersion,
+		ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+		ClientPublicKey:      clientPrivKey.PublicKey().RawString(),
+		ApplicationSignature: "",
+	}
+	// the signed bytes of existing tokens do not change
+	assert.Equal(t, fmt.Sprintf(`{"version":"0.0.1","app_pub_key":"%s","client_pub_key":"%s","signature":""}`,
+		unscoped.ApplicationPublicKey, unscoped.ClientPublicKey), string(unscoped.Bytes()))
+	// the scope is ignored for unscoped tokens
+	withScope := unscoped
+	withScope.ExpirationHeight = 10
+	withScope.Chains = []string{"0001"}
+	assert.Equal(t, unscoped.Bytes(), withScope.Bytes())
+	assert.Nil(t, withScope.ValidateScope("0002", 11))
+	// and is covered for scoped tokens
+	scoped := withScope
+	scoped.Version = ScopedTokenVersion
+	assert.NotEqual(t, unscoped.Bytes(), scoped.Bytes())
+}
+
+func TestAAT_ValidateScope(t *testing.T) {
+	appPrivKey := GetRandomPrivateKey()
+	clientPrivKey := GetRandomPrivateKey()
+	var scoped = AAT{
+		Version:              ScopedTokenVersion,
+		ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+		ClientPublicKey:      clientPrivKey.PublicKey().RawString(),
+		ApplicationSignature: "",
+		ExpirationHeight:     10,
+		Chains:               []string{"0001", "0002"},
+	}
+	assert.Nil(t, scoped.ValidateMessage())
+	assert.Nil(t, scoped.ValidateScope("0001", 10))
+	assert.Equal(t, ExpiredTokenError, scoped.ValidateScope("0001", 11))
+	assert.Equal(t, UnauthorizedTokenChainError, scoped.ValidateScope("0003", 1))
+	noExpiration := scoped
+	noExpiration.ExpirationHeight = 0
+	assert.Equal(t, InvalidTokenExpirationError, noExpiration.ValidateMessage())
+	invalidChain := scoped
+	invalidChain.Chains = []string{"not hex"}
+	assert.NotNil(t, invalidChain.ValidateMessage())
+}
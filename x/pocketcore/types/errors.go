@@ -95,6 +95,7 @@ const (
 	CodeInvalidMerkleRangeError          = 89
 	CodeEvidenceSealed                   = 90
 	CodeChainsOverLimitError             = 91
+	CodeRevokedClientError               = 92
 )
 
 var (
@@ -103,6 +104,9 @@ var (
 	MissingApplicationPublicKeyError = errors.New("the application public key included in the AAT is not valid")
 	MissingClientPublicKeyError      = errors.New("the client public key included in the AAT is not valid")
 	InvalidTokenSignatureError       = errors.New("the application signature on the AAT is not valid")
+	InvalidTokenExpirationError      = errors.New("the expiration height included in the AAT is not valid")
+	ExpiredTokenError                = errors.New("the application authentication token is expired")
+	UnauthorizedTokenChainError      = errors.New("the application authentication token is not valid for the requested chain")
 	NegativeICCounterError           = errors.New("the IC counter is less than 0")
 	MaximumEntropyError              = errors.New("the entropy exceeds the maximum allowed relays")
 	NodeNotInSessionError            = errors.New("the node is not within the session")
@@ -188,8 +192,13 @@ var (
 	InvalidMerkleRangeError          = errors.New("the merkle hash range is invalid")
 	SealedEvidenceError              = errors.New("the evidence is sealed, either max relays reached or claim already submitted")
 	ChainsOverLimitError             = errors.New("the number of staked chains is over the limit")
+	RevokedClientError               = errors.New("the client public key of the AAT is revoked by the application")
 )
 
+func NewRevokedClientError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRevokedClientError, RevokedClientError.Error())
+}
+
 func NewSealedEvidenceError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEvidenceSealed, SealedEvidenceError.Error())
 }
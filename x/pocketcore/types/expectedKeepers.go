@@ -6,6 +6,7 @@ import (
 	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
 	authexported "github.com/pokt-network/pocket-core/x/auth/exported"
 	nodesexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodestypes "github.com/pokt-network/pocket-core/x/nodes/types"
 )
 
 type PosKeeper interface {
@@ -33,6 +34,12 @@ type PosKeeper interface {
 	GetValidatorsByChain(ctx sdk.Ctx, networkID string) (validators []sdk.Address, total int)
 	MaxChains(ctx sdk.Ctx) (maxChains int64)
 	GetRewardCost(ctx sdk.Ctx) sdk.BigInt
+	GetValidator(ctx sdk.Ctx, addr sdk.Address) (validator nodestypes.Validator, found bool)
+	GetValidatorSigningInfo(ctx sdk.Ctx, addr sdk.Address) (info nodestypes.ValidatorSigningInfo, found bool)
+	MinimumStake(ctx sdk.Ctx) (res int64)
+	ServicerStakeFloorMultiplier(ctx sdk.Ctx) sdk.BigInt
+	ServicerStakeWeightCeiling(ctx sdk.Ctx) sdk.BigInt
+	ValidateEditStake(ctx sdk.Ctx, currentValidator, newValidtor nodestypes.Validator, amount sdk.BigInt, signer sdk.Address) sdk.Error
 }
 
 type AppsKeeper interface {
@@ -40,8 +47,9 @@ type AppsKeeper interface {
 	Application(ctx sdk.Ctx, addr sdk.Address) appexported.ApplicationI
 	AllApplications(ctx sdk.Ctx) (applications []appexported.ApplicationI)
 	TotalTokens(ctx sdk.Ctx) sdk.BigInt
-	JailApplication(ctx sdk.Ctx, addr sdk.Address)
+	JailApplication(ctx sdk.Ctx, addr sdk.Address, reason string)
 	MaxChains(ctx sdk.Ctx) (maxChains int64)
+	IsClientRevoked(ctx sdk.Ctx, address sdk.Address, clientPubKey string) bool
 }
 
 type PocketKeeper interface {
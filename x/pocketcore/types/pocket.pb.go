@@ -440,10 +440,12 @@ func (m *RelayResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_RelayResponse proto.InternalMessageInfo
 
 type AAT struct {
-	Version              string `protobuf:"bytes,1,opt,name=version,proto3" json:"version"`
-	ApplicationPublicKey string `protobuf:"bytes,2,opt,name=applicationPublicKey,proto3" json:"app_pub_key"`
-	ClientPublicKey      string `protobuf:"bytes,3,opt,name=clientPublicKey,proto3" json:"client_pub_key"`
-	ApplicationSignature string `protobuf:"bytes,4,opt,name=applicationSignature,proto3" json:"signature"`
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version"`
+	ApplicationPublicKey string   `protobuf:"bytes,2,opt,name=applicationPublicKey,proto3" json:"app_pub_key"`
+	ClientPublicKey      string   `protobuf:"bytes,3,opt,name=clientPublicKey,proto3" json:"client_pub_key"`
+	ApplicationSignature string   `protobuf:"bytes,4,opt,name=applicationSignature,proto3" json:"signature"`
+	ExpirationHeight     int64    `protobuf:"varint,5,opt,name=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	Chains               []string `protobuf:"bytes,6,rep,name=chains,proto3" json:"chains,omitempty"`
 }
 
 func (m *AAT) Reset()         { *m = AAT{} }
@@ -628,90 +630,93 @@ func init() {
 func init() { proto.RegisterFile("x/pocketcore/pocket.proto", fileDescriptor_fd7cbfa14fd73888) }
 
 var fileDescriptor_fd7cbfa14fd73888 = []byte{
-	// 1322 bytes of a gzipped FileDescriptorProto
+	// 1361 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x13, 0x17, 0x4d, 0xc9, 0x8e, 0x47, 0x94, 0x3f, 0x36, 0x0e, 0xfe, 0x72, 0xfe, 0x80, 0xe9, 0x1a,
-	0x28, 0x62, 0x20, 0x88, 0x8c, 0x3a, 0x6d, 0x50, 0x04, 0x09, 0x50, 0x31, 0x35, 0xea, 0xb4, 0x4d,
-	0xe3, 0xac, 0x8d, 0x1e, 0x7a, 0x21, 0x28, 0x6a, 0x2d, 0xb1, 0xa2, 0xb8, 0xec, 0x72, 0xe5, 0x58,
-	0x6f, 0x90, 0x63, 0x1f, 0xa1, 0xe8, 0xa1, 0x87, 0x3c, 0x43, 0x1f, 0x20, 0xc7, 0x5c, 0x0a, 0xe4,
-	0x50, 0x30, 0x85, 0x7d, 0x23, 0xfa, 0x00, 0x45, 0x4e, 0xc5, 0x7e, 0x50, 0x22, 0x6d, 0xc7, 0x29,
-	0xfa, 0x71, 0x31, 0x57, 0x33, 0xbf, 0x99, 0x9d, 0xef, 0x59, 0xc3, 0xea, 0xf1, 0x56, 0x4c, 0xfd,
-	0x01, 0xe1, 0x3e, 0x65, 0x44, 0x1f, 0x5b, 0x31, 0xa3, 0x9c, 0x22, 0xeb, 0xb8, 0x35, 0x65, 0x5d,
-	0x5f, 0xe9, 0xd1, 0x1e, 0x95, 0x8c, 0x2d, 0x71, 0x52, 0x98, 0x8d, 0x9f, 0x0d, 0x68, 0xec, 0x93,
-	0x24, 0x09, 0x68, 0xb4, 0x4b, 0xbc, 0x2e, 0x61, 0xe8, 0x13, 0x58, 0xf6, 0xe2, 0x38, 0x0c, 0x7c,
-	0x8f, 0x07, 0x34, 0xda, 0x1b, 0x75, 0xbe, 0x20, 0xe3, 0xa6, 0xb1, 0x6e, 0x6c, 0xce, 0x3b, 0x28,
-	0x4b, 0xed, 0x05, 0x2f, 0x8e, 0xdd, 0x78, 0xd4, 0x09, 0x03, 0xdf, 0x1d, 0x90, 0x31, 0x3e, 0x0f,
-	0x46, 0x36, 0xd4, 0xfc, 0xbe, 0x17, 0x44, 0xcd, 0x19, 0x29, 0x35, 0x9f, 0xa5, 0xb6, 0x22, 0x60,
-	0xf5, 0x41, 0x0e, 0xa0, 0x44, 0xdd, 0xe9, 0x84, 0xd4, 0x1f, 0xec, 0x92, 0xa0, 0xd7, 0xe7, 0x4d,
-	0x73, 0xdd, 0xd8, 0x34, 0xd5, 0x1d, 0x9a, 0xeb, 0xf6, 0x25, 0x07, 0x5f, 0x80, 0xbe, 0x5b, 0x7d,
-	0xf6, 0x83, 0x5d, 0xd9, 0x78, 0x65, 0xc0, 0x9c, 0x36, 0x1f, 0x3d, 0x81, 0x46, 0x52, 0xf4, 0x44,
-	0x1a, 0x5d, 0xdf, 0xfe, 0x7f, 0xab, 0x18, 0x86, 0x56, 0xc9, 0x59, 0x67, 0xe1, 0x45, 0x6a, 0x57,
-	0xb2, 0xd4, 0x9e, 0xed, 0xcb, 0xdf, 0xb8, 0xac, 0x01, 0x7d, 0x04, 0xa0, 0x09, 0x22, 0x08, 0xc2,
-	0x1d, 0xcb, 0xb9, 0x96, 0xa5, 0xb6, 0x39, 0x20, 0xe3, 0x37, 0xa9, 0x0d, 0xfb, 0x13, 0x26, 0x2e,
-	0x00, 0xd1, 0x7d, 0xb0, 0xf4, 0xaf, 0xaf, 0x68, 0x97, 0x24, 0x4d, 0x73, 0xdd, 0xdc, 0xb4, 0x9c,
-	0x55, 0x11, 0x87, 0x48, 0x10, 0x9e, 0xbf, 0xb6, 0xad, 0xfd, 0x02, 0x00, 0x97, 0xe0, 0xda, 0xb5,
-	0x5f, 0x4d, 0xb8, 0xf2, 0x28, 0xe9, 0x3d, 0x08, 0xbd, 0x60, 0xf8, 0x5f, 0xf8, 0xf6, 0x25, 0xc0,
-	0x90, 0xb0, 0x41, 0x48, 0x30, 0xa5, 0x5c, 0xfa, 0x56, 0xdf, 0xfe, 0x5f, 0x59, 0xdf, 0xae, 0x97,
-	0xf4, 0xb1, 0x17, 0xf5, 0x88, 0x73, 0x55, 0xeb, 0xaa, 0x2b, 0x11, 0x97, 0x51, 0xca, 0x71, 0x41,
-	0x1e, 0x6d, 0x43, 0x9d, 0x53, 0xee, 0x85, 0x7b, 0x8c, 0xd2, 0xc3, 0x44, 0xe7, 0x72, 0x29, 0x4b,
-	0x6d, 0x4b, 0x92, 0xdd, 0x58, 0xd2, 0x71, 0x11, 0x84, 0x7a, 0x50, 0x3f, 0x64, 0x74, 0xd8, 0xee,
-	0x76, 0x19, 0x49, 0x92, 0x66, 0x55, 0x86, 0x77, 0x47, 0xc8, 0x08, 0xb2, 0xeb, 0x29, 0xfa, 0x9b,
-	0xd4, 0xfe, 0xa0, 0x17, 0xf0, 0xfe, 0xa8, 0xd3, 0xf2, 0xe9, 0x70, 0x2b, 0xa6, 0x03, 0x7e, 0x2b,
-	0x22, 0xfc, 0x29, 0x65, 0x03, 0x5d, 0xee, 0xb7, 0x64, 0xe9, 0xf3, 0x71, 0x4c, 0x92, 0x96, 0x56,
-	0x86, 0x8b, 0x9a, 0xd1, 0x0e, 0x58, 0xe4, 0x28, 0xe8, 0x92, 0xc8, 0x27, 0x07, 0xe3, 0x98, 0x34,
-	0x6b, 0xeb, 0xc6, 0x66, 0xcd, 0x79, 0x2f, 0x4b, 0xed, 0x46, 0x4e, 0x77, 0x85, 0xf8, 0x9b, 0xd4,
-	0xb6, 0x76, 0x0a, 0x40, 0x5c, 0x12, 0x43, 0x6d, 0x58, 0x22, 0xc7, 0x71, 0xc0, 0x64, 0xad, 0xeb,
-	0xa2, 0x9d, 0x95, 0x8e, 0x8a, 0x9a, 0x58, 0x9e, 0xf2, 0xf2, 0xba, 0x3d, 0x07, 0xbf, 0x7b, 0x45,
-	0xa4, 0xf6, 0xd9, 0x8f, 0xb6, 0xb1, 0xf1, 0xbb, 0x01, 0x8d, 0x47, 0x49, 0x6f, 0x4f, 0x74, 0xa1,
-	0x8c, 0x07, 0xc2, 0xa0, 0xa3, 0x2b, 0x7f, 0xea, 0x0c, 0xaf, 0x96, 0x33, 0xf2, 0x68, 0x0a, 0x70,
-	0xae, 0xe9, 0x9c, 0x34, 0x74, 0x4e, 0xf2, 0x10, 0x17, 0x94, 0xa0, 0x3b, 0x50, 0x0d, 0x89, 0x77,
-	0xa8, 0xd3, 0xbb, 0x52, 0x56, 0x26, 0x21, 0x0f, 0x1d, 0x4b, 0xeb, 0x91, 0x48, 0x2c, 0xff, 0x9e,
-	0x8b, 0x98, 0xf9, 0xb7, 0x22, 0x56, 0x70, 0xf7, 0x27, 0x03, 0x66, 0xd5, 0x7d, 0xe8, 0x2e, 0x00,
-	0x23, 0xa1, 0x37, 0x2e, 0xba, 0xd9, 0x2c, 0x5b, 0x86, 0x27, 0xfc, 0xdd, 0x0a, 0x2e, 0xa0, 0xd1,
-	0x13, 0x58, 0xf0, 0xfb, 0x5e, 0x18, 0x92, 0xa8, 0xa7, 0xc3, 0xa4, 0x3c, 0xbb, 0x51, 0x96, 0x7f,
-	0x50, 0xc2, 0x3c, 0x8c, 0x8e, 0xbc, 0x30, 0xe8, 0x7e, 0xea, 0x71, 0x6f, 0xb7, 0x82, 0xcf, 0x28,
-	0x50, 0xdd, 0xe6, 0xcc, 0x41, 0x4d, 0xc6, 0x6f, 0xe3, 0x74, 0x06, 0x1a, 0x32, 0x29, 0xb9, 0x5b,
-	0x68, 0x0b, 0xa0, 0x13, 0x52, 0x3a, 0x74, 0xc6, 0x9c, 0x24, 0xd2, 0x5e, 0xcb, 0x59, 0x14, 0xbd,
-	0x20, 0xa9, 0x6e, 0x47, 0x90, 0x71, 0x01, 0x82, 0xbe, 0x3e, 0xdb, 0xac, 0x33, 0xef, 0x6e, 0xd6,
-	0xab, 0x59, 0x6a, 0x2f, 0x4e, 0x42, 0x7b, 0x71, 0xc7, 0xde, 0x86, 0x7a, 0x34, 0x1a, 0x3e, 0x3e,
-	0x2c, 0xf5, 0xd8, 0xb2, 0xc8, 0x49, 0x34, 0x1a, 0xba, 0xf4, 0x70, 0x52, 0x01, 0x05, 0x14, 0xfa,
-	0x0c, 0x66, 0x15, 0xb9, 0x59, 0x5d, 0x37, 0xdf, 0x5a, 0x03, 0xab, 0xf9, 0xac, 0x50, 0xd8, 0xe7,
-	0xaf, 0xed, 0x39, 0xc5, 0x49, 0xb0, 0x26, 0xfd, 0x4b, 0x4d, 0xa4, 0x87, 0xdb, 0x33, 0x13, 0x60,
-	0x9a, 0x64, 0x31, 0x3d, 0x18, 0xf9, 0x6e, 0x44, 0x12, 0x2e, 0x46, 0x8e, 0xde, 0x36, 0x72, 0x7a,
-	0x68, 0xb2, 0xdb, 0x17, 0xa3, 0xa8, 0x08, 0x42, 0xef, 0xc3, 0x1c, 0x89, 0x38, 0xa3, 0xb1, 0x1a,
-	0xcc, 0xa6, 0x53, 0xcf, 0x52, 0x3b, 0x27, 0xe1, 0xfc, 0x80, 0x76, 0x2f, 0xd9, 0x35, 0xcd, 0x2c,
-	0xb5, 0x57, 0xf2, 0x5d, 0xd3, 0x11, 0xec, 0x4b, 0x36, 0x0e, 0xba, 0x07, 0x0b, 0x09, 0x61, 0x47,
-	0x81, 0x4f, 0x98, 0xde, 0x8a, 0x55, 0x69, 0xe7, 0x4a, 0x96, 0xda, 0x4b, 0x39, 0x47, 0xac, 0x46,
-	0xb9, 0x17, 0xcf, 0x60, 0x51, 0x4b, 0x56, 0x91, 0x3f, 0x50, 0x9b, 0xb1, 0x26, 0x25, 0x17, 0xb2,
-	0xd4, 0x2e, 0x50, 0x71, 0xe1, 0x8c, 0x3e, 0x84, 0x1a, 0xa7, 0x03, 0x12, 0xc9, 0x09, 0x53, 0xdf,
-	0x5e, 0x2e, 0xa7, 0xad, 0xdd, 0x3e, 0x70, 0xea, 0x3a, 0x67, 0xa6, 0xe7, 0x71, 0xac, 0xc0, 0xe8,
-	0x26, 0xcc, 0x27, 0x41, 0x2f, 0xf2, 0xf8, 0x88, 0x91, 0xe6, 0x9c, 0xbc, 0xa4, 0x91, 0xa5, 0xf6,
-	0x94, 0x88, 0xa7, 0x47, 0x9d, 0x8a, 0x93, 0x19, 0x58, 0x7d, 0x6b, 0xbf, 0x20, 0x02, 0xcb, 0x43,
-	0xef, 0x5b, 0xca, 0x02, 0x3e, 0xc6, 0x24, 0x89, 0x69, 0x94, 0xc8, 0x1e, 0x30, 0xcf, 0xd7, 0xb3,
-	0x4c, 0x67, 0x8e, 0x71, 0xae, 0x6b, 0xe3, 0x50, 0x2e, 0xed, 0xb2, 0x5c, 0x1c, 0x9f, 0xd7, 0x88,
-	0x3a, 0xb0, 0x34, 0x0c, 0xa2, 0x12, 0xf1, 0xe2, 0xae, 0x29, 0xdf, 0x92, 0x97, 0xed, 0x72, 0x2e,
-	0x3c, 0xb9, 0x05, 0x9f, 0xd3, 0x87, 0x38, 0x2c, 0x32, 0x12, 0x53, 0xc6, 0x09, 0xcb, 0x57, 0x8e,
-	0x29, 0x9b, 0xf9, 0x73, 0xa1, 0x21, 0x67, 0x25, 0xff, 0x6c, 0xef, 0x9c, 0xbd, 0x42, 0x07, 0xf9,
-	0xb9, 0x01, 0x8d, 0x92, 0xe9, 0xe5, 0x4c, 0x19, 0x97, 0x67, 0x0a, 0xdd, 0x80, 0x2b, 0xac, 0x18,
-	0x96, 0x79, 0x55, 0xec, 0xb1, 0x37, 0x0e, 0xa9, 0xd7, 0xc5, 0x13, 0x26, 0xba, 0xaf, 0xc7, 0x98,
-	0xf4, 0xec, 0x92, 0xb1, 0xea, 0x34, 0x74, 0xe4, 0x14, 0x1c, 0xab, 0x8f, 0x36, 0xf6, 0x0f, 0x03,
-	0xcc, 0x76, 0xfb, 0x40, 0x74, 0xd8, 0x11, 0x61, 0xa2, 0x0d, 0xb4, 0x81, 0xf2, 0x52, 0x4d, 0xc2,
-	0xf9, 0x01, 0x3d, 0x80, 0x95, 0xf2, 0x1b, 0x30, 0x0c, 0xfc, 0xfc, 0xb9, 0x34, 0xaf, 0x26, 0xa5,
-	0x7e, 0x33, 0xca, 0xc6, 0xb8, 0x10, 0x8c, 0xee, 0xc1, 0xa2, 0x1f, 0x06, 0x24, 0xe2, 0x53, 0x79,
-	0x73, 0xfa, 0xe6, 0x54, 0xac, 0x89, 0x8a, 0xb3, 0x50, 0xd4, 0x2e, 0x99, 0xb0, 0x3f, 0x89, 0x6b,
-	0xf5, 0xa2, 0xb8, 0x5e, 0x08, 0xd5, 0xae, 0xff, 0x62, 0x40, 0xbd, 0xb0, 0x63, 0xd1, 0x4d, 0xa8,
-	0x1f, 0x78, 0xac, 0x47, 0xf8, 0xc3, 0xa8, 0x4b, 0x8e, 0x65, 0x18, 0x4c, 0xf5, 0xa0, 0x0d, 0x04,
-	0x01, 0x17, 0xb9, 0xe2, 0x45, 0xd5, 0xcf, 0x5f, 0x4c, 0x49, 0x73, 0x46, 0x36, 0xc9, 0xbb, 0x5f,
-	0x54, 0x42, 0xc4, 0x65, 0x52, 0x06, 0x17, 0xe4, 0xd1, 0x0e, 0xcc, 0x72, 0xa9, 0x5c, 0xe7, 0xf2,
-	0xad, 0x9a, 0x56, 0xb4, 0x26, 0x4b, 0xc1, 0x95, 0x2e, 0xac, 0x85, 0xb5, 0x5f, 0x8f, 0xa1, 0x26,
-	0xc1, 0xe2, 0x6d, 0x1e, 0xd2, 0xa7, 0xfa, 0x01, 0x59, 0x55, 0xae, 0x48, 0x02, 0x56, 0x1f, 0x01,
-	0x18, 0xc5, 0xb1, 0x5e, 0x5a, 0x1a, 0x20, 0x09, 0x58, 0x7d, 0xb4, 0xc2, 0x00, 0xe6, 0x27, 0x16,
-	0xa0, 0x0d, 0xa8, 0xf6, 0xf3, 0xb9, 0x6d, 0xa9, 0xa9, 0xa6, 0x1e, 0x21, 0x12, 0x22, 0x79, 0xe8,
-	0x63, 0xa8, 0x49, 0xc3, 0x74, 0x5b, 0x5f, 0x3d, 0x53, 0x99, 0xd2, 0x93, 0x49, 0x51, 0x2a, 0x17,
-	0xd4, 0xc7, 0xd9, 0x7b, 0x71, 0xb2, 0x66, 0xbc, 0x3c, 0x59, 0x33, 0x7e, 0x3b, 0x59, 0x33, 0xbe,
-	0x3f, 0x5d, 0xab, 0xbc, 0x3c, 0x5d, 0xab, 0xbc, 0x3a, 0x5d, 0xab, 0x7c, 0x73, 0xe7, 0xaf, 0xf4,
-	0x67, 0xe9, 0xff, 0x23, 0xd9, 0xac, 0x9d, 0x59, 0xf9, 0xbf, 0xcf, 0xed, 0x3f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xa5, 0xfb, 0x71, 0x3c, 0x3c, 0x0d, 0x00, 0x00,
+	0x13, 0x17, 0x4d, 0x49, 0x8e, 0x47, 0x92, 0x3f, 0x36, 0x0e, 0xfe, 0x74, 0x02, 0x98, 0xfa, 0x1b,
+	0x28, 0x62, 0x20, 0x89, 0x8c, 0x3a, 0x6d, 0x50, 0x04, 0x09, 0x50, 0x31, 0x35, 0xea, 0x34, 0x4d,
+	0xe3, 0xac, 0x8d, 0x1e, 0x7a, 0x11, 0x28, 0x6a, 0x2d, 0xb1, 0xa2, 0xb8, 0x2c, 0xb9, 0x72, 0xac,
+	0x37, 0xc8, 0xb1, 0x8f, 0x50, 0xf4, 0xd0, 0x43, 0x9e, 0xa1, 0x0f, 0x90, 0x63, 0x2e, 0x05, 0x72,
+	0x28, 0x98, 0xc2, 0xbe, 0x11, 0xbd, 0xf5, 0x96, 0x53, 0xb1, 0x1f, 0x94, 0x48, 0x49, 0x71, 0x83,
+	0x7e, 0x5c, 0x44, 0x6a, 0xe6, 0x37, 0xb3, 0xf3, 0x3d, 0x4b, 0xd8, 0x38, 0xdd, 0x09, 0xa8, 0xd3,
+	0x27, 0xcc, 0xa1, 0x21, 0x51, 0xaf, 0x8d, 0x20, 0xa4, 0x8c, 0xa2, 0xea, 0x69, 0x63, 0xc2, 0xba,
+	0xba, 0xde, 0xa5, 0x5d, 0x2a, 0x18, 0x3b, 0xfc, 0x4d, 0x62, 0xb6, 0x7e, 0xd6, 0xa0, 0x76, 0x48,
+	0xa2, 0xc8, 0xa5, 0xfe, 0x3e, 0xb1, 0x3b, 0x24, 0x44, 0x9f, 0xc2, 0x9a, 0x1d, 0x04, 0x9e, 0xeb,
+	0xd8, 0xcc, 0xa5, 0xfe, 0xc1, 0xb0, 0xfd, 0x88, 0x8c, 0x0c, 0xad, 0xae, 0x6d, 0x2f, 0x59, 0x28,
+	0x89, 0xcd, 0x65, 0x3b, 0x08, 0x5a, 0xc1, 0xb0, 0xed, 0xb9, 0x4e, 0xab, 0x4f, 0x46, 0x78, 0x16,
+	0x8c, 0x4c, 0x28, 0x39, 0x3d, 0xdb, 0xf5, 0x8d, 0x05, 0x21, 0xb5, 0x94, 0xc4, 0xa6, 0x24, 0x60,
+	0xf9, 0x40, 0x16, 0xa0, 0x48, 0x9e, 0x69, 0x79, 0xd4, 0xe9, 0xef, 0x13, 0xb7, 0xdb, 0x63, 0x86,
+	0x5e, 0xd7, 0xb6, 0x75, 0x79, 0x86, 0xe2, 0xb6, 0x7a, 0x82, 0x83, 0xe7, 0xa0, 0xef, 0x16, 0x9f,
+	0xff, 0x60, 0x16, 0xb6, 0x5e, 0x6b, 0xb0, 0xa8, 0xcc, 0x47, 0x4f, 0xa1, 0x16, 0x65, 0x3d, 0x11,
+	0x46, 0x57, 0x76, 0xaf, 0x35, 0xb2, 0x61, 0x68, 0xe4, 0x9c, 0xb5, 0x96, 0x5f, 0xc6, 0x66, 0x21,
+	0x89, 0xcd, 0x72, 0x4f, 0xfc, 0xc7, 0x79, 0x0d, 0xe8, 0x63, 0x00, 0x45, 0xe0, 0x41, 0xe0, 0xee,
+	0x54, 0xad, 0x2b, 0x49, 0x6c, 0xea, 0x7d, 0x32, 0x7a, 0x1b, 0x9b, 0x70, 0x38, 0x66, 0xe2, 0x0c,
+	0x10, 0xdd, 0x87, 0xaa, 0xfa, 0xf7, 0x15, 0xed, 0x90, 0xc8, 0xd0, 0xeb, 0xfa, 0x76, 0xd5, 0xda,
+	0xe0, 0x71, 0xf0, 0x39, 0xe1, 0xc5, 0x1b, 0xb3, 0x7a, 0x98, 0x01, 0xe0, 0x1c, 0x5c, 0xb9, 0xf6,
+	0xab, 0x0e, 0x97, 0x1e, 0x47, 0xdd, 0x07, 0x9e, 0xed, 0x0e, 0xfe, 0x0b, 0xdf, 0xbe, 0x04, 0x18,
+	0x90, 0xb0, 0xef, 0x11, 0x4c, 0x29, 0x13, 0xbe, 0x55, 0x76, 0xff, 0x97, 0xd7, 0xb7, 0x6f, 0x47,
+	0x3d, 0x6c, 0xfb, 0x5d, 0x62, 0x5d, 0x56, 0xba, 0x2a, 0x52, 0xa4, 0x15, 0x52, 0xca, 0x70, 0x46,
+	0x1e, 0xed, 0x42, 0x85, 0x51, 0x66, 0x7b, 0x07, 0x21, 0xa5, 0xc7, 0x91, 0xca, 0xe5, 0x6a, 0x12,
+	0x9b, 0x55, 0x41, 0x6e, 0x05, 0x82, 0x8e, 0xb3, 0x20, 0xd4, 0x85, 0xca, 0x71, 0x48, 0x07, 0xcd,
+	0x4e, 0x27, 0x24, 0x51, 0x64, 0x14, 0x45, 0x78, 0xf7, 0xb8, 0x0c, 0x27, 0xb7, 0x6c, 0x49, 0x7f,
+	0x1b, 0x9b, 0x1f, 0x76, 0x5d, 0xd6, 0x1b, 0xb6, 0x1b, 0x0e, 0x1d, 0xec, 0x04, 0xb4, 0xcf, 0x6e,
+	0xf9, 0x84, 0x3d, 0xa3, 0x61, 0x5f, 0x95, 0xfb, 0x2d, 0x51, 0xfa, 0x6c, 0x14, 0x90, 0xa8, 0xa1,
+	0x94, 0xe1, 0xac, 0x66, 0xb4, 0x07, 0x55, 0x72, 0xe2, 0x76, 0x88, 0xef, 0x90, 0xa3, 0x51, 0x40,
+	0x8c, 0x52, 0x5d, 0xdb, 0x2e, 0x59, 0xff, 0x4f, 0x62, 0xb3, 0x96, 0xd2, 0x5b, 0x5c, 0xfc, 0x6d,
+	0x6c, 0x56, 0xf7, 0x32, 0x40, 0x9c, 0x13, 0x43, 0x4d, 0x58, 0x25, 0xa7, 0x81, 0x1b, 0x8a, 0x5a,
+	0x57, 0x45, 0x5b, 0x16, 0x8e, 0xf2, 0x9a, 0x58, 0x9b, 0xf0, 0xd2, 0xba, 0x9d, 0x81, 0xdf, 0xbd,
+	0xc4, 0x53, 0xfb, 0xfc, 0x47, 0x53, 0xdb, 0xfa, 0x5d, 0x83, 0xda, 0xe3, 0xa8, 0x7b, 0xc0, 0xbb,
+	0x50, 0xc4, 0x03, 0x61, 0x50, 0xd1, 0x15, 0x7f, 0x55, 0x86, 0x37, 0xf2, 0x19, 0x79, 0x3c, 0x01,
+	0x58, 0x57, 0x54, 0x4e, 0x6a, 0x2a, 0x27, 0x69, 0x88, 0x33, 0x4a, 0xd0, 0x1d, 0x28, 0x7a, 0xc4,
+	0x3e, 0x56, 0xe9, 0x5d, 0xcf, 0x2b, 0x13, 0x90, 0x87, 0x56, 0x55, 0xe9, 0x11, 0x48, 0x2c, 0x7e,
+	0x67, 0x22, 0xa6, 0xff, 0xad, 0x88, 0x65, 0xdc, 0xfd, 0x49, 0x83, 0xb2, 0x3c, 0x0f, 0xdd, 0x05,
+	0x08, 0x89, 0x67, 0x8f, 0xb2, 0x6e, 0x1a, 0x79, 0xcb, 0xf0, 0x98, 0xbf, 0x5f, 0xc0, 0x19, 0x34,
+	0x7a, 0x0a, 0xcb, 0x4e, 0xcf, 0xf6, 0x3c, 0xe2, 0x77, 0x55, 0x98, 0xa4, 0x67, 0xd7, 0xf3, 0xf2,
+	0x0f, 0x72, 0x98, 0x87, 0xfe, 0x89, 0xed, 0xb9, 0x9d, 0xcf, 0x6c, 0x66, 0xef, 0x17, 0xf0, 0x94,
+	0x02, 0xd9, 0x6d, 0xd6, 0x22, 0x94, 0x44, 0xfc, 0xb6, 0xce, 0x17, 0xa0, 0x26, 0x92, 0x92, 0xba,
+	0x85, 0x76, 0x00, 0xda, 0x1e, 0xa5, 0x03, 0x6b, 0xc4, 0x48, 0x24, 0xec, 0xad, 0x5a, 0x2b, 0xbc,
+	0x17, 0x04, 0xb5, 0xd5, 0xe6, 0x64, 0x9c, 0x81, 0xa0, 0xaf, 0xa7, 0x9b, 0x75, 0xe1, 0xaf, 0x9b,
+	0xf5, 0x72, 0x12, 0x9b, 0x2b, 0xe3, 0xd0, 0xce, 0xef, 0xd8, 0xdb, 0x50, 0xf1, 0x87, 0x83, 0x27,
+	0xc7, 0xb9, 0x1e, 0x5b, 0xe3, 0x39, 0xf1, 0x87, 0x83, 0x16, 0x3d, 0x1e, 0x57, 0x40, 0x06, 0x85,
+	0x3e, 0x87, 0xb2, 0x24, 0x1b, 0xc5, 0xba, 0xfe, 0xce, 0x1a, 0xd8, 0x48, 0x67, 0x85, 0xc4, 0xbe,
+	0x78, 0x63, 0x2e, 0x4a, 0x4e, 0x84, 0x15, 0xe9, 0x5f, 0x6a, 0x22, 0x35, 0xdc, 0x9e, 0xeb, 0x00,
+	0x93, 0x24, 0xf3, 0xe9, 0x11, 0x92, 0xef, 0x86, 0x24, 0x62, 0x7c, 0xe4, 0xa8, 0x6d, 0x23, 0xa6,
+	0x87, 0x22, 0xb7, 0x7a, 0x7c, 0x14, 0x65, 0x41, 0xe8, 0x03, 0x58, 0x24, 0x3e, 0x0b, 0x69, 0x20,
+	0x07, 0xb3, 0x6e, 0x55, 0x92, 0xd8, 0x4c, 0x49, 0x38, 0x7d, 0x41, 0xfb, 0x17, 0xec, 0x1a, 0x23,
+	0x89, 0xcd, 0xf5, 0x74, 0xd7, 0xb4, 0x39, 0xfb, 0x82, 0x8d, 0x83, 0xee, 0xc1, 0x72, 0x44, 0xc2,
+	0x13, 0xd7, 0x21, 0xa1, 0xda, 0x8a, 0x45, 0x61, 0xe7, 0x7a, 0x12, 0x9b, 0xab, 0x29, 0x87, 0xaf,
+	0x46, 0xb1, 0x17, 0xa7, 0xb0, 0xa8, 0x21, 0xaa, 0xc8, 0xe9, 0xcb, 0xcd, 0x58, 0x12, 0x92, 0xcb,
+	0x49, 0x6c, 0x66, 0xa8, 0x38, 0xf3, 0x8e, 0x3e, 0x82, 0x12, 0xa3, 0x7d, 0xe2, 0x8b, 0x09, 0x53,
+	0xd9, 0x5d, 0xcb, 0xa7, 0xad, 0xd9, 0x3c, 0xb2, 0x2a, 0x2a, 0x67, 0xba, 0x6d, 0x33, 0x2c, 0xc1,
+	0xe8, 0x06, 0x2c, 0x45, 0x6e, 0xd7, 0xb7, 0xd9, 0x30, 0x24, 0xc6, 0xa2, 0x38, 0xa4, 0x96, 0xc4,
+	0xe6, 0x84, 0x88, 0x27, 0xaf, 0x2a, 0x15, 0x67, 0x0b, 0xb0, 0xf1, 0xce, 0x7e, 0x41, 0x04, 0xd6,
+	0x06, 0xf6, 0xb7, 0x34, 0x74, 0xd9, 0x08, 0x93, 0x28, 0xa0, 0x7e, 0x24, 0x7a, 0x40, 0x9f, 0xad,
+	0x67, 0x91, 0xce, 0x14, 0x63, 0x5d, 0x55, 0xc6, 0xa1, 0x54, 0xba, 0x15, 0xa6, 0xe2, 0x78, 0x56,
+	0x23, 0x6a, 0xc3, 0xea, 0xc0, 0xf5, 0x73, 0xc4, 0xf9, 0x5d, 0x93, 0x3f, 0x25, 0x2d, 0xdb, 0xb5,
+	0x54, 0x78, 0x7c, 0x0a, 0x9e, 0xd1, 0x87, 0x18, 0xac, 0x84, 0x24, 0xa0, 0x21, 0x23, 0x61, 0xba,
+	0x72, 0x74, 0xd1, 0xcc, 0x5f, 0x70, 0x0d, 0x29, 0x2b, 0xfa, 0x67, 0x7b, 0x67, 0xfa, 0x08, 0x15,
+	0xe4, 0x17, 0x1a, 0xd4, 0x72, 0xa6, 0xe7, 0x33, 0xa5, 0x5d, 0x9c, 0x29, 0x74, 0x1d, 0x2e, 0x85,
+	0xd9, 0xb0, 0x2c, 0xc9, 0x62, 0x0f, 0xec, 0x91, 0x47, 0xed, 0x0e, 0x1e, 0x33, 0xd1, 0x7d, 0x35,
+	0xc6, 0x0c, 0xfd, 0xe2, 0xb1, 0x6a, 0xd5, 0x54, 0xe4, 0x24, 0x1c, 0xcb, 0x87, 0x32, 0xf6, 0x8f,
+	0x05, 0xd0, 0x9b, 0xcd, 0x23, 0xde, 0x61, 0x27, 0x24, 0xe4, 0x6d, 0x60, 0x68, 0x93, 0x43, 0x15,
+	0x09, 0xa7, 0x2f, 0xe8, 0x01, 0xac, 0xe7, 0xef, 0x80, 0x9e, 0xeb, 0xa4, 0xd7, 0xa5, 0x25, 0x39,
+	0x29, 0xd5, 0x9d, 0x51, 0x34, 0xc6, 0x5c, 0x30, 0xba, 0x07, 0x2b, 0x8e, 0xe7, 0x12, 0x9f, 0x4d,
+	0xe4, 0xf5, 0xc9, 0x9d, 0x53, 0xb2, 0xc6, 0x2a, 0xa6, 0xa1, 0xa8, 0x99, 0x33, 0xe1, 0x70, 0x1c,
+	0xd7, 0xe2, 0xbc, 0xb8, 0xce, 0x85, 0xa2, 0x47, 0x73, 0x96, 0x7b, 0x49, 0x4c, 0x09, 0x33, 0x89,
+	0xcd, 0x6b, 0x33, 0xcb, 0xfd, 0x26, 0x1d, 0xb8, 0x8c, 0x0c, 0x02, 0x36, 0x9a, 0x5d, 0xf3, 0xe8,
+	0x26, 0x94, 0x45, 0x17, 0x47, 0x46, 0xb9, 0xae, 0xa7, 0x23, 0x42, 0x52, 0x32, 0x72, 0x0a, 0xa3,
+	0xa2, 0xfe, 0x8b, 0x06, 0x95, 0xcc, 0x7a, 0x47, 0x37, 0xa0, 0x72, 0x64, 0x87, 0x5d, 0xc2, 0x1e,
+	0xfa, 0x1d, 0x72, 0x2a, 0x32, 0xa0, 0xcb, 0xbb, 0xb4, 0xcb, 0x09, 0x38, 0xcb, 0xe5, 0x97, 0xb9,
+	0x5e, 0x7a, 0x59, 0x8b, 0x8c, 0x85, 0xba, 0xfe, 0x5e, 0x97, 0x39, 0x2e, 0xd2, 0x0a, 0x85, 0x0c,
+	0xce, 0xc8, 0xa3, 0x3d, 0x28, 0x33, 0xa1, 0x5c, 0x95, 0xd1, 0x3b, 0x35, 0xad, 0x2b, 0x4d, 0x55,
+	0x09, 0x97, 0xba, 0xb0, 0x12, 0x56, 0x7e, 0x3d, 0x81, 0x92, 0x00, 0xf3, 0xcf, 0x02, 0x8f, 0x3e,
+	0x53, 0x77, 0xd7, 0xa2, 0x74, 0x45, 0x10, 0xb0, 0x7c, 0x70, 0xc0, 0x30, 0x08, 0xd4, 0xbe, 0x54,
+	0x00, 0x41, 0xc0, 0xf2, 0xa1, 0x14, 0xba, 0xb0, 0x34, 0xb6, 0x00, 0x6d, 0x41, 0xb1, 0x97, 0xae,
+	0x8c, 0xaa, 0x1c, 0xa8, 0xf2, 0xfe, 0x23, 0x20, 0x82, 0x87, 0x3e, 0x81, 0x92, 0x30, 0x4c, 0x4d,
+	0x94, 0xcb, 0x53, 0x4d, 0x21, 0x3c, 0x19, 0xf7, 0x83, 0x74, 0x41, 0x3e, 0xac, 0x83, 0x97, 0x67,
+	0x9b, 0xda, 0xab, 0xb3, 0x4d, 0xed, 0xb7, 0xb3, 0x4d, 0xed, 0xfb, 0xf3, 0xcd, 0xc2, 0xab, 0xf3,
+	0xcd, 0xc2, 0xeb, 0xf3, 0xcd, 0xc2, 0x37, 0x77, 0xde, 0x67, 0x34, 0xe4, 0x3e, 0xcd, 0xc4, 0x9c,
+	0x68, 0x97, 0xc5, 0x67, 0xd7, 0xed, 0x3f, 0x07, 0x00, 0x7b, 0xa8, 0x48, 0x0c, 0xb7, 0x0d, 0x00,
+	0x00,
 }
 
 func (m *SessionHeader) Marshal() (dAtA []byte, err error) {
@@ -1250,6 +1255,20 @@ func (m *AAT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Chains[iNdEx])
+			copy(dAtA[i:], m.Chains[iNdEx])
+			i = encodeVarintPocket(dAtA, i, uint64(len(m.Chains[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintPocket(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.ApplicationSignature) > 0 {
 		i -= len(m.ApplicationSignature)
 		copy(dAtA[i:], m.ApplicationSignature)
@@ -1660,6 +1679,15 @@ func (m *AAT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPocket(uint64(l))
 	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovPocket(uint64(m.ExpirationHeight))
+	}
+	if len(m.Chains) > 0 {
+		for _, s := range m.Chains {
+			l = len(s)
+			n += 1 + l + sovPocket(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3386,6 +3414,57 @@ func (m *AAT) Unmarshal(dAtA []byte) error {
 			}
 			m.ApplicationSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPocket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPocket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPocket(dAtA[iNdEx:])
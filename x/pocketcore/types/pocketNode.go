@@ -16,12 +16,16 @@ var GlobalSessionCache *CacheStorage
 
 var GlobalPocketNodes = map[string]*PocketNode{}
 
+// GlobalMaintenanceKey signs the automatic unjail and stake transactions of the pocket nodes; when nil each node signs for itself
+var GlobalMaintenanceKey crypto.PrivateKey
+
 // PocketNode represents an entity in the network that is able to handle dispatches, servicing, challenges, and submit proofs/claims.
 type PocketNode struct {
 	PrivateKey      crypto.PrivateKey
 	EvidenceStore   *CacheStorage
 	SessionStore    *CacheStorage
 	DoCacheInitOnce sync.Once
+	PendingTopUpTx  string // the hash of the last automatic stake top up, until it leaves the mempool
 }
 
 func (n *PocketNode) GetAddress() sdk.Address {
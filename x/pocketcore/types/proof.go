@@ -55,7 +55,8 @@ func (ps ProofIs) FromProofI() (res Proofs) {
 
 var _ Proof = RelayProof{} // ensure implements interface at compile time
 
-// ValidateLocal validates the Relay Proof object is aligned with `sessionBlockHeight` and `verifyAddr`.
+// ValidateLocal validates the Relay Proof object is aligned with `sessionBlockHeight` and `verifyAddr`,
+// and that the token of the proof is neither expired nor scoped to other chains.
 func (rp RelayProof) ValidateLocal(
 	appSupportedBlockchains []string,
 	sessionNodeCount int, // TODO_TECHDEBT: This is not used an can be removed.
@@ -78,6 +79,10 @@ func (rp RelayProof) ValidateLocal(
 	if err != nil {
 		return err
 	}
+	// validate the expiration and chain scope of the token
+	if er := rp.Token.ValidateScope(rp.Blockchain, sessionBlockHeight); er != nil {
+		return NewInvalidTokenError(ModuleName, er)
+	}
 	return nil
 }
 
@@ -162,6 +162,86 @@ func TestRelayProof_ValidateLocal(t *testing.T) {
 	}
 }
 
+func TestRelayProof_ValidateLocalScopedToken(t *testing.T) {
+	appPrivateKey := GetRandomPrivateKey()
+	clientPrivateKey := GetRandomPrivateKey()
+	sPK := getRandomPubKey()
+	chain := getTestSupportedBlockchain()
+	payload := Payload{Data: "fake"}
+	newProof := func(expirationHeight int64, chains []string) RelayProof {
+		proof := RelayProof{
+			Entropy:            0,
+			SessionBlockHeight: 5,
+			ServicerPubKey:     sPK.RawString(),
+			RequestHash:        payload.HashString(),
+			Blockchain:         chain,
+			Token: AAT{
+				Version:              ScopedTokenVersion,
+				ApplicationPublicKey: appPrivateKey.PublicKey().RawString(),
+				ClientPublicKey:      clientPrivateKey.PublicKey().RawString(),
+				ApplicationSignature: "",
+				ExpirationHeight:     expirationHeight,
+				Chains:               chains,
+			},
+		}
+		appSignature, er := appPrivateKey.Sign(proof.Token.Hash())
+		if er != nil {
+			t.Fatalf(er.Error())
+		}
+		proof.Token.ApplicationSignature = hex.EncodeToString(appSignature)
+		clientSignature, er := clientPrivateKey.Sign(proof.Hash())
+		if er != nil {
+			t.Fatalf(er.Error())
+		}
+		proof.Signature = hex.EncodeToString(clientSignature)
+		return proof
+	}
+	// the expiration height and chains are covered by the app signature
+	tamperedProof := newProof(5, []string{chain})
+	tamperedProof.Token.ExpirationHeight = 10
+	tests := []struct {
+		name     string
+		proof    RelayProof
+		hasError bool
+	}{
+		{
+			name:     "Valid Proof: unrestricted chains",
+			proof:    newProof(10, nil),
+			hasError: false,
+		},
+		{
+			name:     "Valid Proof: expires at the session block height",
+			proof:    newProof(5, []string{chain}),
+			hasError: false,
+		},
+		{
+			name:     "Invalid Proof: expired token",
+			proof:    newProof(4, nil),
+			hasError: true,
+		},
+		{
+			name:     "Invalid Proof: chain not allowed by token",
+			proof:    newProof(10, []string{"0002"}),
+			hasError: true,
+		},
+		{
+			name:     "Invalid Proof: missing expiration height",
+			proof:    newProof(0, nil),
+			hasError: true,
+		},
+		{
+			name:     "Invalid Proof: tampered expiration height",
+			proof:    tamperedProof,
+			hasError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.proof.ValidateLocal([]string{chain}, 5, 5, sdk.Address(sPK.Address())) != nil, tt.hasError)
+		})
+	}
+}
+
 func TestRelayProof_Bytes(t *testing.T) {
 	appPubKey := getRandomPubKey().RawString()
 	servicerPubKey := getRandomPubKey().RawString()
@@ -55,6 +55,10 @@ func (r *Relay) Validate(
 	if r.Proof.SessionBlockHeight != sessionBlockHeight {
 		return sdk.ZeroInt(), NewInvalidBlockHeightError(ModuleName)
 	}
+	// scoped tokens are not accepted before the upgrade
+	if r.Proof.Token.IsScoped() && !ModuleCdc.IsAfterScopedAATUpgrade(ctx.BlockHeight()) {
+		return sdk.ZeroInt(), NewInvalidTokenError(ModuleName, UnsupportedTokenVersionError)
+	}
 	// get the session context
 	sessionCtx, er := ctx.PrevCtx(sessionBlockHeight)
 	if er != nil {
@@ -67,6 +71,11 @@ func (r *Relay) Validate(
 	if !found {
 		return sdk.ZeroInt(), NewAppNotFoundError(ModuleName)
 	}
+	// Ensure that the client public key of the AAT is not revoked by the app
+	if ModuleCdc.IsAfterAppRevocationUpgrade(ctx.BlockHeight()) &&
+		appsKeeper.IsClientRevoked(ctx, app.GetAddress(), r.Proof.Token.ClientPublicKey) {
+		return sdk.ZeroInt(), NewRevokedClientError(ModuleName)
+	}
 	// Ensure that the app is not staked to more than the permitted number of chains
 	numAppChains := int64(len(app.GetChains()))
 	if ModuleCdc.IsAfterEnforceMaxChainsUpgrade(ctx.BlockHeight()) &&
@@ -317,7 +317,7 @@ func (m MockAppsKeeper) TotalTokens(ctx sdk.Ctx) sdk.BigInt {
 	panic("implement me")
 }
 
-func (m MockAppsKeeper) JailApplication(ctx sdk.Ctx, addr sdk.Address) {
+func (m MockAppsKeeper) JailApplication(ctx sdk.Ctx, addr sdk.Address, reason string) {
 	panic("implement me")
 }
 
@@ -325,6 +325,10 @@ func (m MockAppsKeeper) MaxChains(ctx sdk.Ctx) (res int64) {
 	return 15
 }
 
+func (m MockAppsKeeper) IsClientRevoked(ctx sdk.Ctx, address sdk.Address, clientPubKey string) bool {
+	return false
+}
+
 type MockPosKeeper struct {
 	Validators []exported.ValidatorI
 }
@@ -366,6 +370,30 @@ func (m MockPosKeeper) GetRewardCost(ctx sdk.Ctx) sdk.BigInt {
 	panic("implement me")
 }
 
+func (m MockPosKeeper) GetValidator(ctx sdk.Ctx, addr sdk.Address) (validator nodesTypes.Validator, found bool) {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) GetValidatorSigningInfo(ctx sdk.Ctx, addr sdk.Address) (info nodesTypes.ValidatorSigningInfo, found bool) {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) MinimumStake(ctx sdk.Ctx) (res int64) {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) ServicerStakeFloorMultiplier(ctx sdk.Ctx) sdk.BigInt {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) ServicerStakeWeightCeiling(ctx sdk.Ctx) sdk.BigInt {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) ValidateEditStake(ctx sdk.Ctx, currentValidator, newValidtor nodesTypes.Validator, amount sdk.BigInt, signer sdk.Address) sdk.Error {
+	panic("implement me")
+}
+
 func (m MockPosKeeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address) sdk.BigInt {
 	panic("implement me")
 }